@@ -30,4 +30,7 @@ const (
 
 	// ReconciliationTracer is the OpenTelemetry tracer name for the reconciliation loops
 	ReconciliationTracer string = "operator/reconciliation"
+
+	// FieldManager is the field manager the operator applies its objects with
+	FieldManager string = "jaeger-operator"
 )
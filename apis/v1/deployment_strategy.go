@@ -20,6 +20,10 @@ const (
 
 	// DeploymentStrategyProduction represents the 'production' deployment strategy
 	DeploymentStrategyProduction DeploymentStrategy = "production"
+
+	// DeploymentStrategyOTelCollector represents the 'otelCollector' deployment strategy, based on the
+	// OpenTelemetry Collector distribution of Jaeger (Jaeger v2)
+	DeploymentStrategyOTelCollector DeploymentStrategy = "otelcollector"
 )
 
 // UnmarshalText implements encoding.TextUnmarshaler to ensure that JSON values in the
@@ -38,6 +42,8 @@ func (ds *DeploymentStrategy) UnmarshalText(text []byte) error {
 		*ds = DeploymentStrategyStreaming
 	case string(DeploymentStrategyProduction):
 		*ds = DeploymentStrategyProduction
+	case string(DeploymentStrategyOTelCollector):
+		*ds = DeploymentStrategyOTelCollector
 	}
 
 	return nil
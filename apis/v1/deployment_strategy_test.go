@@ -13,16 +13,17 @@ func TestUnmarshalJSON(t *testing.T) {
 		json     string
 		expected DeploymentStrategy
 	}{
-		"allInOne":     {json: `"allInOne"`, expected: DeploymentStrategyAllInOne},
-		"streaming":    {json: `"streaming"`, expected: DeploymentStrategyStreaming},
-		"production":   {json: `"production"`, expected: DeploymentStrategyProduction},
-		"all-in-one":   {json: `"all-in-one"`, expected: DeploymentStrategyDeprecatedAllInOne},
-		"ALLinONE":     {json: `"ALLinONE"`, expected: DeploymentStrategyAllInOne},
-		"StReAmInG":    {json: `"StReAmInG"`, expected: DeploymentStrategyStreaming},
-		"Production":   {json: `"Production"`, expected: DeploymentStrategyProduction},
-		"All-IN-One":   {json: `"All-IN-One"`, expected: DeploymentStrategyDeprecatedAllInOne},
-		"random value": {json: `"random value"`, expected: DeploymentStrategyAllInOne},
-		"empty string": {json: `""`, expected: DeploymentStrategyAllInOne},
+		"allInOne":      {json: `"allInOne"`, expected: DeploymentStrategyAllInOne},
+		"streaming":     {json: `"streaming"`, expected: DeploymentStrategyStreaming},
+		"production":    {json: `"production"`, expected: DeploymentStrategyProduction},
+		"otelCollector": {json: `"otelCollector"`, expected: DeploymentStrategyOTelCollector},
+		"all-in-one":    {json: `"all-in-one"`, expected: DeploymentStrategyDeprecatedAllInOne},
+		"ALLinONE":      {json: `"ALLinONE"`, expected: DeploymentStrategyAllInOne},
+		"StReAmInG":     {json: `"StReAmInG"`, expected: DeploymentStrategyStreaming},
+		"Production":    {json: `"Production"`, expected: DeploymentStrategyProduction},
+		"All-IN-One":    {json: `"All-IN-One"`, expected: DeploymentStrategyDeprecatedAllInOne},
+		"random value":  {json: `"random value"`, expected: DeploymentStrategyAllInOne},
+		"empty string":  {json: `""`, expected: DeploymentStrategyAllInOne},
 	}
 
 	for name, tc := range tcs {
@@ -40,10 +41,11 @@ func TestMarshalJSON(t *testing.T) {
 		strategy DeploymentStrategy
 		expected string
 	}{
-		"allinone":   {strategy: DeploymentStrategyAllInOne, expected: `"allinone"`},
-		"streaming":  {strategy: DeploymentStrategyStreaming, expected: `"streaming"`},
-		"production": {strategy: DeploymentStrategyProduction, expected: `"production"`},
-		"all-in-one": {strategy: DeploymentStrategyDeprecatedAllInOne, expected: `"all-in-one"`},
+		"allinone":      {strategy: DeploymentStrategyAllInOne, expected: `"allinone"`},
+		"streaming":     {strategy: DeploymentStrategyStreaming, expected: `"streaming"`},
+		"production":    {strategy: DeploymentStrategyProduction, expected: `"production"`},
+		"otelcollector": {strategy: DeploymentStrategyOTelCollector, expected: `"otelcollector"`},
+		"all-in-one":    {strategy: DeploymentStrategyDeprecatedAllInOne, expected: `"all-in-one"`},
 	}
 
 	for name, tc := range tcs {
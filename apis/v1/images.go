@@ -0,0 +1,48 @@
+package v1
+
+import (
+	"sort"
+
+	"github.com/spf13/viper"
+
+	"github.com/jaegertracing/jaeger-operator/pkg/version"
+)
+
+// UnresolvedImages returns the flags setting images of the instance's components which can't be resolved for the
+// given Jaeger version, like images referenced by digest: the instance would keep running the images of the operator
+// instead of the images of that version. The images set in the instance's spec are used as they are.
+func (j *Jaeger) UnresolvedImages(jaegerVersion string) []string {
+	if j.Spec.Strategy == DeploymentStrategyOTelCollector {
+		// the Jaeger v2 distribution has its own version line
+		return nil
+	}
+
+	allInOne := j.Spec.Strategy == "" || j.Spec.Strategy == DeploymentStrategyAllInOne || j.Spec.Strategy == DeploymentStrategyDeprecatedAllInOne
+	images := []struct {
+		flag    string
+		image   string
+		current string
+		used    bool
+	}{
+		{flag: "jaeger-all-in-one-image", image: j.Spec.AllInOne.Image, current: version.Get().Jaeger, used: allInOne},
+		{flag: "jaeger-collector-image", image: j.Spec.Collector.Image, current: version.Get().Jaeger, used: !allInOne},
+		{flag: "jaeger-query-image", image: j.Spec.Query.Image, current: version.Get().Jaeger, used: !allInOne},
+		{flag: "jaeger-ingester-image", image: j.Spec.Ingester.Image, current: version.Get().Jaeger, used: j.Spec.Strategy == DeploymentStrategyStreaming},
+		{flag: "jaeger-agent-image", image: j.Spec.Agent.Image, current: version.Get().Agent, used: true},
+		{flag: "jaeger-cassandra-schema-image", image: j.Spec.Storage.CassandraCreateSchema.Image, current: version.Get().Jaeger, used: j.Spec.Storage.Type == JaegerCassandraStorage},
+		{flag: "jaeger-es-index-cleaner-image", image: j.Spec.Storage.EsIndexCleaner.Image, current: version.Get().Jaeger, used: j.Spec.Storage.Type == JaegerESStorage},
+		{flag: "jaeger-es-rollover-image", image: j.Spec.Storage.EsRollover.Image, current: version.Get().Jaeger, used: j.Spec.Storage.Type == JaegerESStorage},
+	}
+
+	var unresolved []string
+	for _, i := range images {
+		if !i.used || i.image != "" {
+			continue
+		}
+		if _, ok := version.Image(viper.GetString(i.flag), i.current, jaegerVersion); !ok {
+			unresolved = append(unresolved, i.flag)
+		}
+	}
+	sort.Strings(unresolved)
+	return unresolved
+}
@@ -0,0 +1,54 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnresolvedImages(t *testing.T) {
+	viper.Set("jaeger-collector-image", "org/collector@sha256:0123")
+	viper.Set("jaeger-query-image", "org/query:latest")
+	viper.Set("jaeger-ingester-image", "org/ingester@sha256:4567")
+	viper.Set("jaeger-agent-image", "org/agent")
+	defer viper.Reset()
+
+	tests := []struct {
+		name     string
+		spec     JaegerSpec
+		expected []string
+	}{
+		{
+			name: "all in one",
+		},
+		{
+			name:     "production",
+			spec:     JaegerSpec{Strategy: DeploymentStrategyProduction},
+			expected: []string{"jaeger-collector-image", "jaeger-query-image"},
+		},
+		{
+			name:     "streaming",
+			spec:     JaegerSpec{Strategy: DeploymentStrategyStreaming},
+			expected: []string{"jaeger-collector-image", "jaeger-ingester-image", "jaeger-query-image"},
+		},
+		{
+			name: "images set in the spec",
+			spec: JaegerSpec{
+				Strategy:  DeploymentStrategyProduction,
+				Collector: JaegerCollectorSpec{Image: "org/collector:1.60.0"},
+				Query:     JaegerQuerySpec{Image: "org/query:1.60.0"},
+			},
+		},
+		{
+			name: "otel collector",
+			spec: JaegerSpec{Strategy: DeploymentStrategyOTelCollector},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			j := &Jaeger{Spec: test.spec}
+			assert.Equal(t, test.expected, j.UnresolvedImages("1.60.0"))
+		})
+	}
+}
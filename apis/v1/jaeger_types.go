@@ -3,17 +3,32 @@ package v1
 import (
 	esv1 "github.com/openshift/elasticsearch-operator/apis/logging/v1"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	v1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // IngressSecurityType represents the possible values for the security type
 type IngressSecurityType string
 
+// JaegerKafkaAuthenticationType represents how the collector and ingester authenticate to the Kafka brokers
+type JaegerKafkaAuthenticationType string
+
+// JaegerKafkaProvisioningProfile represents a named sizing of the provisioned Kafka cluster
+type JaegerKafkaProvisioningProfile string
+
+// JaegerMonitorType represents the kind of Prometheus Operator monitors scraping the Jaeger components
+type JaegerMonitorType string
+
 // JaegerPhase represents the current phase of Jaeger instances
 type JaegerPhase string
 
+// JaegerUpgradePolicy represents how the instance is upgraded when the operator brings a newer Jaeger version
+type JaegerUpgradePolicy string
+
 // JaegerStorageType represents the Jaeger storage type
 type JaegerStorageType string
 
@@ -54,6 +69,47 @@ const (
 	// FlagKafkaProvision represents the 'kafka-provision' flag.
 	FlagKafkaProvision = "kafka-provision"
 
+	// FlagProvisionCassandraAuto represents the 'auto' value for the 'cassandra-provision' flag
+	FlagProvisionCassandraAuto = "auto"
+
+	// FlagCassandraProvision represents the 'cassandra-provision' flag.
+	FlagCassandraProvision = "cassandra-provision"
+
+	// FlagK8ssandra represents the 'k8ssandra' flag, set when the K8ssandra operator is available, in which case the
+	// provisioned Cassandra clusters are K8ssandraClusters instead of CassandraDatacenters
+	FlagK8ssandra = "k8ssandra"
+
+	// FlagArgoRollouts represents the 'argo-rollouts' flag, set when the Argo Rollouts API is available, in which case
+	// the sidecar of the Rollouts is kept up to date along with the other workloads
+	FlagArgoRollouts = "argo-rollouts"
+
+	// FlagKEDAIntegrationAuto represents the 'auto' value for the 'keda-integration' flag
+	FlagKEDAIntegrationAuto = "auto"
+
+	// FlagKEDAIntegration represents the 'keda-integration' flag.
+	FlagKEDAIntegration = "keda-integration"
+
+	// FlagPrometheusOperatorIntegrationAuto represents the 'auto' value for the 'prometheus-operator-integration' flag
+	FlagPrometheusOperatorIntegrationAuto = "auto"
+
+	// FlagPrometheusOperatorIntegration represents the 'prometheus-operator-integration' flag.
+	FlagPrometheusOperatorIntegration = "prometheus-operator-integration"
+
+	// FlagKafkaNodePools represents the 'kafka-node-pools' flag, set when the Strimzi operator supports KRaft
+	// clusters made of KafkaNodePools
+	FlagKafkaNodePools = "kafka-node-pools"
+
+	// FlagNativeSidecars represents the 'native-sidecars' flag, set when the cluster runs init containers with the
+	// 'Always' restart policy as sidecars, which is how the sidecar is injected into Jobs and CronJobs
+	FlagNativeSidecars = "native-sidecars"
+
+	// FlagKafkaProvisioningProfile represents the 'kafka-provisioning-profile' flag.
+	FlagKafkaProvisioningProfile = "kafka-provisioning-profile"
+
+	// FlagKafkaProvisioningMinimal represents the deprecated 'kafka-provisioning-minimal' flag, which selects the
+	// 'minimal' profile unless the 'kafka-provisioning-profile' flag is set.
+	FlagKafkaProvisioningMinimal = "kafka-provisioning-minimal"
+
 	// FlagAuthDelegatorAvailability represents the 'auth-delegator-available' flag.
 	FlagAuthDelegatorAvailability = "auth-delegator-available"
 
@@ -72,18 +128,97 @@ const (
 	// IngressSecurityOAuthProxy represents an OAuth Proxy as security type
 	IngressSecurityOAuthProxy IngressSecurityType = "oauth-proxy"
 
+	// KafkaAuthenticationNone connects to the brokers without authentication
+	KafkaAuthenticationNone JaegerKafkaAuthenticationType = "none"
+
+	// KafkaAuthenticationTLS authenticates to the brokers with a client certificate
+	KafkaAuthenticationTLS JaegerKafkaAuthenticationType = "tls"
+
+	// KafkaAuthenticationSCRAMSHA512 authenticates to the brokers with a username and password, using SASL/SCRAM-SHA-512
+	KafkaAuthenticationSCRAMSHA512 JaegerKafkaAuthenticationType = "scram-sha-512"
+
+	// KafkaAuthenticationPlaintext authenticates to the brokers with a username and password, using the SASL mechanism of choice
+	KafkaAuthenticationPlaintext JaegerKafkaAuthenticationType = "plaintext"
+
+	// KafkaAuthenticationKerberos authenticates to the brokers with a Kerberos keytab
+	KafkaAuthenticationKerberos JaegerKafkaAuthenticationType = "kerberos"
+
+	// KafkaProvisioningProfileProduction provisions three brokers with 100Gi of storage each, replicating the internal
+	// topics on all of them
+	KafkaProvisioningProfileProduction JaegerKafkaProvisioningProfile = "production"
+
+	// KafkaProvisioningProfileMinimal provisions a single broker with 10Gi of storage, suitable for demos and tests
+	KafkaProvisioningProfileMinimal JaegerKafkaProvisioningProfile = "minimal"
+
+	// JaegerServiceMonitor scrapes the components through their services
+	JaegerServiceMonitor JaegerMonitorType = "ServiceMonitor"
+
+	// JaegerPodMonitor scrapes the pods of the components directly
+	JaegerPodMonitor JaegerMonitorType = "PodMonitor"
+
+	// FinalizerCleanup holds the deletion of the Jaeger instances until the operator removed the sidecars and the
+	// objects it created outside of the instance's namespace, which aren't garbage collected
+	FinalizerCleanup string = "jaegertracing.io/cleanup"
+
+	// AnnotationPreUpgradeSnapshot holds the spec and version of the instance as they were before its last upgrade
+	AnnotationPreUpgradeSnapshot string = "jaegertracing.io/pre-upgrade-snapshot"
+
+	// AnnotationRollbackUpgrade requests the operator to restore the spec and version from the pre-upgrade snapshot
+	AnnotationRollbackUpgrade string = "jaegertracing.io/rollback-upgrade"
+
+	// AnnotationCassandraSchemaRevision identifies the schema settings a create-schema job migrates the keyspace to
+	AnnotationCassandraSchemaRevision string = "jaegertracing.io/cassandra-schema-revision"
+
 	// AnnotationProvisionedKafkaKey is a label to be added to Kafkas that have been provisioned by Jaeger
 	AnnotationProvisionedKafkaKey string = "jaegertracing.io/kafka-provisioned"
 
 	// AnnotationProvisionedKafkaValue is a label to be added to Kafkas that have been provisioned by Jaeger
 	AnnotationProvisionedKafkaValue string = "true"
 
+	// AnnotationKafkaModeKey records how the Kafka cluster provisioned for the instance manages its metadata, which
+	// doesn't change once the cluster has been created
+	AnnotationKafkaModeKey string = "jaegertracing.io/kafka-mode"
+
+	// AnnotationKafkaModeKRaft is the value of the Kafka mode annotation for clusters made of KafkaNodePools
+	AnnotationKafkaModeKRaft string = "kraft"
+
+	// AnnotationKafkaModeZooKeeper is the value of the Kafka mode annotation for clusters running with ZooKeeper
+	AnnotationKafkaModeZooKeeper string = "zookeeper"
+
+	// AnnotationProvisionedCassandraKey is added to the instances whose Cassandra cluster has been provisioned by Jaeger
+	AnnotationProvisionedCassandraKey string = "jaegertracing.io/cassandra-provisioned"
+
+	// AnnotationProvisionedCassandraValue is the value of the annotation added to the instances whose Cassandra cluster
+	// has been provisioned by Jaeger
+	AnnotationProvisionedCassandraValue string = "true"
+
+	// AnnotationCassandraKindKey records the kind of the custom resource the Cassandra cluster of the instance has been
+	// provisioned with, which doesn't change once the cluster has been created
+	AnnotationCassandraKindKey string = "jaegertracing.io/cassandra-kind"
+
+	// AnnotationCassandraKindDatacenter is the value of the Cassandra kind annotation for clusters provisioned through
+	// cass-operator
+	AnnotationCassandraKindDatacenter string = "CassandraDatacenter"
+
+	// AnnotationCassandraKindK8ssandra is the value of the Cassandra kind annotation for clusters provisioned through
+	// the K8ssandra operator
+	AnnotationCassandraKindK8ssandra string = "K8ssandraCluster"
+
+	// UpgradePolicyAuto upgrades the instance to the Jaeger version of the operator as soon as it's available
+	UpgradePolicyAuto JaegerUpgradePolicy = "Auto"
+
+	// UpgradePolicyManual keeps the instance on its current version, until a newer version is set in the spec
+	UpgradePolicyManual JaegerUpgradePolicy = "Manual"
+
 	// JaegerPhaseFailed indicates that the Jaeger instance failed to be provisioned
 	JaegerPhaseFailed JaegerPhase = "Failed"
 
 	// JaegerPhaseRunning indicates that the Jaeger instance is ready and running
 	JaegerPhaseRunning JaegerPhase = "Running"
 
+	// JaegerPhasePending indicates that the Jaeger instance has been provisioned, but not all of its workloads are available yet
+	JaegerPhasePending JaegerPhase = "Pending"
+
 	// JaegerMemoryStorage indicates that the Jaeger storage type is memory. This is the default storage type.
 	JaegerMemoryStorage JaegerStorageType = "memory"
 
@@ -103,6 +238,49 @@ const (
 	JaegerGRPCPluginStorage JaegerStorageType = "grpc-plugin"
 )
 
+const (
+	// JaegerConditionStorageReady indicates whether the storage backend, its secrets and the storage-related
+	// resources (Elasticsearch, Kafka, maintenance cron jobs) have been provisioned
+	JaegerConditionStorageReady string = "StorageReady"
+
+	// JaegerConditionCollectorAvailable indicates whether the collector (or all-in-one) workload is available
+	JaegerConditionCollectorAvailable string = "CollectorAvailable"
+
+	// JaegerConditionQueryAvailable indicates whether the query (or all-in-one) workload is available
+	JaegerConditionQueryAvailable string = "QueryAvailable"
+
+	// JaegerConditionIngressReady indicates whether the ingress or route exposing the query service has been provisioned
+	JaegerConditionIngressReady string = "IngressReady"
+
+	// JaegerConditionDependenciesComplete indicates whether the storage dependency jobs, like the Cassandra schema creation, completed
+	JaegerConditionDependenciesComplete string = "DependenciesComplete"
+
+	// JaegerConditionUpgradeApplied indicates whether the instance has been upgraded to the version managed by the operator
+	JaegerConditionUpgradeApplied string = "UpgradeApplied"
+
+	// JaegerReasonReconciled is used when the resources backing a condition have been reconciled
+	JaegerReasonReconciled string = "Reconciled"
+
+	// JaegerReasonReconcileFailed is used when a reconciliation step backing a condition failed
+	JaegerReasonReconcileFailed string = "ReconcileFailed"
+
+	// JaegerReasonMinimumReplicasAvailable is used when a workload has the minimum number of replicas available
+	JaegerReasonMinimumReplicasAvailable string = "MinimumReplicasAvailable"
+
+	// JaegerReasonMinimumReplicasUnavailable is used when a workload doesn't have the minimum number of replicas available
+	JaegerReasonMinimumReplicasUnavailable string = "MinimumReplicasUnavailable"
+
+	// JaegerReasonJobsCompleted is used when all the dependency jobs have completed
+	JaegerReasonJobsCompleted string = "JobsCompleted"
+
+	// JaegerReasonUpgraded is used when the instance is running the version managed by the operator
+	JaegerReasonUpgraded string = "Upgraded"
+
+	// JaegerReasonImagesUnresolved is used when the images of the version the instance is held back on can't be
+	// resolved from the images set for the operator, which are then used instead
+	JaegerReasonImagesUnresolved string = "ImagesUnresolved"
+)
+
 // ValidStorageTypes returns the list of valid storage types
 func ValidStorageTypes() []JaegerStorageType {
 	return []JaegerStorageType{
@@ -132,6 +310,18 @@ type JaegerSpec struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Strategy"
 	Strategy DeploymentStrategy `json:"strategy,omitempty"`
 
+	// Version pins the instance to the given Jaeger version, which can't be newer than the version of the operator.
+	// The instance is upgraded only once this is changed, regardless of the upgrade policy.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Version"
+	Version string `json:"version,omitempty"`
+
+	// UpgradePolicy defines whether the instance is upgraded to the Jaeger version of the operator automatically (Auto,
+	// the default) or stays on its current version (Manual). Ignored when the version is pinned.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Upgrade Policy"
+	UpgradePolicy JaegerUpgradePolicy `json:"upgradePolicy,omitempty"`
+
 	// +optional
 	AllInOne JaegerAllInOneSpec `json:"allInOne,omitempty"`
 
@@ -157,9 +347,18 @@ type JaegerSpec struct {
 	// +optional
 	Storage JaegerStorageSpec `json:"storage,omitempty"`
 
+	// +optional
+	Kafka JaegerKafkaSpec `json:"kafka,omitempty"`
+
 	// +optional
 	Ingress JaegerIngressSpec `json:"ingress,omitempty"`
 
+	// +optional
+	NetworkPolicy JaegerNetworkPolicySpec `json:"networkPolicy,omitempty"`
+
+	// +optional
+	Observability JaegerObservabilitySpec `json:"observability,omitempty"`
+
 	// +optional
 	JaegerCommonSpec `json:",inline,omitempty"`
 }
@@ -169,9 +368,132 @@ type JaegerStatus struct {
 	// +operator-sdk:csv:customresourcedefinitions:type=status
 	// +operator-sdk:csv:customresourcedefinitions:displayName="Version"
 	Version string `json:"version"`
+
+	// AvailableUpgrade is the newer Jaeger version brought by the operator, when the instance is held back on its
+	// version by its pinned version or upgrade policy
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +operator-sdk:csv:customresourcedefinitions:displayName="Available Upgrade"
+	AvailableUpgrade string `json:"availableUpgrade,omitempty"`
+
 	// +operator-sdk:csv:customresourcedefinitions:type=status
 	// +operator-sdk:csv:customresourcedefinitions:displayName="Phase"
 	Phase JaegerPhase `json:"phase"`
+
+	// ObservedGeneration is the most recent generation of the Jaeger CR that has been reconciled
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the latest available observations of the instance's state
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +operator-sdk:csv:customresourcedefinitions:displayName="Conditions"
+	// +operator-sdk:csv:customresourcedefinitions:xDescriptors="urn:alm:descriptor:io.kubernetes.conditions"
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Components lists the observed state of the workloads backing the instance
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +operator-sdk:csv:customresourcedefinitions:displayName="Components"
+	Components []JaegerComponentStatus `json:"components,omitempty"`
+
+	// ComponentsReady summarizes how many of the components are ready, such as "2/3"
+	// +optional
+	ComponentsReady string `json:"componentsReady,omitempty"`
+
+	// CassandraSchema is the observed state of the Cassandra schema managed by the create-schema job
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=status
+	// +operator-sdk:csv:customresourcedefinitions:displayName="Cassandra Schema"
+	CassandraSchema *JaegerCassandraSchemaStatus `json:"cassandraSchema,omitempty"`
+}
+
+// JaegerCassandraSchemaPhase represents the phase of the management of the Cassandra schema
+type JaegerCassandraSchemaPhase string
+
+const (
+	// CassandraSchemaPhaseMigrating means the keyspace is being created or migrated to the settings of the instance
+	CassandraSchemaPhaseMigrating JaegerCassandraSchemaPhase = "Migrating"
+
+	// CassandraSchemaPhaseReady means the keyspace matches the settings of the instance
+	CassandraSchemaPhaseReady JaegerCassandraSchemaPhase = "Ready"
+
+	// CassandraSchemaPhaseFailed means the last migration of the keyspace didn't complete
+	CassandraSchemaPhaseFailed JaegerCassandraSchemaPhase = "Failed"
+)
+
+// JaegerCassandraSchemaStatus defines the observed state of the Cassandra schema of an instance
+type JaegerCassandraSchemaStatus struct {
+	// Phase of the schema management, either Migrating, Ready or Failed
+	Phase JaegerCassandraSchemaPhase `json:"phase"`
+
+	// Revision identifies the schema settings below, the keyspace is migrated again when they change
+	Revision string `json:"revision"`
+
+	// Job is the name of the job creating or migrating the schema
+	// +optional
+	Job string `json:"job,omitempty"`
+
+	// Keyspace holding the trace data
+	// +optional
+	Keyspace string `json:"keyspace,omitempty"`
+
+	// Mode of the keyspace, either prod or test
+	// +optional
+	Mode string `json:"mode,omitempty"`
+
+	// Datacenter the keyspace is replicated to
+	// +optional
+	Datacenter string `json:"datacenter,omitempty"`
+
+	// ReplicationFactor of the keyspace
+	// +optional
+	ReplicationFactor int32 `json:"replicationFactor,omitempty"`
+
+	// TraceTTLSeconds is the default TTL of the tables holding the trace data
+	// +optional
+	TraceTTLSeconds int64 `json:"traceTTLSeconds,omitempty"`
+
+	// Image of the create-schema job, whose Jaeger version the schema has been created or upgraded for
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// Message describes why the last migration failed
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// JaegerComponentStatus defines the observed state of one of the workloads backing a Jaeger instance
+type JaegerComponentStatus struct {
+	// Name of the component, such as collector, query, ingester, agent or all-in-one
+	Name string `json:"name"`
+
+	// Kind of the workload backing the component, either Deployment or DaemonSet
+	Kind string `json:"kind"`
+
+	// Image used by the component's main container
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// DesiredReplicas is the number of pods that should be running for this component
+	DesiredReplicas int32 `json:"desiredReplicas"`
+
+	// ReadyReplicas is the number of pods ready for this component
+	ReadyReplicas int32 `json:"readyReplicas"`
+
+	// Endpoints lists the addresses of the services exposing this component, in the host:port format
+	// +optional
+	// +listType=atomic
+	Endpoints []string `json:"endpoints,omitempty"`
+}
+
+// Ready returns whether all the desired replicas for the component are ready
+func (c JaegerComponentStatus) Ready() bool {
+	return c.ReadyReplicas >= c.DesiredReplicas
 }
 
 // Jaeger is the Schema for the jaegers API
@@ -181,6 +503,7 @@ type JaegerStatus struct {
 // +operator-sdk:csv:customresourcedefinitions:resources={{CronJob,v1beta1},{Pod,v1},{Deployment,apps/v1}, {Ingress,networking/v1},{DaemonSets,apps/v1},{StatefulSets,apps/v1},{ConfigMaps,v1},{Service,v1}}
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phase",description="Jaeger instance's status"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.componentsReady",description="Jaeger components ready"
 // +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".status.version",description="Jaeger Version"
 // +kubebuilder:printcolumn:name="Strategy",type="string",JSONPath=".spec.strategy",description="Jaeger deployment strategy"
 // +kubebuilder:printcolumn:name="Storage",type="string",JSONPath=".spec.storage.type",description="Jaeger storage type"
@@ -296,6 +619,11 @@ type JaegerQuerySpec struct {
 	// +optional
 	// +nullable
 	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// PodDisruptionBudget configures the pod disruption budget protecting the replicas of this component during
+	// voluntary disruptions, such as node drains
+	// +optional
+	PodDisruptionBudget *JaegerPodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty"`
 }
 
 // JaegerUISpec defines the options to be used to configure the UI
@@ -310,6 +638,253 @@ type JaegerSamplingSpec struct {
 	// +optional
 	// +kubebuilder:pruning:PreserveUnknownFields
 	Options FreeForm `json:"options,omitempty"`
+
+	// Strategies holds the static sampling strategies served by the collector, in a typed form validated
+	// upon admission. Use either this or the free-form options, not both
+	// +optional
+	Strategies *SamplingStrategies `json:"strategies,omitempty"`
+
+	// Type selects how the collector determines the sampling strategies: 'file' (default) serves the static
+	// strategies from the options, while 'adaptive' calculates the sampling probabilities per operation
+	// based on the observed traffic, storing them in the span storage
+	// +optional
+	Type SamplingStrategiesType `json:"type,omitempty"`
+
+	// Adaptive holds the settings used when the type is 'adaptive'
+	// +optional
+	Adaptive *JaegerAdaptiveSamplingSpec `json:"adaptive,omitempty"`
+}
+
+// SamplingStrategiesType represents how the collector determines the sampling strategies
+type SamplingStrategiesType string
+
+const (
+	// SamplingStrategiesTypeFile serves the static strategies from a file
+	SamplingStrategiesTypeFile SamplingStrategiesType = "file"
+
+	// SamplingStrategiesTypeAdaptive calculates the strategies based on the observed traffic
+	SamplingStrategiesTypeAdaptive SamplingStrategiesType = "adaptive"
+)
+
+// JaegerAdaptiveSamplingSpec defines the settings for the adaptive sampling. Unset values fall back to the collector's defaults.
+type JaegerAdaptiveSamplingSpec struct {
+	// TargetSamplesPerSecond is the global target rate of samples per operation
+	// +optional
+	TargetSamplesPerSecond *Number `json:"targetSamplesPerSecond,omitempty"`
+
+	// DeltaTolerance is the acceptable amount of deviation, between 0 and 1, between the observed and the target samples per second
+	// +optional
+	DeltaTolerance *Number `json:"deltaTolerance,omitempty"`
+
+	// InitialSamplingProbability is the probability, between 0 and 1, used for operations without enough data yet
+	// +optional
+	InitialSamplingProbability *Number `json:"initialSamplingProbability,omitempty"`
+
+	// MinSamplingProbability is the minimum probability, between 0 and 1, for any operation
+	// +optional
+	MinSamplingProbability *Number `json:"minSamplingProbability,omitempty"`
+
+	// MinSamplesPerSecond is the lower bound of traces sampled per second for each operation
+	// +optional
+	MinSamplesPerSecond *Number `json:"minSamplesPerSecond,omitempty"`
+
+	// CalculationInterval is how often the probabilities are calculated
+	// +optional
+	CalculationInterval *metav1.Duration `json:"calculationInterval,omitempty"`
+
+	// AggregationBuckets is the amount of historical throughput data kept in memory
+	// +optional
+	AggregationBuckets *int32 `json:"aggregationBuckets,omitempty"`
+
+	// BucketsForCalculation is the amount of aggregation buckets used to calculate the probabilities
+	// +optional
+	BucketsForCalculation *int32 `json:"bucketsForCalculation,omitempty"`
+
+	// LeaderLeaseRefreshInterval is how often the collector holding the lock, calculating the probabilities, renews it
+	// +optional
+	LeaderLeaseRefreshInterval *metav1.Duration `json:"leaderLeaseRefreshInterval,omitempty"`
+
+	// FollowerLeaseRefreshInterval is how often the other collectors try to acquire the lock
+	// +optional
+	FollowerLeaseRefreshInterval *metav1.Duration `json:"followerLeaseRefreshInterval,omitempty"`
+}
+
+// JaegerKafkaSpec defines the Kafka the collector writes the spans to and the ingester reads them from, when using
+// the streaming strategy
+type JaegerKafkaSpec struct {
+	// Brokers is the comma-separated list of the brokers of an existing Kafka cluster. When set, no Kafka cluster is
+	// provisioned.
+	// +optional
+	Brokers string `json:"brokers,omitempty"`
+
+	// TLS encrypts the connection to the brokers. It's always enabled for the provisioned Kafka cluster, unless
+	// connecting without authentication.
+	// +optional
+	TLS *JaegerKafkaTLSSpec `json:"tls,omitempty"`
+
+	// Authentication configures how the collector and ingester authenticate to the brokers. The connection is left
+	// to the options of the collector and ingester when neither the brokers, TLS nor authentication are set.
+	// +optional
+	Authentication *JaegerKafkaAuthenticationSpec `json:"authentication,omitempty"`
+
+	// Topic configures the spans topic. It's created as a Strimzi KafkaTopic when the Kafka cluster is provisioned by
+	// the operator, instead of being left to the automatic creation by the brokers.
+	// +optional
+	Topic *JaegerKafkaTopicSpec `json:"topic,omitempty"`
+
+	// Provisioning configures the size of the Kafka cluster provisioned by the operator
+	// +optional
+	Provisioning *JaegerKafkaProvisioningSpec `json:"provisioning,omitempty"`
+}
+
+// JaegerKafkaProvisioningSpec defines the size of the provisioned Kafka cluster. The unset fields are taken from the
+// profile.
+type JaegerKafkaProvisioningSpec struct {
+	// Profile is the named sizing the cluster starts from: production or minimal. Defaults to the profile set for the
+	// operator with the --kafka-provisioning-profile flag.
+	// +optional
+	Profile JaegerKafkaProvisioningProfile `json:"profile,omitempty"`
+
+	// Replicas is the number of brokers
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// StorageClassName is the storage class of the volumes of the brokers. The default storage class of the
+	// cluster is used when not set.
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+
+	// Size is the size of the volume of each broker
+	// +optional
+	Size *resource.Quantity `json:"size,omitempty"`
+
+	// Resources are the compute resources of each broker
+	// +optional
+	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// JaegerKafkaTLSSpec defines the encryption of the connection to the Kafka brokers
+type JaegerKafkaTLSSpec struct {
+	// CASecretName is the name of the secret holding the CA certificate of the brokers, under the ca.crt key. The
+	// CAs of the system are used when not set.
+	// +optional
+	CASecretName string `json:"caSecretName,omitempty"`
+
+	// SkipHostVerify turns off the verification of the host names of the brokers
+	// +optional
+	SkipHostVerify bool `json:"skipHostVerify,omitempty"`
+}
+
+// JaegerKafkaAuthenticationSpec defines how the collector and ingester authenticate to the Kafka brokers
+type JaegerKafkaAuthenticationSpec struct {
+	// Type is the authentication type: none, tls, scram-sha-512, plaintext or kerberos. Only none, tls and
+	// scram-sha-512 are supported by the provisioned Kafka cluster, which defaults to tls.
+	// +optional
+	Type JaegerKafkaAuthenticationType `json:"type,omitempty"`
+
+	// SecretName is the name of the secret holding the credentials: the client certificate under the user.crt and
+	// user.key keys for tls, the username and password keys for scram-sha-512 and plaintext, and the keytab and
+	// krb5.conf keys for kerberos. Not used with the provisioned Kafka cluster, which generates the credentials.
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+
+	// Mechanism is the SASL mechanism used by the plaintext authentication: PLAIN, SCRAM-SHA-256 or SCRAM-SHA-512.
+	// Defaults to PLAIN.
+	// +optional
+	Mechanism string `json:"mechanism,omitempty"`
+
+	// Kerberos configures the principal used by the kerberos authentication
+	// +optional
+	Kerberos *JaegerKafkaKerberosSpec `json:"kerberos,omitempty"`
+}
+
+// JaegerKafkaKerberosSpec defines the Kerberos principal authenticating to the Kafka brokers
+type JaegerKafkaKerberosSpec struct {
+	// ServiceName is the Kerberos service name of the brokers. Defaults to kafka.
+	// +optional
+	ServiceName string `json:"serviceName,omitempty"`
+
+	// Realm is the Kerberos realm
+	// +optional
+	Realm string `json:"realm,omitempty"`
+
+	// Username is the Kerberos username
+	// +optional
+	Username string `json:"username,omitempty"`
+}
+
+// JaegerKafkaTopicSpec defines the spans topic. The unset fields are left to the defaults of the brokers.
+type JaegerKafkaTopicSpec struct {
+	// Partitions is the number of partitions of the topic, which bounds the number of ingesters consuming it
+	// +optional
+	Partitions *int32 `json:"partitions,omitempty"`
+
+	// Replicas is the replication factor of the topic
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// RetentionMs is for how long, in milliseconds, the spans are kept in the topic
+	// +optional
+	RetentionMs *int64 `json:"retentionMs,omitempty"`
+
+	// Compression is the compression type of the topic: uncompressed, zstd, lz4, snappy, gzip or producer
+	// +optional
+	Compression string `json:"compression,omitempty"`
+}
+
+// JaegerNetworkPolicySpec defines the network policies restricting the traffic of the Jaeger components to the
+// expected flows
+type JaegerNetworkPolicySpec struct {
+	// Enabled turns on the network policies. Disabled by default.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// IngressFrom are the peers allowed to reach the query service, such as the ingress controller. Defaults to
+	// the router on OpenShift, and to any namespace on Kubernetes.
+	// +optional
+	// +listType=atomic
+	IngressFrom []networkingv1.NetworkPolicyPeer `json:"ingressFrom,omitempty"`
+
+	// MonitoringFrom are the peers allowed to reach the admin ports, which expose the metrics. Defaults to the
+	// cluster monitoring on OpenShift, and to the Prometheus pods of any namespace on Kubernetes.
+	// +optional
+	// +listType=atomic
+	MonitoringFrom []networkingv1.NetworkPolicyPeer `json:"monitoringFrom,omitempty"`
+
+	// StorageTo are the peers running the storage, Kafka and metrics storage. By default, the components may
+	// reach any destination on the ports of the configured endpoints.
+	// +optional
+	// +listType=atomic
+	StorageTo []networkingv1.NetworkPolicyPeer `json:"storageTo,omitempty"`
+}
+
+// JaegerObservabilitySpec defines how the Jaeger components themselves are monitored
+type JaegerObservabilitySpec struct {
+	// +optional
+	Metrics JaegerMetricsSpec `json:"metrics,omitempty"`
+}
+
+// JaegerMetricsSpec defines the Prometheus Operator objects scraping the metrics exposed on the admin ports of the
+// Jaeger components, and alerting on them. They're only created when the Prometheus Operator is available.
+type JaegerMetricsSpec struct {
+	// MonitorType is the kind of monitors created for the components, either ServiceMonitor or PodMonitor. The
+	// components without a service exposing their admin port, such as the ingester, always get a PodMonitor.
+	// No monitors are created when empty.
+	// +optional
+	MonitorType JaegerMonitorType `json:"monitorType,omitempty"`
+
+	// Interval at which the metrics are scraped, such as 30s. Defaults to the interval of the Prometheus instance.
+	// +optional
+	Interval string `json:"interval,omitempty"`
+
+	// Labels are added to the monitors and rules, so that they are selected by the Prometheus instance
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// AlertRules creates a PrometheusRule with the standard alerts for the components, such as dropped spans,
+	// full queues, Kafka lag and storage write errors. Requires a monitor type, disabled by default.
+	// +optional
+	AlertRules *bool `json:"alertRules,omitempty"`
 }
 
 // JaegerIngressSpec defines the options to be used when deploying the query ingress
@@ -425,6 +1000,17 @@ type AutoScaleSpec struct {
 	// MaxReplicas sets an upper bound to the autoscaling feature. When autoscaling is enabled and no value is provided, a default value is used.
 	// +optional
 	MaxReplicas *int32 `json:"maxReplicas,omitempty"`
+
+	// Metrics replaces the metrics used to calculate the desired number of replicas, such as the Kafka consumer lag
+	// as an external metric or the spans received per pod as a pods metric. By default, the CPU and memory
+	// utilization are used, scaling up when either is above 90%.
+	// +optional
+	// +listType=atomic
+	Metrics []autoscalingv2.MetricSpec `json:"metrics,omitempty"`
+
+	// Behavior configures the scaling up and down behavior, such as the policies and stabilization windows
+	// +optional
+	Behavior *autoscalingv2.HorizontalPodAutoscalerBehavior `json:"behavior,omitempty"`
 }
 
 // JaegerCollectorSpec defines the options to be used when deploying the collector
@@ -476,6 +1062,11 @@ type JaegerCollectorSpec struct {
 
 	// +optional
 	TerminationGracePeriodSeconds *int64 `json:"terminationGracePeriodSeconds,omitempty"`
+
+	// PodDisruptionBudget configures the pod disruption budget protecting the replicas of this component during
+	// voluntary disruptions, such as node drains
+	// +optional
+	PodDisruptionBudget *JaegerPodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty"`
 }
 
 // JaegerIngesterSpec defines the options to be used when deploying the ingester
@@ -510,6 +1101,58 @@ type JaegerIngesterSpec struct {
 	// +optional
 	// +nullable
 	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// KEDA scales the ingester with a KEDA ScaledObject following the Kafka consumer lag, in place of the
+	// horizontal pod autoscaler. It's only used when KEDA is available in the cluster.
+	// +optional
+	KEDA *JaegerIngesterKEDASpec `json:"keda,omitempty"`
+
+	// PodDisruptionBudget configures the pod disruption budget protecting the replicas of this component during
+	// voluntary disruptions, such as node drains
+	// +optional
+	PodDisruptionBudget *JaegerPodDisruptionBudgetSpec `json:"podDisruptionBudget,omitempty"`
+}
+
+// JaegerPodDisruptionBudgetSpec defines the pod disruption budget of a component. Only one of minAvailable and
+// maxUnavailable can be set; when neither is, at most one replica is allowed to be unavailable.
+type JaegerPodDisruptionBudgetSpec struct {
+	// Enabled turns the pod disruption budget on or off. By default, it's created when the component is expected
+	// to run more than one replica, or when minAvailable or maxUnavailable is set.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// MinAvailable is the number, or percentage, of replicas that must remain available during a disruption
+	// +optional
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+
+	// MaxUnavailable is the number, or percentage, of replicas that can be unavailable during a disruption
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// JaegerIngesterKEDASpec defines the options for the KEDA ScaledObject scaling the ingester
+type JaegerIngesterKEDASpec struct {
+	// MinReplicas is the lower bound for the number of replicas, which can be zero. When not set, the
+	// minReplicas from the ingester spec is used.
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+
+	// LagThreshold is the average consumer lag per replica the ingester is scaled to. Defaults to 10000.
+	// +optional
+	LagThreshold *int64 `json:"lagThreshold,omitempty"`
+
+	// PollingInterval is the interval, in seconds, at which KEDA checks the lag
+	// +optional
+	PollingInterval *int32 `json:"pollingInterval,omitempty"`
+
+	// CooldownPeriod is the period, in seconds, to wait after the last time the lag was above zero before scaling to zero
+	// +optional
+	CooldownPeriod *int32 `json:"cooldownPeriod,omitempty"`
+
+	// AuthenticationRef is the name of a TriggerAuthentication holding the credentials KEDA uses to connect to Kafka.
+	// Required when the brokers need authentication and the Kafka cluster isn't provisioned by the operator.
+	// +optional
+	AuthenticationRef string `json:"authenticationRef,omitempty"`
 }
 
 // JaegerAgentSpec defines the options to be used when deploying the agent
@@ -543,8 +1186,68 @@ type JaegerAgentSpec struct {
 
 	// +optional
 	PriorityClassName string `json:"priorityClassName,omitempty"`
+
+	// SidecarMode selects what gets injected into the annotated workloads: 'agent' (default) adds a
+	// jaeger-agent sidecar, 'otlp' adds no sidecar and points the OpenTelemetry SDKs to the collector,
+	// and 'collector' adds an OpenTelemetry Collector sidecar receiving OTLP on localhost.
+	// It can be overridden per workload via the 'sidecar.jaegertracing.io/mode' annotation.
+	// +optional
+	SidecarMode AgentSidecarMode `json:"sidecarMode,omitempty"`
+
+	// Injection restricts which workloads from other namespaces may get a sidecar pointing to this instance
+	// +optional
+	Injection JaegerAgentInjectionSpec `json:"injection,omitempty"`
 }
 
+// JaegerAgentInjectionSpec defines the workloads allowed to get a sidecar pointing to the Jaeger instance.
+// Workloads from the instance's own namespace are always allowed. When neither namespaces nor a namespace
+// selector are set, workloads from any namespace are allowed.
+type JaegerAgentInjectionSpec struct {
+	// Namespaces lists the namespaces allowed to inject sidecars pointing to this instance
+	// +optional
+	// +listType=set
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// NamespaceSelector selects the namespaces allowed to inject sidecars pointing to this instance,
+	// in addition to the ones listed under namespaces
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// WorkloadSelector restricts the workloads from the allowed namespaces to the ones with matching labels
+	// +optional
+	WorkloadSelector *metav1.LabelSelector `json:"workloadSelector,omitempty"`
+
+	// Policy defines what happens to workloads which aren't allowed: 'skip' (default) admits them without
+	// a sidecar, while 'reject' refuses them
+	// +optional
+	Policy InjectionPolicy `json:"policy,omitempty"`
+}
+
+// InjectionPolicy represents what happens to workloads asking for a sidecar from a Jaeger instance which doesn't allow them
+type InjectionPolicy string
+
+const (
+	// InjectionPolicySkip admits the workload, without a sidecar
+	InjectionPolicySkip InjectionPolicy = "skip"
+
+	// InjectionPolicyReject refuses the workload
+	InjectionPolicyReject InjectionPolicy = "reject"
+)
+
+// AgentSidecarMode represents what is injected into workloads asking for a sidecar
+type AgentSidecarMode string
+
+const (
+	// AgentSidecarModeAgent injects a jaeger-agent sidecar
+	AgentSidecarModeAgent AgentSidecarMode = "agent"
+
+	// AgentSidecarModeOTLP injects no sidecar, only the environment variables for OpenTelemetry SDKs to export to the collector
+	AgentSidecarModeOTLP AgentSidecarMode = "otlp"
+
+	// AgentSidecarModeCollector injects an OpenTelemetry Collector sidecar, forwarding OTLP data to the collector
+	AgentSidecarModeCollector AgentSidecarMode = "collector"
+)
+
 // JaegerStorageSpec defines the common storage options to be used for the query and collector
 type JaegerStorageSpec struct {
 	// +optional
@@ -572,6 +1275,9 @@ type JaegerStorageSpec struct {
 	// +optional
 	Elasticsearch ElasticsearchSpec `json:"elasticsearch,omitempty"`
 
+	// +optional
+	Cassandra CassandraSpec `json:"cassandra,omitempty"`
+
 	// +optional
 	GRPCPlugin GRPCPluginSpec `json:"grpcPlugin,omitempty"`
 }
@@ -627,6 +1333,34 @@ type ElasticsearchSpec struct {
 	ProxyResources *v1.ResourceRequirements `json:"proxyResources,omitempty"`
 }
 
+// CassandraSpec represents the Cassandra cluster provisioned through cass-operator, or K8ssandra when available, when
+// the storage type is cassandra and no "cassandra.servers" option is set. The cluster is named after the instance.
+type CassandraSpec struct {
+	// Datacenter is the name of the Cassandra datacenter, defaults to "dc1". It's also the name of the
+	// CassandraDatacenter, which has to be unique in the namespace.
+	// +optional
+	Datacenter string `json:"datacenter,omitempty"`
+
+	// ServerVersion is the version of Cassandra, defaults to 4.1.5
+	// +optional
+	ServerVersion string `json:"serverVersion,omitempty"`
+
+	// Size is the number of Cassandra nodes, defaults to 3
+	// +optional
+	Size *int32 `json:"size,omitempty"`
+
+	// StorageClassName of the volumes of the Cassandra nodes, defaults to the default storage class of the cluster
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+
+	// StorageSize is the size of the volume of each Cassandra node, defaults to 10Gi
+	// +optional
+	StorageSize *resource.Quantity `json:"storageSize,omitempty"`
+
+	// +optional
+	Resources *v1.ResourceRequirements `json:"resources,omitempty"`
+}
+
 // JaegerCassandraCreateSchemaSpec holds the options related to the create-schema batch job
 type JaegerCassandraCreateSchemaSpec struct {
 	// +optional
@@ -650,10 +1384,16 @@ type JaegerCassandraCreateSchemaSpec struct {
 	// +optional
 	Mode string `json:"mode,omitempty"`
 
-	// TraceTTL sets the TTL for your trace data
+	// TraceTTL sets the TTL for your trace data.
+	// Changing it on an existing keyspace alters the default TTL of its tables, which applies to new data only.
 	// +optional
 	TraceTTL string `json:"traceTTL,omitempty"`
 
+	// ReplicationFactor of the keyspace, defaults to 2 in the "prod" mode and 1 in the "test" mode.
+	// Changing it on an existing keyspace alters its replication, a repair of the cluster is then needed.
+	// +optional
+	ReplicationFactor *int32 `json:"replicationFactor,omitempty"`
+
 	// Timeout controls the Job deadline, it defaults to 1 day.
 	// specify it with a value which can be parsed by time.ParseDuration, e.g. 24h or 120m.
 	// If the job does not succeed within that duration it transitions into a permanent error state.
@@ -4,15 +4,22 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strings"
+	"time"
 
+	"github.com/Masterminds/semver"
 	esv1 "github.com/openshift/elasticsearch-operator/apis/logging/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/jaegertracing/jaeger-operator/pkg/version"
 )
 
 const (
@@ -104,6 +111,80 @@ func (j *Jaeger) ValidateUpdate(_ runtime.Object) (admission.Warnings, error) {
 		}
 	}
 
+	samplingWarnings, err := j.Spec.Sampling.validate(j)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, selector := range []*metav1.LabelSelector{j.Spec.Agent.Injection.NamespaceSelector, j.Spec.Agent.Injection.WorkloadSelector} {
+		if _, err := metav1.LabelSelectorAsSelector(selector); err != nil {
+			return nil, fmt.Errorf("invalid selector for the sidecar injection: %w", err)
+		}
+	}
+
+	switch j.Spec.Agent.Injection.Policy {
+	case "", InjectionPolicySkip, InjectionPolicyReject:
+	default:
+		return nil, fmt.Errorf("invalid sidecar injection policy: %s", j.Spec.Agent.Injection.Policy)
+	}
+
+	for _, c := range []struct {
+		name string
+		pdb  *JaegerPodDisruptionBudgetSpec
+	}{
+		{name: "collector", pdb: j.Spec.Collector.PodDisruptionBudget},
+		{name: "query", pdb: j.Spec.Query.PodDisruptionBudget},
+		{name: "ingester", pdb: j.Spec.Ingester.PodDisruptionBudget},
+	} {
+		if c.pdb != nil && c.pdb.MinAvailable != nil && c.pdb.MaxUnavailable != nil {
+			return nil, fmt.Errorf("the pod disruption budget of the %s can't set both minAvailable and maxUnavailable", c.name)
+		}
+	}
+
+	switch j.Spec.UpgradePolicy {
+	case "", UpgradePolicyAuto, UpgradePolicyManual:
+	default:
+		return nil, fmt.Errorf("invalid upgrade policy: %s", j.Spec.UpgradePolicy)
+	}
+
+	warnings, err := j.validateVersion()
+	if err != nil {
+		return nil, err
+	}
+	warnings = append(warnings, samplingWarnings...)
+
+	otelWarnings, err := j.validateOTelCollector()
+	if err != nil {
+		return nil, err
+	}
+	warnings = append(warnings, otelWarnings...)
+
+	if err := j.validateKafkaTopic(); err != nil {
+		return nil, err
+	}
+
+	if err := j.validateKafkaConnection(); err != nil {
+		return nil, err
+	}
+
+	if err := j.validateKafkaProvisioning(); err != nil {
+		return nil, err
+	}
+
+	if err := j.validateCassandraCreateSchema(); err != nil {
+		return nil, err
+	}
+
+	if err := j.validateCassandraProvisioning(); err != nil {
+		return nil, err
+	}
+
+	switch j.Spec.Observability.Metrics.MonitorType {
+	case "", JaegerServiceMonitor, JaegerPodMonitor:
+	default:
+		return nil, fmt.Errorf("invalid monitor type: %s", j.Spec.Observability.Metrics.MonitorType)
+	}
+
 	for _, opt := range j.objsWithOptions() {
 		got := opt.DeepCopy().ToArgs()
 		if f := getAdditionalTLSFlags(got); f != nil {
@@ -111,9 +192,202 @@ func (j *Jaeger) ValidateUpdate(_ runtime.Object) (admission.Warnings, error) {
 		}
 	}
 
+	return warnings, nil
+}
+
+// validateOTelCollector checks that the OpenTelemetry Collector strategy isn't scaled beyond a single replica with a
+// storage local to each replica, and warns when an Elasticsearch cluster would have been provisioned by the
+// production strategy
+func (j *Jaeger) validateOTelCollector() (admission.Warnings, error) {
+	if j.Spec.Strategy != DeploymentStrategyOTelCollector {
+		return nil, nil
+	}
+
+	storage := j.Spec.Storage.Type
+	if storage == "" {
+		storage = JaegerMemoryStorage
+	}
+	if storage == JaegerMemoryStorage || storage == JaegerBadgerStorage {
+		collector := j.Spec.Collector
+		autoscaled := collector.Replicas == nil && collector.Autoscale != nil && *collector.Autoscale &&
+			(collector.MaxReplicas == nil || *collector.MaxReplicas > 1)
+		if (collector.Replicas != nil && *collector.Replicas > 1) || autoscaled {
+			return nil, fmt.Errorf("the OpenTelemetry Collector strategy can't run more than one replica with the %s storage, as each replica would keep its own traces", storage)
+		}
+	}
+
+	if ShouldInjectOpenShiftElasticsearchConfiguration(j.Spec.Storage) {
+		return admission.Warnings{
+			"the OpenTelemetry Collector strategy doesn't provision Elasticsearch clusters: set the 'es.server-urls' option to point to an existing cluster",
+		}, nil
+	}
+
+	return nil, nil
+}
+
+// validateVersion checks that the pinned version is known by the operator and doesn't downgrade the instance. It
+// warns when the images set for the operator can't be resolved for the pinned version.
+func (j *Jaeger) validateVersion() (admission.Warnings, error) {
+	if j.Spec.Version == "" {
+		return nil, nil
+	}
+
+	pinned, err := semver.NewVersion(j.Spec.Version)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version %s: %w", j.Spec.Version, err)
+	}
+
+	if current, err := semver.NewVersion(j.Status.Version); err == nil && pinned.LessThan(current) {
+		return nil, fmt.Errorf("the version %s is older than the version of the instance, %s: downgrades aren't supported", j.Spec.Version, j.Status.Version)
+	}
+
+	if latest, err := semver.NewVersion(version.Get().Jaeger); err == nil && pinned.GreaterThan(latest) {
+		return nil, fmt.Errorf("the version %s is newer than the version of the operator, %s", j.Spec.Version, version.Get().Jaeger)
+	}
+
+	if unresolved := j.UnresolvedImages(j.Spec.Version); len(unresolved) > 0 {
+		return admission.Warnings{fmt.Sprintf("the images set with %s can't be resolved for version %s: the instance would run the images of the operator instead", strings.Join(unresolved, ", "), j.Spec.Version)}, nil
+	}
+
 	return nil, nil
 }
 
+// validateKafkaTopic checks the spans topic and that the ingester isn't scaled beyond its number of partitions, as
+// the extra ingesters would have nothing to consume
+func (j *Jaeger) validateKafkaTopic() error {
+	topic := j.Spec.Kafka.Topic
+	if topic == nil {
+		return nil
+	}
+
+	if topic.Partitions != nil && *topic.Partitions < 1 {
+		return fmt.Errorf("the number of partitions of the kafka topic must be positive, got %d", *topic.Partitions)
+	}
+	if topic.Replicas != nil && *topic.Replicas < 1 {
+		return fmt.Errorf("the number of replicas of the kafka topic must be positive, got %d", *topic.Replicas)
+	}
+	if topic.RetentionMs != nil && *topic.RetentionMs < -1 {
+		return fmt.Errorf("invalid retention of the kafka topic: %d", *topic.RetentionMs)
+	}
+
+	switch topic.Compression {
+	case "", "uncompressed", "zstd", "lz4", "snappy", "gzip", "producer":
+	default:
+		return fmt.Errorf("invalid compression of the kafka topic: %s", topic.Compression)
+	}
+
+	if j.Spec.Strategy == DeploymentStrategyStreaming && topic.Partitions != nil &&
+		j.Spec.Ingester.MaxReplicas != nil && *j.Spec.Ingester.MaxReplicas > *topic.Partitions {
+		return fmt.Errorf("the ingester's maxReplicas, %d, is greater than the number of partitions of the kafka topic, %d", *j.Spec.Ingester.MaxReplicas, *topic.Partitions)
+	}
+
+	return nil
+}
+
+// validateKafkaConnection checks the authentication with the brokers. The provisioned Kafka cluster only supports
+// the authentication types the Strimzi operator can create users for, and the credentials of an external cluster
+// have to be given.
+func (j *Jaeger) validateKafkaConnection() error {
+	auth := j.Spec.Kafka.Authentication
+	if auth == nil {
+		return nil
+	}
+
+	// the brokers set in the options point to an external cluster as well, unless the operator placed them there
+	_, pfound := j.Spec.Collector.Options.GenericMap()["kafka.producer.brokers"]
+	_, cfound := j.Spec.Ingester.Options.GenericMap()["kafka.consumer.brokers"]
+	provisioned := j.Annotations[AnnotationProvisionedKafkaKey] == AnnotationProvisionedKafkaValue
+	external := j.Spec.Kafka.Brokers != "" || ((pfound || cfound) && !provisioned)
+
+	switch auth.Type {
+	case "", KafkaAuthenticationNone, KafkaAuthenticationTLS, KafkaAuthenticationSCRAMSHA512:
+	case KafkaAuthenticationPlaintext, KafkaAuthenticationKerberos:
+		if !external {
+			return fmt.Errorf("the kafka authentication type %s requires the brokers of an external cluster", auth.Type)
+		}
+	default:
+		return fmt.Errorf("invalid kafka authentication type: %s", auth.Type)
+	}
+
+	switch auth.Mechanism {
+	case "", "PLAIN", "SCRAM-SHA-256", "SCRAM-SHA-512":
+	default:
+		return fmt.Errorf("invalid kafka authentication mechanism: %s", auth.Mechanism)
+	}
+
+	if external && auth.Type != "" && auth.Type != KafkaAuthenticationNone && auth.SecretName == "" {
+		return fmt.Errorf("the kafka authentication type %s requires the secret with the credentials", auth.Type)
+	}
+
+	return nil
+}
+
+// validateKafkaProvisioning checks the size of the provisioned Kafka cluster
+func (j *Jaeger) validateKafkaProvisioning() error {
+	provisioning := j.Spec.Kafka.Provisioning
+	if provisioning == nil {
+		return nil
+	}
+
+	switch provisioning.Profile {
+	case "", KafkaProvisioningProfileProduction, KafkaProvisioningProfileMinimal:
+	default:
+		return fmt.Errorf("invalid kafka provisioning profile: %s", provisioning.Profile)
+	}
+
+	if provisioning.Replicas != nil && *provisioning.Replicas < 1 {
+		return fmt.Errorf("the number of replicas of the provisioned kafka cluster must be positive, got %d", *provisioning.Replicas)
+	}
+	if provisioning.Size != nil && provisioning.Size.Sign() <= 0 {
+		return fmt.Errorf("the volume size of the provisioned kafka cluster must be positive, got %s", provisioning.Size.String())
+	}
+
+	return nil
+}
+
+// validateCassandraCreateSchema checks the settings the keyspace is created or migrated with, as the create-schema job
+// would otherwise fall back to its defaults
+func (j *Jaeger) validateCassandraCreateSchema() error {
+	spec := j.Spec.Storage.CassandraCreateSchema
+
+	switch spec.Mode {
+	case "", "prod", "test":
+	default:
+		return fmt.Errorf("invalid cassandra create-schema mode: %s", spec.Mode)
+	}
+
+	if spec.TraceTTL != "" {
+		if _, err := time.ParseDuration(spec.TraceTTL); err != nil {
+			return fmt.Errorf("invalid cassandra create-schema trace TTL %s: %w", spec.TraceTTL, err)
+		}
+	}
+	if spec.ReplicationFactor != nil && *spec.ReplicationFactor < 1 {
+		return fmt.Errorf("the replication factor of the cassandra keyspace must be positive, got %d", *spec.ReplicationFactor)
+	}
+
+	return nil
+}
+
+// validateCassandraProvisioning checks the size of the Cassandra cluster provisioned for the instance
+func (j *Jaeger) validateCassandraProvisioning() error {
+	spec := j.Spec.Storage.Cassandra
+
+	if spec.Size != nil && *spec.Size < 1 {
+		return fmt.Errorf("the size of the provisioned cassandra cluster must be positive, got %d", *spec.Size)
+	}
+	if spec.StorageSize != nil && spec.StorageSize.Sign() <= 0 {
+		return fmt.Errorf("the volume size of the provisioned cassandra cluster must be positive, got %s", spec.StorageSize.String())
+	}
+	if spec.Datacenter != "" {
+		// the datacenter is also the name of the CassandraDatacenter
+		if errs := validation.IsDNS1123Label(spec.Datacenter); len(errs) > 0 {
+			return fmt.Errorf("invalid datacenter %s for the provisioned cassandra cluster: %s", spec.Datacenter, strings.Join(errs, ", "))
+		}
+	}
+
+	return nil
+}
+
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
 func (j *Jaeger) ValidateDelete() (admission.Warnings, error) {
 	jaegerlog.Info("validate delete", "name", j.Name)
@@ -6,14 +6,19 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	esv1 "github.com/openshift/elasticsearch-operator/apis/logging/v1"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/jaegertracing/jaeger-operator/pkg/version"
 )
 
 var (
@@ -189,6 +194,8 @@ func TestValidateDelete(t *testing.T) {
 }
 
 func TestValidate(t *testing.T) {
+	zero32, six32, ten32 := int32(0), int32(6), int32(10)
+	trueVar := true
 	tests := []struct {
 		name         string
 		objsToCreate []runtime.Object
@@ -274,6 +281,435 @@ func TestValidate(t *testing.T) {
 			},
 			err: `tls flags incomplete, got: [--something.tls.else=fails]`,
 		},
+		{
+			name: "invalid injection selector",
+			current: &Jaeger{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "project1",
+				},
+				Spec: JaegerSpec{
+					Agent: JaegerAgentSpec{
+						Injection: JaegerAgentInjectionSpec{
+							NamespaceSelector: &metav1.LabelSelector{
+								MatchExpressions: []metav1.LabelSelectorRequirement{{
+									Key:      "team",
+									Operator: "Maybe",
+								}},
+							},
+						},
+					},
+				},
+			},
+			err: `invalid selector for the sidecar injection: "Maybe" is not a valid label selector operator`,
+		},
+		{
+			name: "invalid injection policy",
+			current: &Jaeger{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "project1",
+				},
+				Spec: JaegerSpec{
+					Agent: JaegerAgentSpec{
+						Injection: JaegerAgentInjectionSpec{
+							Policy: "ignore",
+						},
+					},
+				},
+			},
+			err: `invalid sidecar injection policy: ignore`,
+		},
+		{
+			name: "pod disruption budget with both bounds",
+			current: &Jaeger{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "project1",
+				},
+				Spec: JaegerSpec{
+					Collector: JaegerCollectorSpec{
+						PodDisruptionBudget: &JaegerPodDisruptionBudgetSpec{
+							MinAvailable:   &intstr.IntOrString{Type: intstr.Int, IntVal: 1},
+							MaxUnavailable: &intstr.IntOrString{Type: intstr.String, StrVal: "50%"},
+						},
+					},
+				},
+			},
+			err: `the pod disruption budget of the collector can't set both minAvailable and maxUnavailable`,
+		},
+		{
+			name: "invalid monitor type",
+			current: &Jaeger{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "project1",
+				},
+				Spec: JaegerSpec{
+					Observability: JaegerObservabilitySpec{
+						Metrics: JaegerMetricsSpec{
+							MonitorType: "Probe",
+						},
+					},
+				},
+			},
+			err: `invalid monitor type: Probe`,
+		},
+		{
+			name: "invalid kafka topic partitions",
+			current: &Jaeger{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "project1",
+				},
+				Spec: JaegerSpec{
+					Kafka: JaegerKafkaSpec{
+						Topic: &JaegerKafkaTopicSpec{
+							Partitions: &zero32,
+						},
+					},
+				},
+			},
+			err: `the number of partitions of the kafka topic must be positive, got 0`,
+		},
+		{
+			name: "invalid kafka topic compression",
+			current: &Jaeger{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "project1",
+				},
+				Spec: JaegerSpec{
+					Kafka: JaegerKafkaSpec{
+						Topic: &JaegerKafkaTopicSpec{
+							Compression: "brotli",
+						},
+					},
+				},
+			},
+			err: `invalid compression of the kafka topic: brotli`,
+		},
+		{
+			name: "ingester scaled beyond the kafka topic partitions",
+			current: &Jaeger{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "project1",
+				},
+				Spec: JaegerSpec{
+					Strategy: DeploymentStrategyStreaming,
+					Ingester: JaegerIngesterSpec{
+						AutoScaleSpec: AutoScaleSpec{
+							MaxReplicas: &ten32,
+						},
+					},
+					Kafka: JaegerKafkaSpec{
+						Topic: &JaegerKafkaTopicSpec{
+							Partitions: &six32,
+						},
+					},
+				},
+			},
+			err: `the ingester's maxReplicas, 10, is greater than the number of partitions of the kafka topic, 6`,
+		},
+		{
+			name: "ingester scaled within the kafka topic partitions",
+			current: &Jaeger{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "project1",
+				},
+				Spec: JaegerSpec{
+					Strategy: DeploymentStrategyStreaming,
+					Ingester: JaegerIngesterSpec{
+						AutoScaleSpec: AutoScaleSpec{
+							MaxReplicas: &six32,
+						},
+					},
+					Kafka: JaegerKafkaSpec{
+						Topic: &JaegerKafkaTopicSpec{
+							Partitions:  &six32,
+							Compression: "zstd",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "invalid kafka authentication type",
+			current: &Jaeger{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "project1",
+				},
+				Spec: JaegerSpec{
+					Kafka: JaegerKafkaSpec{
+						Authentication: &JaegerKafkaAuthenticationSpec{
+							Type: "oauth",
+						},
+					},
+				},
+			},
+			err: `invalid kafka authentication type: oauth`,
+		},
+		{
+			name: "invalid kafka authentication mechanism",
+			current: &Jaeger{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "project1",
+				},
+				Spec: JaegerSpec{
+					Kafka: JaegerKafkaSpec{
+						Brokers: "kafka:9092",
+						Authentication: &JaegerKafkaAuthenticationSpec{
+							Type:       KafkaAuthenticationPlaintext,
+							SecretName: "kafka-user",
+							Mechanism:  "GSSAPI",
+						},
+					},
+				},
+			},
+			err: `invalid kafka authentication mechanism: GSSAPI`,
+		},
+		{
+			name: "kafka credentials missing for an external cluster",
+			current: &Jaeger{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "project1",
+				},
+				Spec: JaegerSpec{
+					Kafka: JaegerKafkaSpec{
+						Brokers: "kafka:9094",
+						Authentication: &JaegerKafkaAuthenticationSpec{
+							Type: KafkaAuthenticationSCRAMSHA512,
+						},
+					},
+				},
+			},
+			err: `the kafka authentication type scram-sha-512 requires the secret with the credentials`,
+		},
+		{
+			name: "kerberos with the provisioned kafka cluster",
+			current: &Jaeger{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "project1",
+				},
+				Spec: JaegerSpec{
+					Kafka: JaegerKafkaSpec{
+						Authentication: &JaegerKafkaAuthenticationSpec{
+							Type:       KafkaAuthenticationKerberos,
+							SecretName: "kafka-keytab",
+						},
+					},
+				},
+			},
+			err: `the kafka authentication type kerberos requires the brokers of an external cluster`,
+		},
+		{
+			name: "scram with the provisioned kafka cluster",
+			current: &Jaeger{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "project1",
+				},
+				Spec: JaegerSpec{
+					Kafka: JaegerKafkaSpec{
+						Authentication: &JaegerKafkaAuthenticationSpec{
+							Type: KafkaAuthenticationSCRAMSHA512,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "invalid kafka provisioning profile",
+			current: &Jaeger{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "project1",
+				},
+				Spec: JaegerSpec{
+					Kafka: JaegerKafkaSpec{
+						Provisioning: &JaegerKafkaProvisioningSpec{
+							Profile: "large",
+						},
+					},
+				},
+			},
+			err: `invalid kafka provisioning profile: large`,
+		},
+		{
+			name: "invalid kafka provisioning replicas",
+			current: &Jaeger{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "project1",
+				},
+				Spec: JaegerSpec{
+					Kafka: JaegerKafkaSpec{
+						Provisioning: &JaegerKafkaProvisioningSpec{
+							Profile:  KafkaProvisioningProfileMinimal,
+							Replicas: &zero32,
+						},
+					},
+				},
+			},
+			err: `the number of replicas of the provisioned kafka cluster must be positive, got 0`,
+		},
+		{
+			name: "invalid cassandra create-schema mode",
+			current: &Jaeger{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "project1",
+				},
+				Spec: JaegerSpec{
+					Storage: JaegerStorageSpec{
+						CassandraCreateSchema: JaegerCassandraCreateSchemaSpec{
+							Mode: "dev",
+						},
+					},
+				},
+			},
+			err: `invalid cassandra create-schema mode: dev`,
+		},
+		{
+			name: "invalid cassandra create-schema trace TTL",
+			current: &Jaeger{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "project1",
+				},
+				Spec: JaegerSpec{
+					Storage: JaegerStorageSpec{
+						CassandraCreateSchema: JaegerCassandraCreateSchemaSpec{
+							TraceTTL: "7d",
+						},
+					},
+				},
+			},
+			err: `invalid cassandra create-schema trace TTL 7d: time: unknown unit "d" in duration "7d"`,
+		},
+		{
+			name: "invalid cassandra replication factor",
+			current: &Jaeger{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "project1",
+				},
+				Spec: JaegerSpec{
+					Storage: JaegerStorageSpec{
+						CassandraCreateSchema: JaegerCassandraCreateSchemaSpec{
+							ReplicationFactor: &zero32,
+						},
+					},
+				},
+			},
+			err: `the replication factor of the cassandra keyspace must be positive, got 0`,
+		},
+		{
+			name: "invalid cassandra cluster size",
+			current: &Jaeger{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "project1",
+				},
+				Spec: JaegerSpec{
+					Storage: JaegerStorageSpec{
+						Cassandra: CassandraSpec{
+							Size: &zero32,
+						},
+					},
+				},
+			},
+			err: `the size of the provisioned cassandra cluster must be positive, got 0`,
+		},
+		{
+			name: "invalid cassandra datacenter",
+			current: &Jaeger{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "project1",
+				},
+				Spec: JaegerSpec{
+					Storage: JaegerStorageSpec{
+						Cassandra: CassandraSpec{
+							Datacenter: "DC_1",
+						},
+					},
+				},
+			},
+			err: `invalid datacenter DC_1 for the provisioned cassandra cluster: a lowercase RFC 1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?')`,
+		},
+		{
+			name: "otel collector replicas with memory storage",
+			current: &Jaeger{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "project1",
+				},
+				Spec: JaegerSpec{
+					Strategy: DeploymentStrategyOTelCollector,
+					Collector: JaegerCollectorSpec{
+						Replicas: &six32,
+					},
+				},
+			},
+			err: `the OpenTelemetry Collector strategy can't run more than one replica with the memory storage, as each replica would keep its own traces`,
+		},
+		{
+			name: "otel collector autoscaled with badger storage",
+			current: &Jaeger{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "project1",
+				},
+				Spec: JaegerSpec{
+					Strategy: DeploymentStrategyOTelCollector,
+					Storage: JaegerStorageSpec{
+						Type: JaegerBadgerStorage,
+					},
+					Collector: JaegerCollectorSpec{
+						AutoScaleSpec: AutoScaleSpec{
+							Autoscale: &trueVar,
+						},
+					},
+				},
+			},
+			err: `the OpenTelemetry Collector strategy can't run more than one replica with the badger storage, as each replica would keep its own traces`,
+		},
+		{
+			name: "invalid upgrade policy",
+			current: &Jaeger{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "project1",
+				},
+				Spec: JaegerSpec{
+					UpgradePolicy: "Never",
+				},
+			},
+			err: `invalid upgrade policy: Never`,
+		},
+		{
+			name: "invalid version",
+			current: &Jaeger{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "project1",
+				},
+				Spec: JaegerSpec{
+					Version: "latest",
+				},
+			},
+			err: `invalid version latest: Invalid Semantic Version`,
+		},
+		{
+			name: "version newer than the operator",
+			current: &Jaeger{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "project1",
+				},
+				Spec: JaegerSpec{
+					Version: "99.0.0",
+				},
+			},
+			err: `the version 99.0.0 is newer than the version of the operator, 0.0.0`,
+		},
+		{
+			name: "version downgrading the instance",
+			current: &Jaeger{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "project1",
+				},
+				Spec: JaegerSpec{
+					Version: "1.40.0",
+				},
+				Status: JaegerStatus{
+					Version: "1.50.0",
+				},
+			},
+			err: `the version 1.40.0 is older than the version of the instance, 1.50.0: downgrades aren't supported`,
+		},
 	}
 
 	for _, test := range tests {
@@ -295,6 +731,47 @@ func TestValidate(t *testing.T) {
 	}
 }
 
+func TestValidateOTelCollectorWithElasticsearchProvisioning(t *testing.T) {
+	j := &Jaeger{
+		Spec: JaegerSpec{
+			Strategy: DeploymentStrategyOTelCollector,
+			Storage: JaegerStorageSpec{
+				Type: JaegerESStorage,
+			},
+		},
+	}
+
+	warnings, err := j.validateOTelCollector()
+	require.NoError(t, err)
+	assert.Equal(t, admission.Warnings{
+		"the OpenTelemetry Collector strategy doesn't provision Elasticsearch clusters: set the 'es.server-urls' option to point to an existing cluster",
+	}, warnings)
+}
+
+func TestValidateVersionWithUnresolvedImages(t *testing.T) {
+	viper.Set("jaeger-all-in-one-image", "org/all-in-one@sha256:0123")
+	viper.Set("jaeger-agent-image", "org/agent:"+version.Get().Agent)
+	defer viper.Reset()
+
+	j := &Jaeger{
+		Spec: JaegerSpec{
+			Version: "0.0.0-old",
+		},
+	}
+
+	warnings, err := j.validateVersion()
+	require.NoError(t, err)
+	assert.Equal(t, admission.Warnings{
+		"the images set with jaeger-all-in-one-image can't be resolved for version 0.0.0-old: the instance would run the images of the operator instead",
+	}, warnings)
+
+	// the image of the spec is used as it is
+	j.Spec.AllInOne.Image = "org/all-in-one:0.0.0-old"
+	warnings, err = j.validateVersion()
+	require.NoError(t, err)
+	assert.Empty(t, warnings)
+}
+
 func TestShouldDeployElasticsearch(t *testing.T) {
 	tests := []struct {
 		j        JaegerStorageSpec
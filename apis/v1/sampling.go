@@ -0,0 +1,293 @@
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// SamplingStrategyType represents the type of a sampling strategy
+type SamplingStrategyType string
+
+const (
+	// SamplingStrategyTypeProbabilistic samples traces with the probability given as param
+	SamplingStrategyTypeProbabilistic SamplingStrategyType = "probabilistic"
+
+	// SamplingStrategyTypeRateLimiting samples up to the amount of traces per second given as param
+	SamplingStrategyTypeRateLimiting SamplingStrategyType = "ratelimiting"
+)
+
+// Number is a decimal number, like a probability, kept as written: floats aren't allowed in the API types, as they
+// don't round-trip reliably between the clients of the API
+// +kubebuilder:validation:Type=number
+type Number string
+
+// NewNumber returns the number with the given value
+func NewNumber(f float64) Number {
+	return Number(strconv.FormatFloat(f, 'f', -1, 64))
+}
+
+// Float64 returns the value of the number
+func (n Number) Float64() (float64, error) {
+	return strconv.ParseFloat(string(n), 64)
+}
+
+// MarshalJSON writes the number as a JSON number
+func (n Number) MarshalJSON() ([]byte, error) {
+	return json.Marshal(json.Number(n))
+}
+
+// UnmarshalJSON reads the number from a JSON number, or a string holding one
+func (n *Number) UnmarshalJSON(b []byte) error {
+	var v json.Number
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	*n = Number(v)
+	return nil
+}
+
+// SamplingStrategies represents the sampling strategies served by the collector, in the format of the strategies file
+type SamplingStrategies struct {
+	// +optional
+	DefaultStrategy *DefaultSamplingStrategy `json:"default_strategy,omitempty"`
+
+	// +optional
+	ServiceStrategies []ServiceSamplingStrategy `json:"service_strategies,omitempty"`
+}
+
+// DefaultSamplingStrategy is the strategy used for services without a strategy of their own
+type DefaultSamplingStrategy struct {
+	Type SamplingStrategyType `json:"type"`
+
+	Param Number `json:"param"`
+
+	// +optional
+	OperationStrategies []OperationSamplingStrategy `json:"operation_strategies,omitempty"`
+}
+
+// ServiceSamplingStrategy is the strategy used for the given service
+type ServiceSamplingStrategy struct {
+	Service string `json:"service"`
+
+	Type SamplingStrategyType `json:"type"`
+
+	Param Number `json:"param"`
+
+	// +optional
+	OperationStrategies []OperationSamplingStrategy `json:"operation_strategies,omitempty"`
+}
+
+// OperationSamplingStrategy is the strategy used for the given operation, which can only be probabilistic
+type OperationSamplingStrategy struct {
+	Operation string `json:"operation"`
+
+	Type SamplingStrategyType `json:"type"`
+
+	Param Number `json:"param"`
+}
+
+// Validate checks the sampling strategies, which would otherwise only fail once loaded by the collector
+func (s *SamplingStrategies) Validate() error {
+	if s.DefaultStrategy != nil {
+		if err := validateSamplingStrategy("default_strategy", s.DefaultStrategy.Type, s.DefaultStrategy.Param); err != nil {
+			return err
+		}
+		if err := validateOperationStrategies("default_strategy", s.DefaultStrategy.OperationStrategies); err != nil {
+			return err
+		}
+	}
+
+	services := map[string]bool{}
+	for i, svc := range s.ServiceStrategies {
+		path := fmt.Sprintf("service_strategies[%d]", i)
+		if svc.Service == "" {
+			return fmt.Errorf("%s: the service is required", path)
+		}
+		if services[svc.Service] {
+			return fmt.Errorf("%s: duplicate strategy for the service %s", path, svc.Service)
+		}
+		services[svc.Service] = true
+
+		if err := validateSamplingStrategy(path, svc.Type, svc.Param); err != nil {
+			return err
+		}
+		if err := validateOperationStrategies(path, svc.OperationStrategies); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateOperationStrategies(parent string, strategies []OperationSamplingStrategy) error {
+	operations := map[string]bool{}
+	for i, op := range strategies {
+		path := fmt.Sprintf("%s.operation_strategies[%d]", parent, i)
+		if op.Operation == "" {
+			return fmt.Errorf("%s: the operation is required", path)
+		}
+		if operations[op.Operation] {
+			return fmt.Errorf("%s: duplicate strategy for the operation %s", path, op.Operation)
+		}
+		operations[op.Operation] = true
+
+		if op.Type != SamplingStrategyTypeProbabilistic {
+			return fmt.Errorf("%s: only probabilistic strategies are supported per operation, got %q", path, op.Type)
+		}
+		if err := validateSamplingStrategy(path, op.Type, op.Param); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateSamplingStrategy(path string, typ SamplingStrategyType, param Number) error {
+	value, err := param.Float64()
+	if err != nil {
+		return fmt.Errorf("%s: the param must be a number, got %q", path, param)
+	}
+
+	switch typ {
+	case SamplingStrategyTypeProbabilistic:
+		if value < 0 || value > 1 {
+			return fmt.Errorf("%s: the probability must be between 0 and 1, got %v", path, value)
+		}
+	case SamplingStrategyTypeRateLimiting:
+		if value < 0 {
+			return fmt.Errorf("%s: the amount of traces per second can't be negative, got %v", path, value)
+		}
+	default:
+		return fmt.Errorf("%s: unknown sampling strategy type %q", path, typ)
+	}
+	return nil
+}
+
+// validate checks the sampling spec for the given instance: the typed strategies document, and for the adaptive
+// sampling, the settings and whether the span storage is able to hold the calculated probabilities. The strategies
+// from the free-form options are checked too, but only result in warnings, as they have always been accepted as is.
+func (s JaegerSamplingSpec) validate(jaeger *Jaeger) (admission.Warnings, error) {
+	if s.Strategies != nil && !s.Options.IsEmpty() {
+		return nil, fmt.Errorf("the sampling strategies can be set either as options or as strategies, not both")
+	}
+
+	var warnings admission.Warnings
+	if !s.Options.IsEmpty() {
+		if err := validateFreeFormStrategies(s.Options); err != nil {
+			warnings = append(warnings, fmt.Sprintf("the sampling options might be rejected by the collector: %v", err))
+		}
+	}
+	if s.Strategies != nil {
+		if err := s.Strategies.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid sampling strategies: %w", err)
+		}
+	}
+
+	return warnings, s.validateType(jaeger)
+}
+
+// validateFreeFormStrategies checks the strategies document given as free-form options
+func validateFreeFormStrategies(options FreeForm) error {
+	raw, err := options.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	strategies := &SamplingStrategies{}
+	if err := json.Unmarshal(raw, strategies); err != nil {
+		return fmt.Errorf("invalid sampling strategies: %w", err)
+	}
+	if err := strategies.Validate(); err != nil {
+		return fmt.Errorf("invalid sampling strategies: %w", err)
+	}
+	return nil
+}
+
+// validateType checks the sampling type, and for the adaptive sampling, its settings
+func (s JaegerSamplingSpec) validateType(jaeger *Jaeger) error {
+	switch s.Type {
+	case "", SamplingStrategiesTypeFile:
+		return nil
+	case SamplingStrategiesTypeAdaptive:
+	default:
+		return fmt.Errorf("invalid sampling type: %s", s.Type)
+	}
+
+	if jaeger.Spec.Strategy == DeploymentStrategyStreaming {
+		return fmt.Errorf("adaptive sampling isn't supported with the streaming strategy, as the collector doesn't have access to the span storage")
+	}
+	switch jaeger.Spec.Storage.Type {
+	case "", JaegerMemoryStorage, JaegerBadgerStorage, JaegerCassandraStorage, JaegerESStorage:
+	default:
+		return fmt.Errorf("adaptive sampling isn't supported with the storage type %s", jaeger.Spec.Storage.Type)
+	}
+
+	a := s.Adaptive
+	if a == nil {
+		return nil
+	}
+	numbers := map[string]float64{}
+	for _, f := range []struct {
+		name string
+		val  *Number
+	}{
+		{"targetSamplesPerSecond", a.TargetSamplesPerSecond},
+		{"deltaTolerance", a.DeltaTolerance},
+		{"initialSamplingProbability", a.InitialSamplingProbability},
+		{"minSamplingProbability", a.MinSamplingProbability},
+		{"minSamplesPerSecond", a.MinSamplesPerSecond},
+	} {
+		if f.val == nil {
+			continue
+		}
+		value, err := f.val.Float64()
+		if err != nil {
+			return fmt.Errorf("adaptive sampling: %s must be a number, got %q", f.name, *f.val)
+		}
+		numbers[f.name] = value
+	}
+	for _, name := range []string{"deltaTolerance", "initialSamplingProbability", "minSamplingProbability"} {
+		if value, ok := numbers[name]; ok && (value < 0 || value > 1) {
+			return fmt.Errorf("adaptive sampling: %s must be between 0 and 1, got %v", name, value)
+		}
+	}
+	if value, ok := numbers["targetSamplesPerSecond"]; ok && value <= 0 {
+		return fmt.Errorf("adaptive sampling: targetSamplesPerSecond must be positive, got %v", value)
+	}
+	if value, ok := numbers["minSamplesPerSecond"]; ok && value < 0 {
+		return fmt.Errorf("adaptive sampling: minSamplesPerSecond can't be negative, got %v", value)
+	}
+	minimum, hasMinimum := numbers["minSamplingProbability"]
+	initial, hasInitial := numbers["initialSamplingProbability"]
+	if hasMinimum && hasInitial && minimum > initial {
+		return fmt.Errorf("adaptive sampling: minSamplingProbability can't be greater than initialSamplingProbability")
+	}
+	for _, f := range []struct {
+		name string
+		val  *int32
+	}{
+		{"aggregationBuckets", a.AggregationBuckets},
+		{"bucketsForCalculation", a.BucketsForCalculation},
+	} {
+		if f.val != nil && *f.val <= 0 {
+			return fmt.Errorf("adaptive sampling: %s must be positive, got %d", f.name, *f.val)
+		}
+	}
+	if a.AggregationBuckets != nil && a.BucketsForCalculation != nil && *a.BucketsForCalculation > *a.AggregationBuckets {
+		return fmt.Errorf("adaptive sampling: bucketsForCalculation can't be greater than aggregationBuckets")
+	}
+	for _, f := range []struct {
+		name string
+		val  *metav1.Duration
+	}{
+		{"calculationInterval", a.CalculationInterval},
+		{"leaderLeaseRefreshInterval", a.LeaderLeaseRefreshInterval},
+		{"followerLeaseRefreshInterval", a.FollowerLeaseRefreshInterval},
+	} {
+		if f.val != nil && f.val.Duration <= 0 {
+			return fmt.Errorf("adaptive sampling: %s must be positive, got %s", f.name, f.val.Duration)
+		}
+	}
+	return nil
+}
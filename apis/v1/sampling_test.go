@@ -0,0 +1,315 @@
+package v1
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func TestNumberJSON(t *testing.T) {
+	// prepare
+	strategy := &DefaultSamplingStrategy{}
+
+	// test
+	require.NoError(t, json.Unmarshal([]byte(`{"type":"probabilistic","param":0.25}`), strategy))
+	out, err := json.Marshal(strategy)
+
+	// verify
+	require.NoError(t, err)
+	assert.Equal(t, Number("0.25"), strategy.Param)
+	assert.JSONEq(t, `{"type":"probabilistic","param":0.25}`, string(out))
+
+	require.NoError(t, json.Unmarshal([]byte(`{"param":"0.5"}`), strategy))
+	assert.Equal(t, Number("0.5"), strategy.Param)
+	assert.Error(t, json.Unmarshal([]byte(`{"param":"half"}`), strategy))
+}
+
+func TestSamplingStrategiesValidate(t *testing.T) {
+	for _, tt := range []struct {
+		name       string
+		strategies SamplingStrategies
+		err        string
+	}{
+		{
+			name: "valid",
+			strategies: SamplingStrategies{
+				DefaultStrategy: &DefaultSamplingStrategy{
+					Type:  SamplingStrategyTypeProbabilistic,
+					Param: "0.5",
+					OperationStrategies: []OperationSamplingStrategy{
+						{Operation: "/health", Type: SamplingStrategyTypeProbabilistic, Param: "0"},
+					},
+				},
+				ServiceStrategies: []ServiceSamplingStrategy{
+					{Service: "foo", Type: SamplingStrategyTypeRateLimiting, Param: "100"},
+					{Service: "bar", Type: SamplingStrategyTypeProbabilistic, Param: "1"},
+				},
+			},
+		},
+		{
+			name: "typo in the type",
+			strategies: SamplingStrategies{
+				DefaultStrategy: &DefaultSamplingStrategy{Type: "probabilstic", Param: "0.5"},
+			},
+			err: `default_strategy: unknown sampling strategy type "probabilstic"`,
+		},
+		{
+			name: "param not a number",
+			strategies: SamplingStrategies{
+				DefaultStrategy: &DefaultSamplingStrategy{Type: SamplingStrategyTypeProbabilistic, Param: "half"},
+			},
+			err: `default_strategy: the param must be a number, got "half"`,
+		},
+		{
+			name: "probability out of range",
+			strategies: SamplingStrategies{
+				ServiceStrategies: []ServiceSamplingStrategy{
+					{Service: "foo", Type: SamplingStrategyTypeProbabilistic, Param: "50"},
+				},
+			},
+			err: "service_strategies[0]: the probability must be between 0 and 1, got 50",
+		},
+		{
+			name: "negative rate",
+			strategies: SamplingStrategies{
+				DefaultStrategy: &DefaultSamplingStrategy{Type: SamplingStrategyTypeRateLimiting, Param: "-1"},
+			},
+			err: "default_strategy: the amount of traces per second can't be negative, got -1",
+		},
+		{
+			name: "missing service",
+			strategies: SamplingStrategies{
+				ServiceStrategies: []ServiceSamplingStrategy{
+					{Type: SamplingStrategyTypeProbabilistic, Param: "0.1"},
+				},
+			},
+			err: "service_strategies[0]: the service is required",
+		},
+		{
+			name: "duplicate service",
+			strategies: SamplingStrategies{
+				ServiceStrategies: []ServiceSamplingStrategy{
+					{Service: "foo", Type: SamplingStrategyTypeProbabilistic, Param: "0.1"},
+					{Service: "foo", Type: SamplingStrategyTypeProbabilistic, Param: "0.2"},
+				},
+			},
+			err: "service_strategies[1]: duplicate strategy for the service foo",
+		},
+		{
+			name: "rate limiting per operation",
+			strategies: SamplingStrategies{
+				ServiceStrategies: []ServiceSamplingStrategy{{
+					Service: "foo",
+					Type:    SamplingStrategyTypeProbabilistic,
+					Param:   "0.1",
+					OperationStrategies: []OperationSamplingStrategy{
+						{Operation: "op", Type: SamplingStrategyTypeRateLimiting, Param: "10"},
+					},
+				}},
+			},
+			err: `service_strategies[0].operation_strategies[0]: only probabilistic strategies are supported per operation, got "ratelimiting"`,
+		},
+		{
+			name: "duplicate operation",
+			strategies: SamplingStrategies{
+				DefaultStrategy: &DefaultSamplingStrategy{
+					Type:  SamplingStrategyTypeProbabilistic,
+					Param: "0.1",
+					OperationStrategies: []OperationSamplingStrategy{
+						{Operation: "op", Type: SamplingStrategyTypeProbabilistic, Param: "0.1"},
+						{Operation: "op", Type: SamplingStrategyTypeProbabilistic, Param: "0.2"},
+					},
+				},
+			},
+			err: "default_strategy.operation_strategies[1]: duplicate strategy for the operation op",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.strategies.Validate()
+			if tt.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
+
+func TestSamplingSpecValidate(t *testing.T) {
+	number := func(f float64) *Number { n := NewNumber(f); return &n }
+	int32p := func(i int32) *int32 { return &i }
+
+	for _, tt := range []struct {
+		name     string
+		strategy DeploymentStrategy
+		storage  JaegerStorageType
+		sampling JaegerSamplingSpec
+		warning  string
+		err      string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "free form strategies",
+			sampling: JaegerSamplingSpec{
+				Options: NewFreeForm(map[string]interface{}{
+					"default_strategy": map[string]interface{}{"type": "probabilistic", "param": 0.5},
+				}),
+			},
+		},
+		{
+			name: "invalid free form strategies",
+			sampling: JaegerSamplingSpec{
+				Options: NewFreeForm(map[string]interface{}{
+					"default_strategy": map[string]interface{}{"type": "probabilistic", "param": 2},
+				}),
+			},
+			warning: "the sampling options might be rejected by the collector: invalid sampling strategies: default_strategy: the probability must be between 0 and 1, got 2",
+		},
+		{
+			name: "malformed free form strategies",
+			sampling: JaegerSamplingSpec{
+				Options: NewFreeForm(map[string]interface{}{
+					"service_strategies": "foo",
+				}),
+			},
+			warning: "the sampling options might be rejected by the collector: invalid sampling strategies: json: cannot unmarshal string into Go struct field SamplingStrategies.service_strategies of type []v1.ServiceSamplingStrategy",
+		},
+		{
+			name: "typed strategies",
+			sampling: JaegerSamplingSpec{
+				Strategies: &SamplingStrategies{
+					DefaultStrategy: &DefaultSamplingStrategy{Type: SamplingStrategyTypeProbabilistic, Param: "0.5"},
+				},
+			},
+		},
+		{
+			name: "invalid typed strategies",
+			sampling: JaegerSamplingSpec{
+				Strategies: &SamplingStrategies{
+					DefaultStrategy: &DefaultSamplingStrategy{Type: "probabilstic", Param: "0.5"},
+				},
+			},
+			err: `invalid sampling strategies: default_strategy: unknown sampling strategy type "probabilstic"`,
+		},
+		{
+			name: "typed strategies and free form options",
+			sampling: JaegerSamplingSpec{
+				Options: NewFreeForm(map[string]interface{}{
+					"default_strategy": map[string]interface{}{"type": "probabilistic", "param": 0.5},
+				}),
+				Strategies: &SamplingStrategies{
+					DefaultStrategy: &DefaultSamplingStrategy{Type: SamplingStrategyTypeProbabilistic, Param: "0.5"},
+				},
+			},
+			err: "the sampling strategies can be set either as options or as strategies, not both",
+		},
+		{
+			name:     "unknown type",
+			sampling: JaegerSamplingSpec{Type: "remote"},
+			err:      "invalid sampling type: remote",
+		},
+		{
+			name:    "adaptive",
+			storage: JaegerCassandraStorage,
+			sampling: JaegerSamplingSpec{
+				Type: SamplingStrategiesTypeAdaptive,
+				Adaptive: &JaegerAdaptiveSamplingSpec{
+					TargetSamplesPerSecond:     number(2),
+					InitialSamplingProbability: number(0.01),
+					MinSamplingProbability:     number(0.001),
+					AggregationBuckets:         int32p(10),
+					BucketsForCalculation:      int32p(2),
+					CalculationInterval:        &metav1.Duration{Duration: time.Minute},
+				},
+			},
+		},
+		{
+			name:     "adaptive with streaming",
+			strategy: DeploymentStrategyStreaming,
+			storage:  JaegerESStorage,
+			sampling: JaegerSamplingSpec{Type: SamplingStrategiesTypeAdaptive},
+			err:      "adaptive sampling isn't supported with the streaming strategy, as the collector doesn't have access to the span storage",
+		},
+		{
+			name:     "adaptive with grpc-plugin",
+			storage:  JaegerGRPCPluginStorage,
+			sampling: JaegerSamplingSpec{Type: SamplingStrategiesTypeAdaptive},
+			err:      "adaptive sampling isn't supported with the storage type grpc-plugin",
+		},
+		{
+			name: "adaptive with a non-positive target",
+			sampling: JaegerSamplingSpec{
+				Type:     SamplingStrategiesTypeAdaptive,
+				Adaptive: &JaegerAdaptiveSamplingSpec{TargetSamplesPerSecond: number(0)},
+			},
+			err: "adaptive sampling: targetSamplesPerSecond must be positive, got 0",
+		},
+		{
+			name: "adaptive with a probability out of range",
+			sampling: JaegerSamplingSpec{
+				Type:     SamplingStrategiesTypeAdaptive,
+				Adaptive: &JaegerAdaptiveSamplingSpec{DeltaTolerance: number(1.5)},
+			},
+			err: "adaptive sampling: deltaTolerance must be between 0 and 1, got 1.5",
+		},
+		{
+			name: "adaptive with the minimum above the initial probability",
+			sampling: JaegerSamplingSpec{
+				Type: SamplingStrategiesTypeAdaptive,
+				Adaptive: &JaegerAdaptiveSamplingSpec{
+					InitialSamplingProbability: number(0.001),
+					MinSamplingProbability:     number(0.01),
+				},
+			},
+			err: "adaptive sampling: minSamplingProbability can't be greater than initialSamplingProbability",
+		},
+		{
+			name: "adaptive with more buckets for the calculation than aggregated",
+			sampling: JaegerSamplingSpec{
+				Type: SamplingStrategiesTypeAdaptive,
+				Adaptive: &JaegerAdaptiveSamplingSpec{
+					AggregationBuckets:    int32p(2),
+					BucketsForCalculation: int32p(3),
+				},
+			},
+			err: "adaptive sampling: bucketsForCalculation can't be greater than aggregationBuckets",
+		},
+		{
+			name: "adaptive with a non-positive interval",
+			sampling: JaegerSamplingSpec{
+				Type: SamplingStrategiesTypeAdaptive,
+				Adaptive: &JaegerAdaptiveSamplingSpec{
+					LeaderLeaseRefreshInterval: &metav1.Duration{},
+				},
+			},
+			err: "adaptive sampling: leaderLeaseRefreshInterval must be positive, got 0s",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			jaeger := &Jaeger{Spec: JaegerSpec{
+				Strategy: tt.strategy,
+				Storage:  JaegerStorageSpec{Type: tt.storage},
+				Sampling: tt.sampling,
+			}}
+
+			warnings, err := jaeger.Spec.Sampling.validate(jaeger)
+			if tt.warning == "" {
+				assert.Empty(t, warnings)
+			} else {
+				assert.Equal(t, admission.Warnings{tt.warning}, warnings)
+			}
+			if tt.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
@@ -6,9 +6,12 @@ package v1
 
 import (
 	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -29,6 +32,18 @@ func (in *AutoScaleSpec) DeepCopyInto(out *AutoScaleSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]v2.MetricSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Behavior != nil {
+		in, out := &in.Behavior, &out.Behavior
+		*out = new(v2.HorizontalPodAutoscalerBehavior)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoScaleSpec.
@@ -41,6 +56,61 @@ func (in *AutoScaleSpec) DeepCopy() *AutoScaleSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CassandraSpec) DeepCopyInto(out *CassandraSpec) {
+	*out = *in
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		*out = new(int32)
+		**out = **in
+	}
+	if in.StorageClassName != nil {
+		in, out := &in.StorageClassName, &out.StorageClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.StorageSize != nil {
+		in, out := &in.StorageSize, &out.StorageSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CassandraSpec.
+func (in *CassandraSpec) DeepCopy() *CassandraSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CassandraSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultSamplingStrategy) DeepCopyInto(out *DefaultSamplingStrategy) {
+	*out = *in
+	if in.OperationStrategies != nil {
+		in, out := &in.OperationStrategies, &out.OperationStrategies
+		*out = make([]OperationSamplingStrategy, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultSamplingStrategy.
+func (in *DefaultSamplingStrategy) DeepCopy() *DefaultSamplingStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultSamplingStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ElasticsearchSpec) DeepCopyInto(out *ElasticsearchSpec) {
 	*out = *in
@@ -131,7 +201,7 @@ func (in *Jaeger) DeepCopyInto(out *Jaeger) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Jaeger.
@@ -152,6 +222,101 @@ func (in *Jaeger) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JaegerAdaptiveSamplingSpec) DeepCopyInto(out *JaegerAdaptiveSamplingSpec) {
+	*out = *in
+	if in.TargetSamplesPerSecond != nil {
+		in, out := &in.TargetSamplesPerSecond, &out.TargetSamplesPerSecond
+		*out = new(Number)
+		**out = **in
+	}
+	if in.DeltaTolerance != nil {
+		in, out := &in.DeltaTolerance, &out.DeltaTolerance
+		*out = new(Number)
+		**out = **in
+	}
+	if in.InitialSamplingProbability != nil {
+		in, out := &in.InitialSamplingProbability, &out.InitialSamplingProbability
+		*out = new(Number)
+		**out = **in
+	}
+	if in.MinSamplingProbability != nil {
+		in, out := &in.MinSamplingProbability, &out.MinSamplingProbability
+		*out = new(Number)
+		**out = **in
+	}
+	if in.MinSamplesPerSecond != nil {
+		in, out := &in.MinSamplesPerSecond, &out.MinSamplesPerSecond
+		*out = new(Number)
+		**out = **in
+	}
+	if in.CalculationInterval != nil {
+		in, out := &in.CalculationInterval, &out.CalculationInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.AggregationBuckets != nil {
+		in, out := &in.AggregationBuckets, &out.AggregationBuckets
+		*out = new(int32)
+		**out = **in
+	}
+	if in.BucketsForCalculation != nil {
+		in, out := &in.BucketsForCalculation, &out.BucketsForCalculation
+		*out = new(int32)
+		**out = **in
+	}
+	if in.LeaderLeaseRefreshInterval != nil {
+		in, out := &in.LeaderLeaseRefreshInterval, &out.LeaderLeaseRefreshInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.FollowerLeaseRefreshInterval != nil {
+		in, out := &in.FollowerLeaseRefreshInterval, &out.FollowerLeaseRefreshInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JaegerAdaptiveSamplingSpec.
+func (in *JaegerAdaptiveSamplingSpec) DeepCopy() *JaegerAdaptiveSamplingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JaegerAdaptiveSamplingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JaegerAgentInjectionSpec) DeepCopyInto(out *JaegerAgentInjectionSpec) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WorkloadSelector != nil {
+		in, out := &in.WorkloadSelector, &out.WorkloadSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JaegerAgentInjectionSpec.
+func (in *JaegerAgentInjectionSpec) DeepCopy() *JaegerAgentInjectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JaegerAgentInjectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JaegerAgentSpec) DeepCopyInto(out *JaegerAgentSpec) {
 	*out = *in
@@ -168,6 +333,7 @@ func (in *JaegerAgentSpec) DeepCopyInto(out *JaegerAgentSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	in.Injection.DeepCopyInto(&out.Injection)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JaegerAgentSpec.
@@ -217,6 +383,11 @@ func (in *JaegerCassandraCreateSchemaSpec) DeepCopyInto(out *JaegerCassandraCrea
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ReplicationFactor != nil {
+		in, out := &in.ReplicationFactor, &out.ReplicationFactor
+		*out = new(int32)
+		**out = **in
+	}
 	if in.Affinity != nil {
 		in, out := &in.Affinity, &out.Affinity
 		*out = new(corev1.Affinity)
@@ -239,6 +410,21 @@ func (in *JaegerCassandraCreateSchemaSpec) DeepCopy() *JaegerCassandraCreateSche
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JaegerCassandraSchemaStatus) DeepCopyInto(out *JaegerCassandraSchemaStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JaegerCassandraSchemaStatus.
+func (in *JaegerCassandraSchemaStatus) DeepCopy() *JaegerCassandraSchemaStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(JaegerCassandraSchemaStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JaegerCollectorSpec) DeepCopyInto(out *JaegerCollectorSpec) {
 	*out = *in
@@ -273,6 +459,11 @@ func (in *JaegerCollectorSpec) DeepCopyInto(out *JaegerCollectorSpec) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(JaegerPodDisruptionBudgetSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JaegerCollectorSpec.
@@ -361,6 +552,26 @@ func (in *JaegerCommonSpec) DeepCopy() *JaegerCommonSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JaegerComponentStatus) DeepCopyInto(out *JaegerComponentStatus) {
+	*out = *in
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JaegerComponentStatus.
+func (in *JaegerComponentStatus) DeepCopy() *JaegerComponentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(JaegerComponentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JaegerDependenciesSpec) DeepCopyInto(out *JaegerDependenciesSpec) {
 	*out = *in
@@ -479,6 +690,41 @@ func (in *JaegerEsRolloverSpec) DeepCopy() *JaegerEsRolloverSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JaegerIngesterKEDASpec) DeepCopyInto(out *JaegerIngesterKEDASpec) {
+	*out = *in
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.LagThreshold != nil {
+		in, out := &in.LagThreshold, &out.LagThreshold
+		*out = new(int64)
+		**out = **in
+	}
+	if in.PollingInterval != nil {
+		in, out := &in.PollingInterval, &out.PollingInterval
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CooldownPeriod != nil {
+		in, out := &in.CooldownPeriod, &out.CooldownPeriod
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JaegerIngesterKEDASpec.
+func (in *JaegerIngesterKEDASpec) DeepCopy() *JaegerIngesterKEDASpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JaegerIngesterKEDASpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JaegerIngesterSpec) DeepCopyInto(out *JaegerIngesterSpec) {
 	*out = *in
@@ -503,6 +749,16 @@ func (in *JaegerIngesterSpec) DeepCopyInto(out *JaegerIngesterSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.KEDA != nil {
+		in, out := &in.KEDA, &out.KEDA
+		*out = new(JaegerIngesterKEDASpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(JaegerPodDisruptionBudgetSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JaegerIngesterSpec.
@@ -605,6 +861,156 @@ func (in *JaegerIngressTLSSpec) DeepCopy() *JaegerIngressTLSSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JaegerKafkaAuthenticationSpec) DeepCopyInto(out *JaegerKafkaAuthenticationSpec) {
+	*out = *in
+	if in.Kerberos != nil {
+		in, out := &in.Kerberos, &out.Kerberos
+		*out = new(JaegerKafkaKerberosSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JaegerKafkaAuthenticationSpec.
+func (in *JaegerKafkaAuthenticationSpec) DeepCopy() *JaegerKafkaAuthenticationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JaegerKafkaAuthenticationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JaegerKafkaKerberosSpec) DeepCopyInto(out *JaegerKafkaKerberosSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JaegerKafkaKerberosSpec.
+func (in *JaegerKafkaKerberosSpec) DeepCopy() *JaegerKafkaKerberosSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JaegerKafkaKerberosSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JaegerKafkaProvisioningSpec) DeepCopyInto(out *JaegerKafkaProvisioningSpec) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.StorageClassName != nil {
+		in, out := &in.StorageClassName, &out.StorageClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JaegerKafkaProvisioningSpec.
+func (in *JaegerKafkaProvisioningSpec) DeepCopy() *JaegerKafkaProvisioningSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JaegerKafkaProvisioningSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JaegerKafkaSpec) DeepCopyInto(out *JaegerKafkaSpec) {
+	*out = *in
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(JaegerKafkaTLSSpec)
+		**out = **in
+	}
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(JaegerKafkaAuthenticationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Topic != nil {
+		in, out := &in.Topic, &out.Topic
+		*out = new(JaegerKafkaTopicSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Provisioning != nil {
+		in, out := &in.Provisioning, &out.Provisioning
+		*out = new(JaegerKafkaProvisioningSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JaegerKafkaSpec.
+func (in *JaegerKafkaSpec) DeepCopy() *JaegerKafkaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JaegerKafkaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JaegerKafkaTLSSpec) DeepCopyInto(out *JaegerKafkaTLSSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JaegerKafkaTLSSpec.
+func (in *JaegerKafkaTLSSpec) DeepCopy() *JaegerKafkaTLSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JaegerKafkaTLSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JaegerKafkaTopicSpec) DeepCopyInto(out *JaegerKafkaTopicSpec) {
+	*out = *in
+	if in.Partitions != nil {
+		in, out := &in.Partitions, &out.Partitions
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RetentionMs != nil {
+		in, out := &in.RetentionMs, &out.RetentionMs
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JaegerKafkaTopicSpec.
+func (in *JaegerKafkaTopicSpec) DeepCopy() *JaegerKafkaTopicSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JaegerKafkaTopicSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JaegerList) DeepCopyInto(out *JaegerList) {
 	*out = *in
@@ -637,6 +1043,33 @@ func (in *JaegerList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JaegerMetricsSpec) DeepCopyInto(out *JaegerMetricsSpec) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.AlertRules != nil {
+		in, out := &in.AlertRules, &out.AlertRules
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JaegerMetricsSpec.
+func (in *JaegerMetricsSpec) DeepCopy() *JaegerMetricsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JaegerMetricsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JaegerMetricsStorageSpec) DeepCopyInto(out *JaegerMetricsStorageSpec) {
 	*out = *in
@@ -652,6 +1085,93 @@ func (in *JaegerMetricsStorageSpec) DeepCopy() *JaegerMetricsStorageSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JaegerNetworkPolicySpec) DeepCopyInto(out *JaegerNetworkPolicySpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IngressFrom != nil {
+		in, out := &in.IngressFrom, &out.IngressFrom
+		*out = make([]networkingv1.NetworkPolicyPeer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MonitoringFrom != nil {
+		in, out := &in.MonitoringFrom, &out.MonitoringFrom
+		*out = make([]networkingv1.NetworkPolicyPeer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StorageTo != nil {
+		in, out := &in.StorageTo, &out.StorageTo
+		*out = make([]networkingv1.NetworkPolicyPeer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JaegerNetworkPolicySpec.
+func (in *JaegerNetworkPolicySpec) DeepCopy() *JaegerNetworkPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JaegerNetworkPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JaegerObservabilitySpec) DeepCopyInto(out *JaegerObservabilitySpec) {
+	*out = *in
+	in.Metrics.DeepCopyInto(&out.Metrics)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JaegerObservabilitySpec.
+func (in *JaegerObservabilitySpec) DeepCopy() *JaegerObservabilitySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JaegerObservabilitySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JaegerPodDisruptionBudgetSpec) DeepCopyInto(out *JaegerPodDisruptionBudgetSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MinAvailable != nil {
+		in, out := &in.MinAvailable, &out.MinAvailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JaegerPodDisruptionBudgetSpec.
+func (in *JaegerPodDisruptionBudgetSpec) DeepCopy() *JaegerPodDisruptionBudgetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JaegerPodDisruptionBudgetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JaegerQuerySpec) DeepCopyInto(out *JaegerQuerySpec) {
 	*out = *in
@@ -680,6 +1200,11 @@ func (in *JaegerQuerySpec) DeepCopyInto(out *JaegerQuerySpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(JaegerPodDisruptionBudgetSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JaegerQuerySpec.
@@ -696,6 +1221,16 @@ func (in *JaegerQuerySpec) DeepCopy() *JaegerQuerySpec {
 func (in *JaegerSamplingSpec) DeepCopyInto(out *JaegerSamplingSpec) {
 	*out = *in
 	in.Options.DeepCopyInto(&out.Options)
+	if in.Strategies != nil {
+		in, out := &in.Strategies, &out.Strategies
+		*out = new(SamplingStrategies)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Adaptive != nil {
+		in, out := &in.Adaptive, &out.Adaptive
+		*out = new(JaegerAdaptiveSamplingSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JaegerSamplingSpec.
@@ -719,7 +1254,10 @@ func (in *JaegerSpec) DeepCopyInto(out *JaegerSpec) {
 	in.UI.DeepCopyInto(&out.UI)
 	in.Sampling.DeepCopyInto(&out.Sampling)
 	in.Storage.DeepCopyInto(&out.Storage)
+	in.Kafka.DeepCopyInto(&out.Kafka)
 	in.Ingress.DeepCopyInto(&out.Ingress)
+	in.NetworkPolicy.DeepCopyInto(&out.NetworkPolicy)
+	in.Observability.DeepCopyInto(&out.Observability)
 	in.JaegerCommonSpec.DeepCopyInto(&out.JaegerCommonSpec)
 }
 
@@ -736,6 +1274,25 @@ func (in *JaegerSpec) DeepCopy() *JaegerSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JaegerStatus) DeepCopyInto(out *JaegerStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make([]JaegerComponentStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CassandraSchema != nil {
+		in, out := &in.CassandraSchema, &out.CassandraSchema
+		*out = new(JaegerCassandraSchemaStatus)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JaegerStatus.
@@ -757,6 +1314,7 @@ func (in *JaegerStorageSpec) DeepCopyInto(out *JaegerStorageSpec) {
 	in.EsIndexCleaner.DeepCopyInto(&out.EsIndexCleaner)
 	in.EsRollover.DeepCopyInto(&out.EsRollover)
 	in.Elasticsearch.DeepCopyInto(&out.Elasticsearch)
+	in.Cassandra.DeepCopyInto(&out.Cassandra)
 	out.GRPCPlugin = in.GRPCPlugin
 }
 
@@ -786,6 +1344,21 @@ func (in *JaegerUISpec) DeepCopy() *JaegerUISpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperationSamplingStrategy) DeepCopyInto(out *OperationSamplingStrategy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperationSamplingStrategy.
+func (in *OperationSamplingStrategy) DeepCopy() *OperationSamplingStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(OperationSamplingStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Options) DeepCopyInto(out *Options) {
 	*out = *in
@@ -811,6 +1384,53 @@ func (in *Options) DeepCopy() *Options {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SamplingStrategies) DeepCopyInto(out *SamplingStrategies) {
+	*out = *in
+	if in.DefaultStrategy != nil {
+		in, out := &in.DefaultStrategy, &out.DefaultStrategy
+		*out = new(DefaultSamplingStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceStrategies != nil {
+		in, out := &in.ServiceStrategies, &out.ServiceStrategies
+		*out = make([]ServiceSamplingStrategy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SamplingStrategies.
+func (in *SamplingStrategies) DeepCopy() *SamplingStrategies {
+	if in == nil {
+		return nil
+	}
+	out := new(SamplingStrategies)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceSamplingStrategy) DeepCopyInto(out *ServiceSamplingStrategy) {
+	*out = *in
+	if in.OperationStrategies != nil {
+		in, out := &in.OperationStrategies, &out.OperationStrategies
+		*out = make([]OperationSamplingStrategy, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceSamplingStrategy.
+func (in *ServiceSamplingStrategy) DeepCopy() *ServiceSamplingStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceSamplingStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in Values) DeepCopyInto(out *Values) {
 	{
@@ -10,6 +10,7 @@ import (
 
 	"github.com/jaegertracing/jaeger-operator/pkg/cmd/generate"
 	"github.com/jaegertracing/jaeger-operator/pkg/cmd/start"
+	"github.com/jaegertracing/jaeger-operator/pkg/cmd/upgrade"
 	"github.com/jaegertracing/jaeger-operator/pkg/cmd/version"
 )
 
@@ -39,6 +40,7 @@ func init() {
 	RootCmd.AddCommand(start.NewStartCommand())
 	RootCmd.AddCommand(version.NewVersionCommand())
 	RootCmd.AddCommand(generate.NewGenerateCommand())
+	RootCmd.AddCommand(upgrade.NewUpgradeCommand())
 }
 
 // initConfig reads in config file and ENV variables if set.
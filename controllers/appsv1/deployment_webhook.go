@@ -13,7 +13,9 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
@@ -27,16 +29,32 @@ import (
 
 var _ webhook.AdmissionHandler = (*deploymentInterceptor)(nil)
 
+// Reasons for the events recorded against the deployments handled by the webhook
+const (
+	// EventReasonSidecarInjected is used when the Jaeger Agent sidecar has been injected into the deployment
+	EventReasonSidecarInjected = "SidecarInjected"
+
+	// EventReasonSidecarRemoved is used when the Jaeger Agent sidecar has been removed from the deployment
+	EventReasonSidecarRemoved = "SidecarRemoved"
+
+	// EventReasonSidecarNotInjected is used when the deployment asks for a sidecar, but none could be injected
+	EventReasonSidecarNotInjected = "SidecarNotInjected"
+
+	// EventReasonSidecarNotAllowed is used when the selected Jaeger instance doesn't accept sidecars from the deployment
+	EventReasonSidecarNotAllowed = "SidecarNotAllowed"
+)
+
 // NewDeploymentInterceptorWebhook creates a new deployment mutating webhook to be registered
-func NewDeploymentInterceptorWebhook(c client.Client, decoder *admission.Decoder) webhook.AdmissionHandler {
+func NewDeploymentInterceptorWebhook(c client.Client, decoder *admission.Decoder, recorder record.EventRecorder) webhook.AdmissionHandler {
 	return &deploymentInterceptor{
-		client:  c,
-		decoder: decoder,
+		client:   c,
+		decoder:  decoder,
+		recorder: recorder,
 	}
 }
 
 // You need to ensure the path here match the path in the marker.
-// +kubebuilder:webhook:path=/mutate-v1-deployment,mutating=true,failurePolicy=ignore,groups="apps",resources=deployments,sideEffects=None,verbs=create;update,versions=v1,name=deployment.sidecar-injector.jaegertracing.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-v1-deployment,mutating=true,failurePolicy=ignore,groups="apps",resources=deployments,sideEffects=NoneOnDryRun,verbs=create;update,versions=v1,name=deployment.sidecar-injector.jaegertracing.io,admissionReviewVersions=v1
 
 // +kubebuilder:rbac:groups=core,resources=namespaces,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=core,resources=namespaces/status,verbs=get;update;patch
@@ -45,8 +63,9 @@ func NewDeploymentInterceptorWebhook(c client.Client, decoder *admission.Decoder
 
 // deploymentInterceptor label pods if Sidecar is specified in deployment
 type deploymentInterceptor struct {
-	client  client.Client
-	decoder *admission.Decoder
+	client   client.Client
+	decoder  *admission.Decoder
+	recorder record.EventRecorder
 }
 
 func (d *deploymentInterceptor) shouldHandleDeployment(req admission.Request) bool {
@@ -92,11 +111,79 @@ func (d *deploymentInterceptor) Handle(ctx context.Context, req admission.Reques
 		return admission.Allowed("is jaeger deployment, we do not touch it")
 	}
 
+	return reconcileSidecar(ctx, d.client, d.recorder, req, dep, inject.FromDeployment(dep), func() ([]byte, error) {
+		return json.Marshal(dep)
+	})
+}
+
+// deploymentInterceptor implements admission.DecoderInjector.
+// A decoder will be automatically injected.
+
+// InjectDecoder injects the decoder.
+func (d *deploymentInterceptor) InjectDecoder(decoder *admission.Decoder) error {
+	d.decoder = decoder
+	return nil
+}
+
+func reconcileConfigMaps(ctx context.Context, cl client.Client, jaeger *v1.Jaeger, namespace string) error {
+	tracer := otel.GetTracerProvider().Tracer(v1.ReconciliationTracer)
+	ctx, span := tracer.Start(ctx, "reconcileConfigMaps")
+	defer span.End()
+
+	cms := []*corev1.ConfigMap{}
+	if cm := ca.GetTrustedCABundle(jaeger); cm != nil {
+		cms = append(cms, cm)
+	}
+	if cm := ca.GetServiceCABundle(jaeger); cm != nil {
+		cms = append(cms, cm)
+	}
+
+	for _, cm := range cms {
+		if err := reconcileConfigMap(ctx, cl, cm, namespace); err != nil {
+			return tracing.HandleError(err, span)
+		}
+	}
+
+	return nil
+}
+
+func reconcileConfigMap(ctx context.Context, cl client.Client, cm *corev1.ConfigMap, namespace string) error {
+	tracer := otel.GetTracerProvider().Tracer(v1.ReconciliationTracer)
+	ctx, span := tracer.Start(ctx, "reconcileConfigMap")
+	defer span.End()
+
+	// Update the namespace to be the same as the workload being injected
+	cm.Namespace = namespace
+	span.SetAttributes(attribute.String("name", cm.Name), attribute.String("namespace", cm.Namespace))
+
+	if err := cl.Create(ctx, cm); err != nil {
+		if errors.IsAlreadyExists(err) {
+			span.AddEvent("config map exists already")
+		} else {
+			return tracing.HandleError(err, span)
+		}
+	}
+
+	return nil
+}
+
+// reconcileSidecar injects, updates or removes the sidecar from the given workload, based on the annotations
+// from the workload and its namespace. The encode function returns the mutated object, used to build the patch.
+func reconcileSidecar(ctx context.Context, cl client.Client, recorder record.EventRecorder, req admission.Request, obj runtime.Object, workload *inject.Workload, encode func() ([]byte, error)) admission.Response {
+	span := trace.SpanFromContext(ctx)
+	logger := log.Log.WithValues("namespace", req.Namespace)
+
+	dryRun := req.DryRun != nil && *req.DryRun
+	if dryRun {
+		// the webhook is declared without side effects on dry runs: no events are recorded and no config maps created
+		recorder = dryRunRecorder{}
+	}
+
 	ns := &corev1.Namespace{}
-	err = d.client.Get(ctx, types.NamespacedName{Name: req.Namespace}, ns)
+	err := cl.Get(ctx, types.NamespacedName{Name: req.Namespace}, ns)
 	// we shouldn't fail if the namespace object can't be obtained
 	if err != nil {
-		msg := "failed to get the namespace for the deployment, skipping injection based on namespace annotation"
+		msg := "failed to get the namespace for the workload, skipping injection based on namespace annotation"
 		logger.Error(err, msg)
 		span.AddEvent(msg, trace.WithAttributes(attribute.String("error", err.Error())))
 	}
@@ -108,20 +195,34 @@ func (d *deploymentInterceptor) Handle(ctx context.Context, req admission.Reques
 		opts = append(opts, client.InNamespace(viper.GetString(v1.ConfigWatchNamespace)))
 	}
 
-	if err := d.client.List(ctx, jaegers, opts...); err != nil {
+	if err := cl.List(ctx, jaegers, opts...); err != nil {
 		logger.Error(err, "failed to get the available Jaeger pods")
 		return admission.Errored(http.StatusInternalServerError, tracing.HandleError(err, span))
 	}
 
-	if inject.Needed(dep, ns) {
-		jaeger := inject.Select(dep, ns, jaegers)
+	if inject.NeededForWorkload(workload, ns) {
+		jaeger := inject.SelectForWorkload(workload, ns, jaegers)
 		if jaeger != nil && jaeger.GetDeletionTimestamp() == nil {
 			logger := logger.WithValues(
 				"jaeger", jaeger.Name,
 				"jaeger-namespace", jaeger.Namespace,
 			)
-			if jaeger.Namespace != dep.Namespace {
-				if err := reconcileConfigMaps(ctx, d.client, jaeger, dep); err != nil {
+			if allowed, reason := inject.Allowed(jaeger, workload, ns); !allowed {
+				logger.Info(reason)
+				span.AddEvent(reason)
+				recorder.Event(obj, corev1.EventTypeWarning, EventReasonSidecarNotAllowed, reason)
+				if jaeger.Spec.Agent.Injection.Policy == v1.InjectionPolicyReject {
+					return admission.Denied(reason)
+				}
+				if _, hasLabel := workload.Labels[inject.Label]; hasLabel && inject.HasSidecarForWorkload(workload) {
+					// the sidecar was injected before the instance restricted who may inject against it
+					return removeSidecar(ctx, recorder, req, obj, workload, encode)
+				}
+				return admission.Allowed(reason)
+			}
+
+			if jaeger.Namespace != req.Namespace && !dryRun {
+				if err := reconcileConfigMaps(ctx, cl, jaeger, req.Namespace); err != nil {
 					const msg = "failed to reconcile config maps for the namespace"
 					logger.Error(err, msg)
 					span.AddEvent(msg)
@@ -137,86 +238,57 @@ func (d *deploymentInterceptor) Handle(ctx context.Context, req admission.Reques
 			}
 
 			envConfigMaps := corev1.ConfigMapList{}
-			d.client.List(ctx, &envConfigMaps, client.InNamespace(dep.Namespace))
-			dep = inject.Sidecar(jaeger, dep, inject.WithEnvFromConfigMaps(inject.GetConfigMapsMatchedEnvFromInDeployment(*dep, envConfigMaps.Items)))
-			marshaledDeploy, err := json.Marshal(dep)
+			cl.List(ctx, &envConfigMaps, client.InNamespace(req.Namespace))
+			inject.SidecarForWorkload(jaeger, workload, inject.WithEnvFromConfigMaps(inject.GetConfigMapsMatchedEnvFrom(workload.PodSpec, envConfigMaps.Items)))
+			marshaled, err := encode()
 			if err != nil {
 				return admission.Errored(http.StatusInternalServerError, tracing.HandleError(err, span))
 			}
 
-			return admission.PatchResponseFromRaw(req.Object.Raw, marshaledDeploy)
+			recorder.Eventf(obj, corev1.EventTypeNormal, EventReasonSidecarInjected, "Injected the sidecar in %s mode pointing to the Jaeger instance %s/%s", inject.ModeForWorkload(jaeger, workload), jaeger.Namespace, jaeger.Name)
+			return admission.PatchResponseFromRaw(req.Object.Raw, marshaled)
 		}
 
 		const msg = "no suitable Jaeger instances found to inject a sidecar"
 		span.AddEvent(msg)
 		logger.V(-1).Info(msg)
+		recorder.Event(obj, corev1.EventTypeWarning, EventReasonSidecarNotInjected, "No suitable Jaeger instances found to inject a sidecar")
 		return admission.Allowed(msg)
 	}
 
-	if hasAgent, _ := inject.HasJaegerAgent(dep); hasAgent {
-		if _, hasLabel := dep.Labels[inject.Label]; hasLabel {
-			const msg = "remove sidecar"
-			logger.Info(msg)
-			span.AddEvent(msg)
-			inject.CleanSidecar(dep.Labels[inject.Label], dep)
-			marshaledDeploy, err := json.Marshal(dep)
-			if err != nil {
-				return admission.Errored(http.StatusInternalServerError, tracing.HandleError(err, span))
-			}
-
-			return admission.PatchResponseFromRaw(req.Object.Raw, marshaledDeploy)
+	if inject.HasSidecarForWorkload(workload) {
+		if _, hasLabel := workload.Labels[inject.Label]; hasLabel {
+			return removeSidecar(ctx, recorder, req, obj, workload, encode)
 		}
 	}
 	return admission.Allowed("no action needed")
 }
 
-// deploymentInterceptor implements admission.DecoderInjector.
-// A decoder will be automatically injected.
-
-// InjectDecoder injects the decoder.
-func (d *deploymentInterceptor) InjectDecoder(decoder *admission.Decoder) error {
-	d.decoder = decoder
-	return nil
-}
-
-func reconcileConfigMaps(ctx context.Context, cl client.Client, jaeger *v1.Jaeger, dep *appsv1.Deployment) error {
-	tracer := otel.GetTracerProvider().Tracer(v1.ReconciliationTracer)
-	ctx, span := tracer.Start(ctx, "reconcileConfigMaps")
-	defer span.End()
-
-	cms := []*corev1.ConfigMap{}
-	if cm := ca.GetTrustedCABundle(jaeger); cm != nil {
-		cms = append(cms, cm)
-	}
-	if cm := ca.GetServiceCABundle(jaeger); cm != nil {
-		cms = append(cms, cm)
-	}
+// removeSidecar removes the sidecar managed by the operator from the given workload
+func removeSidecar(ctx context.Context, recorder record.EventRecorder, req admission.Request, obj runtime.Object, workload *inject.Workload, encode func() ([]byte, error)) admission.Response {
+	span := trace.SpanFromContext(ctx)
+	logger := log.Log.WithValues("namespace", req.Namespace)
 
-	for _, cm := range cms {
-		if err := reconcileConfigMap(ctx, cl, cm, dep); err != nil {
-			return tracing.HandleError(err, span)
-		}
+	const msg = "remove sidecar"
+	logger.Info(msg)
+	span.AddEvent(msg)
+	instance := workload.Labels[inject.Label]
+	inject.CleanSidecarForWorkload(instance, workload)
+	marshaled, err := encode()
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, tracing.HandleError(err, span))
 	}
 
-	return nil
+	recorder.Eventf(obj, corev1.EventTypeNormal, EventReasonSidecarRemoved, "Removed the sidecar for the Jaeger instance %s", instance)
+	return admission.PatchResponseFromRaw(req.Object.Raw, marshaled)
 }
 
-func reconcileConfigMap(ctx context.Context, cl client.Client, cm *corev1.ConfigMap, dep *appsv1.Deployment) error {
-	tracer := otel.GetTracerProvider().Tracer(v1.ReconciliationTracer)
-	ctx, span := tracer.Start(ctx, "reconcileConfigMap")
-	defer span.End()
+// dryRunRecorder drops the events recorded while handling dry-run requests
+type dryRunRecorder struct{}
 
-	// Update the namespace to be the same as the Deployment being injected
-	cm.Namespace = dep.Namespace
-	span.SetAttributes(attribute.String("name", cm.Name), attribute.String("namespace", cm.Namespace))
+func (dryRunRecorder) Event(runtime.Object, string, string, string) {}
 
-	if err := cl.Create(ctx, cm); err != nil {
-		if errors.IsAlreadyExists(err) {
-			span.AddEvent("config map exists already")
-		} else {
-			return tracing.HandleError(err, span)
-		}
-	}
+func (dryRunRecorder) Eventf(runtime.Object, string, string, string, ...interface{}) {}
 
-	return nil
+func (dryRunRecorder) AnnotatedEventf(runtime.Object, map[string]string, string, string, string, ...interface{}) {
 }
@@ -19,6 +19,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -97,7 +98,7 @@ func TestReconcileConfigMaps(t *testing.T) {
 			autodetect.OperatorConfiguration.SetPlatform(autodetect.OpenShiftPlatform)
 
 			// test
-			err := reconcileConfigMaps(context.Background(), cl, jaeger, &dep)
+			err := reconcileConfigMaps(context.Background(), cl, jaeger, dep.Namespace)
 
 			// verify
 			assert.Equal(t, tC.expect, err)
@@ -399,7 +400,7 @@ func TestReconcilieDeployment(t *testing.T) {
 			}
 
 			decoder := admission.NewDecoder(scheme.Scheme)
-			r := NewDeploymentInterceptorWebhook(cl, decoder)
+			r := NewDeploymentInterceptorWebhook(cl, decoder, &record.FakeRecorder{})
 
 			req := admission.Request{}
 			if !tc.emptyRequest {
@@ -0,0 +1,184 @@
+package appsv1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/autodetect"
+	"github.com/jaegertracing/jaeger-operator/pkg/inject"
+)
+
+var _ webhook.AdmissionHandler = (*workloadInterceptor)(nil)
+
+// NewWorkloadInterceptorWebhook creates a new mutating webhook injecting the sidecar into workloads other than deployments
+func NewWorkloadInterceptorWebhook(c client.Client, decoder *admission.Decoder, recorder record.EventRecorder) webhook.AdmissionHandler {
+	return &workloadInterceptor{
+		deploymentInterceptor: deploymentInterceptor{
+			client:   c,
+			decoder:  decoder,
+			recorder: recorder,
+		},
+	}
+}
+
+// Jobs and bare pods have an immutable pod spec, so they can only be injected when they are created.
+// The sidecar is injected into Jobs and CronJobs as a native sidecar, only on clusters supporting them: a regular
+// sidecar container would keep running once the job is done, preventing it from completing.
+// Pods managed by a controller are skipped, as the sidecar is injected into their owner's pod template.
+// +kubebuilder:webhook:path=/mutate-v1-workload,mutating=true,failurePolicy=ignore,groups="apps",resources=statefulsets;daemonsets,sideEffects=NoneOnDryRun,verbs=create;update,versions=v1,name=workload.sidecar-injector.jaegertracing.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-v1-workload,mutating=true,failurePolicy=ignore,groups="batch",resources=cronjobs,sideEffects=NoneOnDryRun,verbs=create;update,versions=v1,name=cronjob.sidecar-injector.jaegertracing.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-v1-workload,mutating=true,failurePolicy=ignore,groups="batch",resources=jobs,sideEffects=NoneOnDryRun,verbs=create,versions=v1,name=job.sidecar-injector.jaegertracing.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-v1-workload,mutating=true,failurePolicy=ignore,groups="argoproj.io",resources=rollouts,sideEffects=NoneOnDryRun,verbs=create;update,versions=v1alpha1,name=rollout.sidecar-injector.jaegertracing.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-v1-workload,mutating=true,failurePolicy=ignore,groups="",resources=pods,sideEffects=NoneOnDryRun,verbs=create,versions=v1,name=pod.sidecar-injector.jaegertracing.io,admissionReviewVersions=v1
+
+// workloadInterceptor injects the sidecar into StatefulSets, DaemonSets, Jobs, CronJobs, Argo Rollouts and bare Pods
+type workloadInterceptor struct {
+	deploymentInterceptor
+}
+
+// Handle injects the sidecar into the workload's pod template if the workload or namespace provide the annotation
+func (w *workloadInterceptor) Handle(ctx context.Context, req admission.Request) admission.Response {
+	tracer := otel.GetTracerProvider().Tracer(v1.ReconciliationTracer)
+	ctx, span := tracer.Start(ctx, "reconcileWorkload")
+	span.SetAttributes(
+		attribute.String("kind", req.Kind.String()),
+		attribute.String("name", req.Name),
+		attribute.String("namespace", req.Namespace),
+	)
+	defer span.End()
+
+	if !w.shouldHandleDeployment(req) {
+		return admission.Allowed("not watching in namespace, we do not touch the workload")
+	}
+
+	logger := log.Log.WithValues("namespace", req.Namespace, "kind", req.Kind.Kind)
+	logger.V(-1).Info("verify workload")
+
+	obj, workload, encode, err := w.decode(req)
+	if err != nil {
+		logger.Error(err, "failed to decode workload")
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	if workload == nil {
+		return admission.Allowed("no pod template to inject into, we do not touch the workload")
+	}
+
+	if workload.Batch && !autodetect.OperatorConfiguration.IsNativeSidecarsAvailable() {
+		return admission.Allowed("native sidecars aren't supported by the cluster, we do not touch jobs")
+	}
+
+	if pod, ok := obj.(*corev1.Pod); ok && metav1.GetControllerOf(pod) != nil {
+		return admission.Allowed("pod is managed by a controller, we do not touch it")
+	}
+
+	return reconcileSidecar(ctx, w.client, w.recorder, req, obj, workload, encode)
+}
+
+// decode returns the object from the request, the workload backed by its pod template and a function encoding
+// the object once the workload has been mutated. The workload is nil when the object has no pod template.
+func (w *workloadInterceptor) decode(req admission.Request) (runtime.Object, *inject.Workload, func() ([]byte, error), error) {
+	var obj runtime.Object
+	var workload *inject.Workload
+
+	switch req.Kind.Kind {
+	case "StatefulSet":
+		sts := &appsv1.StatefulSet{}
+		if err := w.decoder.Decode(req, sts); err != nil {
+			return nil, nil, nil, err
+		}
+		obj, workload = sts, inject.NewWorkload(&sts.ObjectMeta, &sts.Spec.Template)
+	case "DaemonSet":
+		ds := &appsv1.DaemonSet{}
+		if err := w.decoder.Decode(req, ds); err != nil {
+			return nil, nil, nil, err
+		}
+		obj, workload = ds, inject.NewWorkload(&ds.ObjectMeta, &ds.Spec.Template)
+	case "Job":
+		job := &batchv1.Job{}
+		if err := w.decoder.Decode(req, job); err != nil {
+			return nil, nil, nil, err
+		}
+		obj, workload = job, inject.NewBatchWorkload(&job.ObjectMeta, &job.Spec.Template)
+	case "CronJob":
+		cronJob := &batchv1.CronJob{}
+		if err := w.decoder.Decode(req, cronJob); err != nil {
+			return nil, nil, nil, err
+		}
+		obj, workload = cronJob, inject.NewBatchWorkload(&cronJob.ObjectMeta, &cronJob.Spec.JobTemplate.Spec.Template)
+	case "Pod":
+		pod := &corev1.Pod{}
+		if err := w.decoder.Decode(req, pod); err != nil {
+			return nil, nil, nil, err
+		}
+		obj, workload = pod, inject.FromPod(pod)
+	case "Rollout":
+		return decodeRollout(req)
+	default:
+		return nil, nil, nil, fmt.Errorf("unsupported kind %s", req.Kind.Kind)
+	}
+
+	return obj, workload, func() ([]byte, error) {
+		return json.Marshal(obj)
+	}, nil
+}
+
+// decodeRollout handles Argo Rollouts, for which we don't have the Go types: the pod template
+// is converted from and back into the unstructured object
+func decodeRollout(req admission.Request) (runtime.Object, *inject.Workload, func() ([]byte, error), error) {
+	rollout := &unstructured.Unstructured{}
+	if err := rollout.UnmarshalJSON(req.Object.Raw); err != nil {
+		return nil, nil, nil, err
+	}
+
+	raw, found, err := unstructured.NestedMap(rollout.Object, "spec", "template")
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if !found {
+		// rollouts using a workloadRef have no template of their own
+		return rollout, nil, nil, nil
+	}
+
+	template := &corev1.PodTemplateSpec{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw, template); err != nil {
+		return nil, nil, nil, err
+	}
+
+	meta := &metav1.ObjectMeta{
+		Name:        rollout.GetName(),
+		Namespace:   req.Namespace,
+		Labels:      rollout.GetLabels(),
+		Annotations: rollout.GetAnnotations(),
+	}
+	workload := inject.NewWorkload(meta, template)
+
+	return rollout, workload, func() ([]byte, error) {
+		updated, err := runtime.DefaultUnstructuredConverter.ToUnstructured(template)
+		if err != nil {
+			return nil, err
+		}
+		if err := unstructured.SetNestedMap(rollout.Object, updated, "spec", "template"); err != nil {
+			return nil, err
+		}
+		rollout.SetLabels(meta.Labels)
+		rollout.SetAnnotations(meta.Annotations)
+		return rollout.MarshalJSON()
+	}, nil
+}
@@ -0,0 +1,396 @@
+package appsv1
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/autodetect"
+	"github.com/jaegertracing/jaeger-operator/pkg/inject"
+)
+
+func TestReconcileWorkload(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{
+		Namespace: "observability",
+		Name:      "my-instance",
+	})
+
+	s := scheme.Scheme
+	s.AddKnownTypes(v1.GroupVersion, jaeger)
+	s.AddKnownTypes(v1.GroupVersion, &v1.JaegerList{})
+
+	meta := metav1.ObjectMeta{
+		Name:        "my-workload",
+		Namespace:   "my-ns",
+		Annotations: map[string]string{inject.Annotation: "true"},
+	}
+	template := corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{"app": "my-app"},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Name: "only_container",
+			}},
+		},
+	}
+
+	trueVar := true
+	testCases := []struct {
+		desc     string
+		kind     string
+		obj      interface{}
+		noNative bool
+		patched  string
+		message  string
+	}{
+		{
+			desc:    "statefulset",
+			kind:    "StatefulSet",
+			obj:     &appsv1.StatefulSet{ObjectMeta: meta, Spec: appsv1.StatefulSetSpec{Template: template}},
+			patched: "/spec/template/spec/containers/1",
+		},
+		{
+			desc:    "daemonset",
+			kind:    "DaemonSet",
+			obj:     &appsv1.DaemonSet{ObjectMeta: meta, Spec: appsv1.DaemonSetSpec{Template: template}},
+			patched: "/spec/template/spec/containers/1",
+		},
+		{
+			desc:    "job",
+			kind:    "Job",
+			obj:     &batchv1.Job{ObjectMeta: meta, Spec: batchv1.JobSpec{Template: template}},
+			patched: "/spec/template/spec/initContainers",
+		},
+		{
+			desc:     "job without native sidecars",
+			kind:     "Job",
+			obj:      &batchv1.Job{ObjectMeta: meta, Spec: batchv1.JobSpec{Template: template}},
+			noNative: true,
+			message:  "native sidecars aren't supported by the cluster, we do not touch jobs",
+		},
+		{
+			desc: "cronjob",
+			kind: "CronJob",
+			obj: &batchv1.CronJob{ObjectMeta: meta, Spec: batchv1.CronJobSpec{
+				JobTemplate: batchv1.JobTemplateSpec{Spec: batchv1.JobSpec{Template: template}},
+			}},
+			patched: "/spec/jobTemplate/spec/template/spec/initContainers",
+		},
+		{
+			desc:    "bare pod",
+			kind:    "Pod",
+			obj:     &corev1.Pod{ObjectMeta: meta, Spec: template.Spec},
+			patched: "/spec/containers/1",
+		},
+		{
+			desc: "pod managed by a controller",
+			kind: "Pod",
+			obj: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "my-workload-0",
+					Namespace:   "my-ns",
+					Annotations: map[string]string{inject.Annotation: "true"},
+					OwnerReferences: []metav1.OwnerReference{{
+						APIVersion: "apps/v1",
+						Kind:       "StatefulSet",
+						Name:       "my-workload",
+						Controller: &trueVar,
+					}},
+				},
+				Spec: template.Spec,
+			},
+			message: "pod is managed by a controller, we do not touch it",
+		},
+		{
+			desc: "argo rollout",
+			kind: "Rollout",
+			obj: map[string]interface{}{
+				"apiVersion": "argoproj.io/v1alpha1",
+				"kind":       "Rollout",
+				"metadata": map[string]interface{}{
+					"name":        "my-workload",
+					"namespace":   "my-ns",
+					"annotations": map[string]interface{}{inject.Annotation: "true"},
+				},
+				"spec": map[string]interface{}{
+					"template": map[string]interface{}{
+						"spec": map[string]interface{}{
+							"containers": []interface{}{
+								map[string]interface{}{"name": "only_container"},
+							},
+						},
+					},
+				},
+			},
+			patched: "/spec/template/spec/containers/1",
+		},
+		{
+			desc: "argo rollout with a workload reference",
+			kind: "Rollout",
+			obj: map[string]interface{}{
+				"apiVersion": "argoproj.io/v1alpha1",
+				"kind":       "Rollout",
+				"metadata": map[string]interface{}{
+					"name":        "my-workload",
+					"namespace":   "my-ns",
+					"annotations": map[string]interface{}{inject.Annotation: "true"},
+				},
+				"spec": map[string]interface{}{
+					"workloadRef": map[string]interface{}{
+						"apiVersion": "apps/v1",
+						"kind":       "Deployment",
+						"name":       "my-workload",
+					},
+				},
+			},
+			message: "no pod template to inject into, we do not touch the workload",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			// prepare
+			if tc.noNative {
+				autodetect.OperatorConfiguration.SetNativeSidecarsAvailability(autodetect.NativeSidecarsAvailabilityNo)
+			} else {
+				autodetect.OperatorConfiguration.SetNativeSidecarsAvailability(autodetect.NativeSidecarsAvailabilityYes)
+			}
+			defer viper.Reset()
+			ns := &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-ns",
+				},
+			}
+			cl := fake.NewClientBuilder().WithRuntimeObjects(jaeger, ns).Build()
+			r := NewWorkloadInterceptorWebhook(cl, admission.NewDecoder(scheme.Scheme), record.NewFakeRecorder(1))
+
+			raw, err := json.Marshal(tc.obj)
+			require.NoError(t, err)
+
+			req := admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Kind:      metav1.GroupVersionKind{Kind: tc.kind},
+					Name:      "my-workload",
+					Namespace: "my-ns",
+					Object:    runtime.RawExtension{Raw: raw},
+				},
+			}
+
+			// test
+			resp := r.Handle(context.Background(), req)
+
+			// verify
+			assert.True(t, resp.Allowed)
+			if tc.message != "" {
+				assert.Equal(t, tc.message, resp.Result.Message)
+				assert.Empty(t, resp.Patches)
+				return
+			}
+
+			paths := []string{}
+			for _, patch := range resp.Patches {
+				paths = append(paths, patch.Path)
+			}
+			assert.Contains(t, paths, tc.patched)
+			assert.Contains(t, paths, "/metadata/labels")
+		})
+	}
+}
+
+func TestReconcileWorkloadUnsupportedKind(t *testing.T) {
+	// prepare
+	r := NewWorkloadInterceptorWebhook(fake.NewClientBuilder().Build(), admission.NewDecoder(scheme.Scheme), record.NewFakeRecorder(1))
+	req := admission.Request{
+		AdmissionRequest: admissionv1.AdmissionRequest{
+			Kind:      metav1.GroupVersionKind{Kind: "ReplicationController"},
+			Namespace: "my-ns",
+			Object:    runtime.RawExtension{Raw: []byte(`{}`)},
+		},
+	}
+
+	// test
+	resp := r.Handle(context.Background(), req)
+
+	// verify
+	assert.False(t, resp.Allowed)
+	assert.Equal(t, "unsupported kind ReplicationController", resp.Result.Message)
+}
+
+func TestReconcileWorkloadNotAllowed(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{
+		Namespace: "observability",
+		Name:      "my-instance",
+	})
+	jaeger.Spec.Agent.Injection.Namespaces = []string{"other-ns"}
+
+	s := scheme.Scheme
+	s.AddKnownTypes(v1.GroupVersion, jaeger)
+	s.AddKnownTypes(v1.GroupVersion, &v1.JaegerList{})
+
+	newStatefulSet := func() *appsv1.StatefulSet {
+		return &appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "my-workload",
+				Namespace:   "my-ns",
+				Annotations: map[string]string{inject.Annotation: "true"},
+			},
+			Spec: appsv1.StatefulSetSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{
+							Name: "only_container",
+						}},
+					},
+				},
+			},
+		}
+	}
+	injected := newStatefulSet()
+	inject.SidecarForWorkload(jaeger, inject.NewWorkload(&injected.ObjectMeta, &injected.Spec.Template))
+
+	reason := "the Jaeger instance observability/my-instance doesn't accept sidecars from the namespace my-ns"
+	for _, tc := range []struct {
+		desc    string
+		policy  v1.InjectionPolicy
+		sts     *appsv1.StatefulSet
+		allowed bool
+		patched bool
+	}{
+		{
+			desc:    "skip",
+			sts:     newStatefulSet(),
+			allowed: true,
+		},
+		{
+			desc:   "reject",
+			policy: v1.InjectionPolicyReject,
+			sts:    newStatefulSet(),
+		},
+		{
+			desc:    "skip removes a sidecar injected before",
+			sts:     injected,
+			allowed: true,
+			patched: true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			// prepare
+			jaeger := jaeger.DeepCopy()
+			jaeger.Spec.Agent.Injection.Policy = tc.policy
+			ns := &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-ns",
+				},
+			}
+			cl := fake.NewClientBuilder().WithRuntimeObjects(jaeger, ns).Build()
+			recorder := record.NewFakeRecorder(2)
+			r := NewWorkloadInterceptorWebhook(cl, admission.NewDecoder(scheme.Scheme), recorder)
+
+			raw, err := json.Marshal(tc.sts)
+			require.NoError(t, err)
+
+			req := admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Kind:      metav1.GroupVersionKind{Kind: "StatefulSet"},
+					Name:      "my-workload",
+					Namespace: "my-ns",
+					Object:    runtime.RawExtension{Raw: raw},
+				},
+			}
+
+			// test
+			resp := r.Handle(context.Background(), req)
+
+			// verify
+			assert.Equal(t, tc.allowed, resp.Allowed)
+			assert.Equal(t, "Warning SidecarNotAllowed "+reason, <-recorder.Events)
+			if tc.patched {
+				assert.NotEmpty(t, resp.Patches)
+				return
+			}
+			assert.Empty(t, resp.Patches)
+			assert.Equal(t, reason, resp.Result.Message)
+		})
+	}
+}
+
+func TestReconcileWorkloadDryRun(t *testing.T) {
+	// prepare
+	jaeger := v1.NewJaeger(types.NamespacedName{
+		Namespace: "observability",
+		Name:      "my-instance",
+	})
+
+	s := scheme.Scheme
+	s.AddKnownTypes(v1.GroupVersion, jaeger)
+	s.AddKnownTypes(v1.GroupVersion, &v1.JaegerList{})
+
+	sts := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "my-workload",
+			Namespace:   "my-ns",
+			Annotations: map[string]string{inject.Annotation: "true"},
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name: "only_container",
+					}},
+				},
+			},
+		},
+	}
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "my-ns",
+		},
+	}
+	cl := fake.NewClientBuilder().WithRuntimeObjects(jaeger, ns).Build()
+	recorder := record.NewFakeRecorder(1)
+	r := NewWorkloadInterceptorWebhook(cl, admission.NewDecoder(scheme.Scheme), recorder)
+
+	raw, err := json.Marshal(sts)
+	require.NoError(t, err)
+
+	dryRun := true
+	req := admission.Request{
+		AdmissionRequest: admissionv1.AdmissionRequest{
+			Kind:      metav1.GroupVersionKind{Kind: "StatefulSet"},
+			Name:      "my-workload",
+			Namespace: "my-ns",
+			Object:    runtime.RawExtension{Raw: raw},
+			DryRun:    &dryRun,
+		},
+	}
+
+	// test
+	resp := r.Handle(context.Background(), req)
+
+	// verify
+	assert.True(t, resp.Allowed)
+	assert.NotEmpty(t, resp.Patches)
+
+	// neither events nor config maps are created for dry runs
+	assert.Empty(t, recorder.Events)
+	configMaps := &corev1.ConfigMapList{}
+	require.NoError(t, cl.List(context.Background(), configMaps))
+	assert.Empty(t, configMaps.Items)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	k8sconfig "sigs.k8s.io/controller-runtime/pkg/client/config"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	k8sreconcile "sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -21,6 +22,7 @@ func TestNewJaegerInstance(t *testing.T) {
 		k8sClient,
 		k8sClient,
 		testScheme,
+		&record.FakeRecorder{},
 	)
 
 	instance := v1.NewJaeger(nsn)
@@ -45,6 +47,7 @@ func TestRegisterWithManager(t *testing.T) {
 		k8sClient,
 		k8sClient,
 		testScheme,
+		&record.FakeRecorder{},
 	)
 
 	// test
@@ -0,0 +1,102 @@
+package jaegertracing_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	k8sreconcile "sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+	"github.com/jaegertracing/jaeger-operator/controllers/jaegertracing"
+)
+
+func reconcileInstance(t *testing.T, nsn types.NamespacedName) {
+	reconciler := jaegertracing.NewReconciler(k8sClient, k8sClient, testScheme, &record.FakeRecorder{})
+	_, err := reconciler.Reconcile(context.Background(), k8sreconcile.Request{NamespacedName: nsn})
+	require.NoError(t, err)
+}
+
+func TestApplyPrunesRemovedFields(t *testing.T) {
+	// prepare
+	nsn := types.NamespacedName{Name: "my-pruned-instance", Namespace: "default"}
+	instance := v1.NewJaeger(nsn)
+	instance.Spec.Labels = map[string]string{"removed": "soon"}
+	require.NoError(t, k8sClient.Create(context.Background(), instance))
+	reconcileInstance(t, nsn)
+
+	dep := &appsv1.Deployment{}
+	require.NoError(t, k8sClient.Get(context.Background(), nsn, dep))
+	require.Equal(t, "soon", dep.Labels["removed"])
+
+	// test
+	require.NoError(t, k8sClient.Get(context.Background(), nsn, instance))
+	instance.Spec.Labels = nil
+	require.NoError(t, k8sClient.Update(context.Background(), instance))
+	reconcileInstance(t, nsn)
+
+	// verify
+	require.NoError(t, k8sClient.Get(context.Background(), nsn, dep))
+	assert.NotContains(t, dep.Labels, "removed")
+}
+
+func TestApplyLeavesFieldsOfOtherManagers(t *testing.T) {
+	// prepare
+	nsn := types.NamespacedName{Name: "my-shared-instance", Namespace: "default"}
+	require.NoError(t, k8sClient.Create(context.Background(), v1.NewJaeger(nsn)))
+	reconcileInstance(t, nsn)
+
+	dep := &appsv1.Deployment{}
+	require.NoError(t, k8sClient.Get(context.Background(), nsn, dep))
+	patch := client.MergeFrom(dep.DeepCopy())
+	dep.Labels["set-by"] = "someone-else"
+	require.NoError(t, k8sClient.Patch(context.Background(), dep, patch, client.FieldOwner("someone-else")))
+
+	// test
+	reconcileInstance(t, nsn)
+
+	// verify
+	require.NoError(t, k8sClient.Get(context.Background(), nsn, dep))
+	assert.Equal(t, "someone-else", dep.Labels["set-by"])
+}
+
+func TestApplyPrunesFieldsOfFormerUpdates(t *testing.T) {
+	// prepare
+	nsn := types.NamespacedName{Name: "my-upgraded-instance", Namespace: "default"}
+	require.NoError(t, k8sClient.Create(context.Background(), v1.NewJaeger(nsn)))
+
+	// the service as created by a former version of the operator, which didn't use server-side apply yet
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-upgraded-instance-query",
+			Namespace: nsn.Namespace,
+			Labels:    map[string]string{"stale": "true"},
+		},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{{Name: "stale", Port: 12345}},
+		},
+	}
+	require.NoError(t, k8sClient.Create(context.Background(), svc, client.FieldOwner(v1.FieldManager)))
+
+	// test
+	reconcileInstance(t, nsn)
+
+	// verify
+	require.NoError(t, k8sClient.Get(context.Background(), client.ObjectKeyFromObject(svc), svc))
+	assert.NotContains(t, svc.Labels, "stale")
+	for _, port := range svc.Spec.Ports {
+		assert.NotEqual(t, "stale", port.Name)
+	}
+	for _, entry := range svc.ManagedFields {
+		if entry.Manager == v1.FieldManager {
+			assert.Equal(t, metav1.ManagedFieldsOperationApply, entry.Operation)
+		}
+	}
+}
@@ -14,6 +14,6 @@ fi
 
 if [ "$JAEGER_OPERATOR_KAFKA_MINIMAL" = true  ]; then
     $YQ -i e \
-        '.spec.template.spec.containers[0].env += {"name": "KAFKA-PROVISIONING-MINIMAL", "value": "true"} ' \
+        '.spec.template.spec.containers[0].env += {"name": "KAFKA-PROVISIONING-PROFILE", "value": "minimal"} ' \
         $ROOT_DIR/config/manager/manager.yaml
 fi
@@ -15,6 +15,7 @@ import (
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/version"
 	"k8s.io/client-go/discovery"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -24,7 +25,10 @@ import (
 	"github.com/jaegertracing/jaeger-operator/pkg/tracing"
 )
 
-var listenedGroupsMap = map[string]bool{"logging.openshift.io": true, "kafka.strimzi.io": true, "route.openshift.io": true}
+// minNativeSidecarsVersion is the first Kubernetes version with the SidecarContainers feature enabled by default
+var minNativeSidecarsVersion = version.MustParseGeneric("1.29.0")
+
+var listenedGroupsMap = map[string]bool{"logging.openshift.io": true, "kafka.strimzi.io": true, "cassandra.datastax.com": true, "k8ssandra.io": true, "argoproj.io": true, "keda.sh": true, "monitoring.coreos.com": true, "route.openshift.io": true}
 
 // Background represents a procedure that runs in the background, periodically auto-detecting features
 type Background struct {
@@ -33,9 +37,12 @@ type Background struct {
 	dcl      discovery.DiscoveryInterface
 	ticker   *time.Ticker
 
-	firstRun         *sync.Once
-	retryDetectKafka bool
-	retryDetectEs    bool
+	firstRun                      *sync.Once
+	retryDetectKafka              bool
+	retryDetectCassandra          bool
+	retryDetectEs                 bool
+	retryDetectKEDA               bool
+	retryDetectPrometheusOperator bool
 }
 
 // New creates a new auto-detect runner
@@ -53,14 +60,20 @@ func WithClients(cl client.Client, dcl discovery.DiscoveryInterface, clr client.
 	// whether we should keep adjusting depending on the environment
 	retryDetectEs := viper.GetString("es-provision") == v1.FlagProvisionElasticsearchAuto
 	retryDetectKafka := viper.GetString("kafka-provision") == v1.FlagProvisionKafkaAuto
+	retryDetectCassandra := viper.GetString(v1.FlagCassandraProvision) == v1.FlagProvisionCassandraAuto
+	retryDetectKEDA := viper.GetString(v1.FlagKEDAIntegration) == v1.FlagKEDAIntegrationAuto
+	retryDetectPrometheusOperator := viper.GetString(v1.FlagPrometheusOperatorIntegration) == v1.FlagPrometheusOperatorIntegrationAuto
 
 	return &Background{
-		cl:               cl,
-		dcl:              dcl,
-		clReader:         clr,
-		retryDetectKafka: retryDetectKafka,
-		retryDetectEs:    retryDetectEs,
-		firstRun:         &sync.Once{},
+		cl:                            cl,
+		dcl:                           dcl,
+		clReader:                      clr,
+		retryDetectKafka:              retryDetectKafka,
+		retryDetectCassandra:          retryDetectCassandra,
+		retryDetectEs:                 retryDetectEs,
+		retryDetectKEDA:               retryDetectKEDA,
+		retryDetectPrometheusOperator: retryDetectPrometheusOperator,
+		firstRun:                      &sync.Once{},
 	}
 }
 
@@ -102,10 +115,17 @@ func (b *Background) autoDetectCapabilities() {
 			b.detectCronjobsVersion(ctx)
 			b.detectAutoscalingVersion(ctx)
 			b.detectDefaultIngressClass(ctx)
+			b.detectNativeSidecars(ctx)
 		})
 		b.detectOAuthProxyImageStream(ctx)
 		b.detectElasticsearch(ctx, apiList)
 		b.detectKafka(ctx, apiList)
+		b.detectKafkaNodePools(ctx, apiList)
+		b.detectCassandra(ctx, apiList)
+		b.detectK8ssandra(ctx, apiList)
+		b.detectArgoRollouts(ctx, apiList)
+		b.detectKEDA(ctx, apiList)
+		b.detectPrometheusOperator(ctx, apiList)
 	}
 	b.detectClusterRoles(ctx)
 }
@@ -173,6 +193,23 @@ func (b *Background) detectAutoscalingVersion(ctx context.Context) {
 	}
 }
 
+// detectNativeSidecars checks whether the cluster supports native sidecars, enabled by default since Kubernetes 1.29
+func (b *Background) detectNativeSidecars(_ context.Context) {
+	availability := NativeSidecarsAvailabilityNo
+
+	info, err := b.dcl.ServerVersion()
+	if err != nil {
+		log.Log.V(-1).Info(fmt.Sprintf("error getting the server version: %s", err))
+	} else if v, err := version.ParseGeneric(info.GitVersion); err != nil {
+		log.Log.V(-1).Info(fmt.Sprintf("error parsing the server version %q: %s", info.GitVersion, err))
+	} else if v.AtLeast(minNativeSidecarsVersion) {
+		availability = NativeSidecarsAvailabilityYes
+	}
+
+	OperatorConfiguration.SetNativeSidecarsAvailability(availability)
+	log.Log.V(-1).Info("detected the native sidecars support", v1.FlagNativeSidecars, availability.String())
+}
+
 // AvailableAPIs returns available list of CRDs from the cluster.
 func AvailableAPIs(discovery discovery.DiscoveryInterface, groups map[string]bool) ([]*metav1.APIResourceList, error) {
 	var apiLists []*metav1.APIResourceList
@@ -392,6 +429,143 @@ func (b *Background) detectKafka(_ context.Context, apiList []*metav1.APIResourc
 	}
 }
 
+// detectKafkaNodePools checks whether the Kafka Operator supports KafkaNodePools, in which case the provisioned
+// Kafka clusters run in KRaft mode
+func (b *Background) detectKafkaNodePools(_ context.Context, apiList []*metav1.APIResourceList) {
+	current := OperatorConfiguration.GetKafkaNodePoolsAvailability()
+
+	availability := KafkaNodePoolsAvailabilityNo
+	if isKafkaNodePoolAvailable(apiList) {
+		availability = KafkaNodePoolsAvailabilityYes
+	}
+
+	if current != availability {
+		log.Log.Info(
+			"Automatically adjusted the 'kafka-node-pools' flag",
+			v1.FlagKafkaNodePools, availability.String(),
+		)
+		OperatorConfiguration.SetKafkaNodePoolsAvailability(availability)
+	}
+}
+
+// detectCassandra checks whether cass-operator is available, either on its own or as part of K8ssandra
+func (b *Background) detectCassandra(_ context.Context, apiList []*metav1.APIResourceList) {
+	currentCassandraProvision := OperatorConfiguration.GetCassandraIntegration()
+	if !b.retryDetectCassandra {
+		log.Log.V(-1).Info(
+			"The 'cassandra-provision' option is explicitly set",
+			v1.FlagCassandraProvision, currentCassandraProvision.String(),
+		)
+		return
+	}
+
+	log.Log.V(-1).Info("Determining whether we should enable the Cassandra Operator integration")
+
+	cassandraProvision := CassandraOperatorIntegrationNo
+	if isCassandraOperatorAvailable(apiList) {
+		cassandraProvision = CassandraOperatorIntegrationYes
+	}
+
+	if currentCassandraProvision != cassandraProvision {
+		log.Log.Info(
+			"Automatically adjusted the 'cassandra-provision' flag",
+			v1.FlagCassandraProvision, cassandraProvision.String(),
+		)
+		OperatorConfiguration.SetCassandraIntegration(cassandraProvision)
+	}
+}
+
+// detectK8ssandra checks whether the K8ssandra operator is available, in which case the provisioned Cassandra
+// clusters are K8ssandraClusters
+func (b *Background) detectK8ssandra(_ context.Context, apiList []*metav1.APIResourceList) {
+	current := OperatorConfiguration.GetK8ssandraAvailability()
+
+	availability := K8ssandraAvailabilityNo
+	if isK8ssandraAvailable(apiList) {
+		availability = K8ssandraAvailabilityYes
+	}
+
+	if current != availability {
+		log.Log.Info(
+			"Automatically adjusted the 'k8ssandra' flag",
+			v1.FlagK8ssandra, availability.String(),
+		)
+		OperatorConfiguration.SetK8ssandraAvailability(availability)
+	}
+}
+
+// detectArgoRollouts checks whether the Argo Rollouts API is available
+func (b *Background) detectArgoRollouts(_ context.Context, apiList []*metav1.APIResourceList) {
+	current := OperatorConfiguration.GetArgoRolloutsAvailability()
+
+	availability := ArgoRolloutsAvailabilityNo
+	if isArgoRolloutsAvailable(apiList) {
+		availability = ArgoRolloutsAvailabilityYes
+	}
+
+	if current != availability {
+		log.Log.Info(
+			"Automatically adjusted the 'argo-rollouts' flag",
+			v1.FlagArgoRollouts, availability.String(),
+		)
+		OperatorConfiguration.SetArgoRolloutsAvailability(availability)
+	}
+}
+
+// detectKEDA checks whether KEDA is available
+func (b *Background) detectKEDA(_ context.Context, apiList []*metav1.APIResourceList) {
+	currentKEDAIntegration := OperatorConfiguration.GetKEDAIntegration()
+	if !b.retryDetectKEDA {
+		log.Log.V(-1).Info(
+			"The 'keda-integration' option is explicitly set",
+			v1.FlagKEDAIntegration, currentKEDAIntegration.String(),
+		)
+		return
+	}
+
+	log.Log.V(-1).Info("Determining whether we should enable the KEDA integration")
+
+	kedaIntegration := KEDAIntegrationNo
+	if isKEDAAvailable(apiList) {
+		kedaIntegration = KEDAIntegrationYes
+	}
+
+	if currentKEDAIntegration != kedaIntegration {
+		log.Log.Info(
+			"Automatically adjusted the 'keda-integration' flag",
+			v1.FlagKEDAIntegration, kedaIntegration.String(),
+		)
+		OperatorConfiguration.SetKEDAIntegration(kedaIntegration)
+	}
+}
+
+// detectPrometheusOperator checks whether the Prometheus Operator is available
+func (b *Background) detectPrometheusOperator(_ context.Context, apiList []*metav1.APIResourceList) {
+	currentIntegration := OperatorConfiguration.GetPrometheusOperatorIntegration()
+	if !b.retryDetectPrometheusOperator {
+		log.Log.V(-1).Info(
+			"The 'prometheus-operator-integration' option is explicitly set",
+			v1.FlagPrometheusOperatorIntegration, currentIntegration.String(),
+		)
+		return
+	}
+
+	log.Log.V(-1).Info("Determining whether we should enable the Prometheus Operator integration")
+
+	integration := PrometheusOperatorIntegrationNo
+	if isPrometheusOperatorAvailable(apiList) {
+		integration = PrometheusOperatorIntegrationYes
+	}
+
+	if currentIntegration != integration {
+		log.Log.Info(
+			"Automatically adjusted the 'prometheus-operator-integration' flag",
+			v1.FlagPrometheusOperatorIntegration, integration.String(),
+		)
+		OperatorConfiguration.SetPrometheusOperatorIntegration(integration)
+	}
+}
+
 func (b *Background) detectClusterRoles(ctx context.Context) {
 	if OperatorConfiguration.GetPlatform() != OpenShiftPlatform {
 		return
@@ -470,3 +644,68 @@ func isKafkaOperatorAvailable(apiList []*metav1.APIResourceList) bool {
 	}
 	return false
 }
+
+func isKafkaNodePoolAvailable(apiList []*metav1.APIResourceList) bool {
+	for _, r := range apiList {
+		if strings.HasPrefix(r.GroupVersion, "kafka.strimzi.io") {
+			for _, api := range r.APIResources {
+				if api.Kind == "KafkaNodePool" {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func isCassandraOperatorAvailable(apiList []*metav1.APIResourceList) bool {
+	return isKindAvailable(apiList, "cassandra.datastax.com", "CassandraDatacenter")
+}
+
+func isK8ssandraAvailable(apiList []*metav1.APIResourceList) bool {
+	return isKindAvailable(apiList, "k8ssandra.io", "K8ssandraCluster")
+}
+
+func isArgoRolloutsAvailable(apiList []*metav1.APIResourceList) bool {
+	return isKindAvailable(apiList, "argoproj.io", "Rollout")
+}
+
+// isKindAvailable returns true if the given kind is served by any version of the given group
+func isKindAvailable(apiList []*metav1.APIResourceList, group, kind string) bool {
+	for _, r := range apiList {
+		if strings.HasPrefix(r.GroupVersion, group+"/") {
+			for _, api := range r.APIResources {
+				if api.Kind == kind {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func isKEDAAvailable(apiList []*metav1.APIResourceList) bool {
+	for _, r := range apiList {
+		if strings.HasPrefix(r.GroupVersion, "keda.sh") {
+			for _, api := range r.APIResources {
+				if api.Kind == "ScaledObject" {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func isPrometheusOperatorAvailable(apiList []*metav1.APIResourceList) bool {
+	for _, r := range apiList {
+		if strings.HasPrefix(r.GroupVersion, "monitoring.coreos.com") {
+			for _, api := range r.APIResources {
+				if api.Kind == "ServiceMonitor" {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
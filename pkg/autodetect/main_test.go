@@ -421,6 +421,150 @@ func TestAutoDetectKafkaDefaultWithOperator(t *testing.T) {
 	assert.True(t, OperatorConfiguration.IsKafkaOperatorIntegrationEnabled())
 }
 
+func TestAutoDetectKEDANotAvailable(t *testing.T) {
+	// prepare
+	viper.Set(v1.FlagKEDAIntegration, v1.FlagKEDAIntegrationAuto)
+	defer viper.Reset()
+
+	dcl := &fakeDiscoveryClient{}
+	cl := fake.NewClientBuilder().Build()
+	b := WithClients(cl, dcl, cl)
+
+	// test
+	b.autoDetectCapabilities()
+
+	// verify
+	assert.False(t, OperatorConfiguration.IsKEDAIntegrationEnabled())
+}
+
+func TestAutoDetectKEDAAvailable(t *testing.T) {
+	// prepare
+	viper.Set(v1.FlagKEDAIntegration, v1.FlagKEDAIntegrationAuto)
+	defer viper.Reset()
+
+	dcl := &fakeDiscoveryClient{}
+	cl := fake.NewClientBuilder().Build()
+	b := WithClients(cl, dcl, cl)
+	dcl.ServerGroupsFunc = func() (apiGroupList *metav1.APIGroupList, err error) {
+		return &metav1.APIGroupList{Groups: []metav1.APIGroup{{
+			Name: "keda.sh",
+		}}}, nil
+	}
+
+	dcl.ServerResourcesForGroupVersionFunc = func(_ string) (apiGroupList *metav1.APIResourceList, err error) {
+		return &metav1.APIResourceList{
+			GroupVersion: "keda.sh/v1alpha1",
+			APIResources: []metav1.APIResource{{Kind: "ScaledObject"}},
+		}, nil
+	}
+
+	// test
+	b.autoDetectCapabilities()
+
+	// verify
+	assert.True(t, OperatorConfiguration.IsKEDAIntegrationEnabled())
+}
+
+func TestAutoDetectKEDAExplicitNo(t *testing.T) {
+	// prepare
+	OperatorConfiguration.SetKEDAIntegration(KEDAIntegrationNo)
+	defer viper.Reset()
+
+	dcl := &fakeDiscoveryClient{}
+	cl := fake.NewClientBuilder().Build()
+	b := WithClients(cl, dcl, cl)
+	dcl.ServerGroupsFunc = func() (apiGroupList *metav1.APIGroupList, err error) {
+		return &metav1.APIGroupList{Groups: []metav1.APIGroup{{
+			Name: "keda.sh",
+		}}}, nil
+	}
+
+	dcl.ServerResourcesForGroupVersionFunc = func(_ string) (apiGroupList *metav1.APIResourceList, err error) {
+		return &metav1.APIResourceList{
+			GroupVersion: "keda.sh/v1alpha1",
+			APIResources: []metav1.APIResource{{Kind: "ScaledObject"}},
+		}, nil
+	}
+
+	// test
+	b.autoDetectCapabilities()
+
+	// verify
+	assert.False(t, OperatorConfiguration.IsKEDAIntegrationEnabled())
+}
+
+func TestAutoDetectPrometheusOperatorNotAvailable(t *testing.T) {
+	// prepare
+	viper.Set(v1.FlagPrometheusOperatorIntegration, v1.FlagPrometheusOperatorIntegrationAuto)
+	defer viper.Reset()
+
+	dcl := &fakeDiscoveryClient{}
+	cl := fake.NewClientBuilder().Build()
+	b := WithClients(cl, dcl, cl)
+
+	// test
+	b.autoDetectCapabilities()
+
+	// verify
+	assert.False(t, OperatorConfiguration.IsPrometheusOperatorIntegrationEnabled())
+}
+
+func TestAutoDetectPrometheusOperatorAvailable(t *testing.T) {
+	// prepare
+	viper.Set(v1.FlagPrometheusOperatorIntegration, v1.FlagPrometheusOperatorIntegrationAuto)
+	defer viper.Reset()
+
+	dcl := &fakeDiscoveryClient{}
+	cl := fake.NewClientBuilder().Build()
+	b := WithClients(cl, dcl, cl)
+	dcl.ServerGroupsFunc = func() (apiGroupList *metav1.APIGroupList, err error) {
+		return &metav1.APIGroupList{Groups: []metav1.APIGroup{{
+			Name: "monitoring.coreos.com",
+		}}}, nil
+	}
+
+	dcl.ServerResourcesForGroupVersionFunc = func(_ string) (apiGroupList *metav1.APIResourceList, err error) {
+		return &metav1.APIResourceList{
+			GroupVersion: "monitoring.coreos.com/v1",
+			APIResources: []metav1.APIResource{{Kind: "ServiceMonitor"}},
+		}, nil
+	}
+
+	// test
+	b.autoDetectCapabilities()
+
+	// verify
+	assert.True(t, OperatorConfiguration.IsPrometheusOperatorIntegrationEnabled())
+}
+
+func TestAutoDetectPrometheusOperatorExplicitNo(t *testing.T) {
+	// prepare
+	OperatorConfiguration.SetPrometheusOperatorIntegration(PrometheusOperatorIntegrationNo)
+	defer viper.Reset()
+
+	dcl := &fakeDiscoveryClient{}
+	cl := fake.NewClientBuilder().Build()
+	b := WithClients(cl, dcl, cl)
+	dcl.ServerGroupsFunc = func() (apiGroupList *metav1.APIGroupList, err error) {
+		return &metav1.APIGroupList{Groups: []metav1.APIGroup{{
+			Name: "monitoring.coreos.com",
+		}}}, nil
+	}
+
+	dcl.ServerResourcesForGroupVersionFunc = func(_ string) (apiGroupList *metav1.APIResourceList, err error) {
+		return &metav1.APIResourceList{
+			GroupVersion: "monitoring.coreos.com/v1",
+			APIResources: []metav1.APIResource{{Kind: "ServiceMonitor"}},
+		}, nil
+	}
+
+	// test
+	b.autoDetectCapabilities()
+
+	// verify
+	assert.False(t, OperatorConfiguration.IsPrometheusOperatorIntegrationEnabled())
+}
+
 func TestAutoDetectCronJobsVersion(t *testing.T) {
 	apiGroupVersions := []string{v1.FlagCronJobsVersionBatchV1, v1.FlagCronJobsVersionBatchV1Beta1}
 	for _, apiGroup := range apiGroupVersions {
@@ -450,6 +594,35 @@ func TestAutoDetectCronJobsVersion(t *testing.T) {
 	}
 }
 
+func TestAutoDetectNativeSidecars(t *testing.T) {
+	for _, tt := range []struct {
+		gitVersion string
+		expected   NativeSidecarsAvailability
+	}{
+		{gitVersion: "v1.28.9", expected: NativeSidecarsAvailabilityNo},
+		{gitVersion: "v1.29.0", expected: NativeSidecarsAvailabilityYes},
+		{gitVersion: "v1.30.2+k3s1", expected: NativeSidecarsAvailabilityYes},
+		{gitVersion: "", expected: NativeSidecarsAvailabilityNo},
+	} {
+		t.Run(tt.gitVersion, func(t *testing.T) {
+			// prepare
+			dcl := &fakeDiscoveryClient{}
+			cl := fake.NewFakeClient() // nolint:staticcheck
+			b := WithClients(cl, dcl, cl)
+			dcl.ServerVersionFunc = func() (*version.Info, error) {
+				return &version.Info{GitVersion: tt.gitVersion}, nil
+			}
+			defer viper.Reset()
+
+			// test
+			b.detectNativeSidecars(context.Background())
+
+			// verify
+			assert.Equal(t, tt.expected, OperatorConfiguration.GetNativeSidecarsAvailability())
+		})
+	}
+}
+
 func TestAutoDetectAutoscalingVersion(t *testing.T) {
 	apiGroupVersions := []string{v1.FlagAutoscalingVersionV2, v1.FlagAutoscalingVersionV2Beta2}
 	for _, apiGroup := range apiGroupVersions {
@@ -715,6 +888,7 @@ type fakeDiscoveryClient struct {
 	discovery.DiscoveryInterface
 	ServerGroupsFunc                   func() (apiGroupList *metav1.APIGroupList, err error)
 	ServerResourcesForGroupVersionFunc func(groupVersion string) (resources *metav1.APIResourceList, err error)
+	ServerVersionFunc                  func() (*version.Info, error)
 }
 
 func (d *fakeDiscoveryClient) ServerGroups() (apiGroupList *metav1.APIGroupList, err error) {
@@ -744,7 +918,10 @@ func (d *fakeDiscoveryClient) ServerPreferredNamespacedResources() ([]*metav1.AP
 }
 
 func (d *fakeDiscoveryClient) ServerVersion() (*version.Info, error) {
-	return &version.Info{}, nil
+	if d.ServerVersionFunc == nil {
+		return &version.Info{}, nil
+	}
+	return d.ServerVersionFunc()
 }
 
 func (d *fakeDiscoveryClient) OpenAPISchema() (*openapi_v2.Document, error) {
@@ -754,3 +931,198 @@ func (d *fakeDiscoveryClient) OpenAPISchema() (*openapi_v2.Document, error) {
 func (d *fakeDiscoveryClient) RESTClient() restclient.Interface {
 	return &fakeRest.RESTClient{}
 }
+
+func TestAutoDetectKafkaNodePoolsNotAvailable(t *testing.T) {
+	// prepare
+	defer viper.Reset()
+
+	dcl := &fakeDiscoveryClient{}
+	cl := fake.NewClientBuilder().Build()
+	b := WithClients(cl, dcl, cl)
+	dcl.ServerGroupsFunc = func() (apiGroupList *metav1.APIGroupList, err error) {
+		return &metav1.APIGroupList{Groups: []metav1.APIGroup{{
+			Name: "kafka.strimzi.io",
+		}}}, nil
+	}
+
+	dcl.ServerResourcesForGroupVersionFunc = func(_ string) (apiGroupList *metav1.APIResourceList, err error) {
+		return &metav1.APIResourceList{
+			GroupVersion: "kafka.strimzi.io/v1beta2",
+			APIResources: []metav1.APIResource{{Kind: "Kafka"}, {Kind: "KafkaUser"}},
+		}, nil
+	}
+
+	// test
+	b.autoDetectCapabilities()
+
+	// verify
+	assert.False(t, OperatorConfiguration.IsKafkaNodePoolsAvailable())
+}
+
+func TestAutoDetectKafkaNodePoolsAvailable(t *testing.T) {
+	// prepare
+	defer viper.Reset()
+
+	dcl := &fakeDiscoveryClient{}
+	cl := fake.NewClientBuilder().Build()
+	b := WithClients(cl, dcl, cl)
+	dcl.ServerGroupsFunc = func() (apiGroupList *metav1.APIGroupList, err error) {
+		return &metav1.APIGroupList{Groups: []metav1.APIGroup{{
+			Name: "kafka.strimzi.io",
+		}}}, nil
+	}
+
+	dcl.ServerResourcesForGroupVersionFunc = func(_ string) (apiGroupList *metav1.APIResourceList, err error) {
+		return &metav1.APIResourceList{
+			GroupVersion: "kafka.strimzi.io/v1beta2",
+			APIResources: []metav1.APIResource{{Kind: "Kafka"}, {Kind: "KafkaNodePool"}, {Kind: "KafkaUser"}},
+		}, nil
+	}
+
+	// test
+	b.autoDetectCapabilities()
+
+	// verify
+	assert.True(t, OperatorConfiguration.IsKafkaNodePoolsAvailable())
+}
+
+func TestAutoDetectCassandraProvisionNoOperator(t *testing.T) {
+	// prepare
+	viper.Set(v1.FlagCassandraProvision, v1.FlagProvisionCassandraAuto)
+	defer viper.Reset()
+
+	dcl := &fakeDiscoveryClient{}
+	cl := fake.NewClientBuilder().Build()
+	b := WithClients(cl, dcl, cl)
+
+	// test
+	b.autoDetectCapabilities()
+
+	// verify
+	assert.False(t, OperatorConfiguration.IsCassandraOperatorIntegrationEnabled())
+	assert.False(t, OperatorConfiguration.IsK8ssandraAvailable())
+}
+
+func TestAutoDetectCassandraProvisionWithCassOperator(t *testing.T) {
+	// prepare
+	viper.Set(v1.FlagCassandraProvision, v1.FlagProvisionCassandraAuto)
+	defer viper.Reset()
+
+	dcl := &fakeDiscoveryClient{}
+	cl := fake.NewClientBuilder().Build()
+	b := WithClients(cl, dcl, cl)
+
+	dcl.ServerGroupsFunc = func() (apiGroupList *metav1.APIGroupList, err error) {
+		return &metav1.APIGroupList{Groups: []metav1.APIGroup{{
+			Name: "cassandra.datastax.com",
+		}}}, nil
+	}
+
+	dcl.ServerResourcesForGroupVersionFunc = func(_ string) (apiGroupList *metav1.APIResourceList, err error) {
+		return &metav1.APIResourceList{
+			GroupVersion: "cassandra.datastax.com/v1beta1",
+			APIResources: []metav1.APIResource{{Kind: "CassandraDatacenter"}},
+		}, nil
+	}
+
+	// test
+	b.autoDetectCapabilities()
+
+	// verify
+	assert.True(t, OperatorConfiguration.IsCassandraOperatorIntegrationEnabled())
+	assert.False(t, OperatorConfiguration.IsK8ssandraAvailable())
+}
+
+func TestAutoDetectCassandraProvisionWithK8ssandra(t *testing.T) {
+	// prepare
+	viper.Set(v1.FlagCassandraProvision, v1.FlagProvisionCassandraAuto)
+	defer viper.Reset()
+
+	dcl := &fakeDiscoveryClient{}
+	cl := fake.NewClientBuilder().Build()
+	b := WithClients(cl, dcl, cl)
+
+	dcl.ServerGroupsFunc = func() (apiGroupList *metav1.APIGroupList, err error) {
+		return &metav1.APIGroupList{Groups: []metav1.APIGroup{
+			{Name: "cassandra.datastax.com", PreferredVersion: metav1.GroupVersionForDiscovery{GroupVersion: "cassandra.datastax.com/v1beta1"}},
+			{Name: "k8ssandra.io", PreferredVersion: metav1.GroupVersionForDiscovery{GroupVersion: "k8ssandra.io/v1alpha1"}},
+		}}, nil
+	}
+
+	dcl.ServerResourcesForGroupVersionFunc = func(groupVersion string) (apiGroupList *metav1.APIResourceList, err error) {
+		if groupVersion == "k8ssandra.io/v1alpha1" {
+			return &metav1.APIResourceList{
+				GroupVersion: groupVersion,
+				APIResources: []metav1.APIResource{{Kind: "K8ssandraCluster"}},
+			}, nil
+		}
+		return &metav1.APIResourceList{
+			GroupVersion: groupVersion,
+			APIResources: []metav1.APIResource{{Kind: "CassandraDatacenter"}},
+		}, nil
+	}
+
+	// test
+	b.autoDetectCapabilities()
+
+	// verify
+	assert.True(t, OperatorConfiguration.IsCassandraOperatorIntegrationEnabled())
+	assert.True(t, OperatorConfiguration.IsK8ssandraAvailable())
+}
+
+func TestAutoDetectArgoRollouts(t *testing.T) {
+	// prepare
+	defer viper.Reset()
+
+	dcl := &fakeDiscoveryClient{}
+	cl := fake.NewClientBuilder().Build()
+	b := WithClients(cl, dcl, cl)
+
+	dcl.ServerGroupsFunc = func() (apiGroupList *metav1.APIGroupList, err error) {
+		return &metav1.APIGroupList{Groups: []metav1.APIGroup{
+			{Name: "argoproj.io", PreferredVersion: metav1.GroupVersionForDiscovery{GroupVersion: "argoproj.io/v1alpha1"}},
+		}}, nil
+	}
+
+	dcl.ServerResourcesForGroupVersionFunc = func(groupVersion string) (apiGroupList *metav1.APIResourceList, err error) {
+		return &metav1.APIResourceList{
+			GroupVersion: groupVersion,
+			APIResources: []metav1.APIResource{{Kind: "Rollout"}},
+		}, nil
+	}
+
+	// test
+	b.autoDetectCapabilities()
+
+	// verify
+	assert.True(t, OperatorConfiguration.IsArgoRolloutsAvailable())
+}
+
+func TestAutoDetectCassandraExplicitNo(t *testing.T) {
+	// prepare
+	OperatorConfiguration.SetCassandraIntegration(CassandraOperatorIntegrationNo)
+	defer viper.Reset()
+
+	dcl := &fakeDiscoveryClient{}
+	cl := fake.NewClientBuilder().Build()
+	b := WithClients(cl, dcl, cl)
+
+	dcl.ServerGroupsFunc = func() (apiGroupList *metav1.APIGroupList, err error) {
+		return &metav1.APIGroupList{Groups: []metav1.APIGroup{{
+			Name: "cassandra.datastax.com",
+		}}}, nil
+	}
+
+	dcl.ServerResourcesForGroupVersionFunc = func(_ string) (apiGroupList *metav1.APIResourceList, err error) {
+		return &metav1.APIResourceList{
+			GroupVersion: "cassandra.datastax.com/v1beta1",
+			APIResources: []metav1.APIResource{{Kind: "CassandraDatacenter"}},
+		}, nil
+	}
+
+	// test
+	b.autoDetectCapabilities()
+
+	// verify
+	assert.False(t, OperatorConfiguration.IsCassandraOperatorIntegrationEnabled())
+}
@@ -54,6 +54,111 @@ func (p KafkaOperatorIntegration) String() string {
 	return [...]string{"Yes", "No"}[p]
 }
 
+// KafkaNodePoolsAvailability holds the if the Kafka Operator supports KafkaNodePools.
+type KafkaNodePoolsAvailability int
+
+const (
+	// KafkaNodePoolsAvailabilityYes represents the KafkaNodePools are supported.
+	KafkaNodePoolsAvailabilityYes KafkaNodePoolsAvailability = iota
+
+	// KafkaNodePoolsAvailabilityNo represents the KafkaNodePools are not supported.
+	KafkaNodePoolsAvailabilityNo
+)
+
+func (p KafkaNodePoolsAvailability) String() string {
+	return [...]string{"Yes", "No"}[p]
+}
+
+// CassandraOperatorIntegration holds the if the Cassandra Operator integration is enabled.
+type CassandraOperatorIntegration int
+
+const (
+	// CassandraOperatorIntegrationYes represents the Cassandra Operator integration is enabled.
+	CassandraOperatorIntegrationYes CassandraOperatorIntegration = iota
+
+	// CassandraOperatorIntegrationNo represents the Cassandra Operator integration is disabled.
+	CassandraOperatorIntegrationNo
+)
+
+func (p CassandraOperatorIntegration) String() string {
+	return [...]string{"Yes", "No"}[p]
+}
+
+// K8ssandraAvailability holds the if the K8ssandra operator is available.
+type K8ssandraAvailability int
+
+const (
+	// K8ssandraAvailabilityYes represents the K8ssandra operator is available.
+	K8ssandraAvailabilityYes K8ssandraAvailability = iota
+
+	// K8ssandraAvailabilityNo represents the K8ssandra operator is not available.
+	K8ssandraAvailabilityNo
+)
+
+func (p K8ssandraAvailability) String() string {
+	return [...]string{"Yes", "No"}[p]
+}
+
+// ArgoRolloutsAvailability holds the if the Argo Rollouts API is available.
+type ArgoRolloutsAvailability int
+
+const (
+	// ArgoRolloutsAvailabilityYes represents the Argo Rollouts API is available.
+	ArgoRolloutsAvailabilityYes ArgoRolloutsAvailability = iota
+
+	// ArgoRolloutsAvailabilityNo represents the Argo Rollouts API is not available.
+	ArgoRolloutsAvailabilityNo
+)
+
+func (p ArgoRolloutsAvailability) String() string {
+	return [...]string{"Yes", "No"}[p]
+}
+
+// NativeSidecarsAvailability holds the if the cluster supports native sidecars.
+type NativeSidecarsAvailability int
+
+const (
+	// NativeSidecarsAvailabilityYes represents the native sidecars are supported.
+	NativeSidecarsAvailabilityYes NativeSidecarsAvailability = iota
+
+	// NativeSidecarsAvailabilityNo represents the native sidecars are not supported.
+	NativeSidecarsAvailabilityNo
+)
+
+func (p NativeSidecarsAvailability) String() string {
+	return [...]string{"Yes", "No"}[p]
+}
+
+// KEDAIntegration holds the if the KEDA integration is enabled.
+type KEDAIntegration int
+
+const (
+	// KEDAIntegrationYes represents the KEDA integration is enabled.
+	KEDAIntegrationYes KEDAIntegration = iota
+
+	// KEDAIntegrationNo represents the KEDA integration is disabled.
+	KEDAIntegrationNo
+)
+
+func (p KEDAIntegration) String() string {
+	return [...]string{"Yes", "No"}[p]
+}
+
+// PrometheusOperatorIntegration holds the if the Prometheus Operator integration is enabled.
+type PrometheusOperatorIntegration int
+
+const (
+	// PrometheusOperatorIntegrationYes represents the Prometheus Operator integration is enabled.
+	PrometheusOperatorIntegrationYes PrometheusOperatorIntegration = iota
+
+	// PrometheusOperatorIntegrationNo represents the Prometheus Operator integration is disabled.
+	PrometheusOperatorIntegrationNo
+)
+
+func (p PrometheusOperatorIntegration) String() string {
+	return [...]string{"Yes", "No"}[p]
+}
+
 // AuthDelegatorAvailability holds the if the AuthDelegator available.
 type AuthDelegatorAvailability int
 
@@ -179,6 +284,232 @@ func (c *operatorConfigurationWrapper) IsKafkaOperatorIntegrationEnabled() bool
 	return c.GetKafkaIntegration() == KafkaOperatorIntegrationYes
 }
 
+func (c *operatorConfigurationWrapper) SetKafkaNodePoolsAvailability(e interface{}) {
+	var availability string
+	switch v := e.(type) {
+	case string:
+		availability = v
+	case KafkaNodePoolsAvailability:
+		availability = v.String()
+	default:
+		availability = KafkaNodePoolsAvailabilityNo.String()
+	}
+
+	c.mu.Lock()
+	viper.Set(v1.FlagKafkaNodePools, availability)
+	c.mu.Unlock()
+}
+
+func (c *operatorConfigurationWrapper) GetKafkaNodePoolsAvailability() KafkaNodePoolsAvailability {
+	c.mu.RLock()
+	e := viper.GetString(v1.FlagKafkaNodePools)
+	c.mu.RUnlock()
+
+	if strings.ToLower(e) == "yes" {
+		return KafkaNodePoolsAvailabilityYes
+	}
+	return KafkaNodePoolsAvailabilityNo
+}
+
+// IsKafkaNodePoolsAvailable returns true if the Kafka Operator supports KRaft clusters made of KafkaNodePools
+func (c *operatorConfigurationWrapper) IsKafkaNodePoolsAvailable() bool {
+	return c.GetKafkaNodePoolsAvailability() == KafkaNodePoolsAvailabilityYes
+}
+
+func (c *operatorConfigurationWrapper) SetCassandraIntegration(e interface{}) {
+	var integration string
+	switch v := e.(type) {
+	case string:
+		integration = v
+	case CassandraOperatorIntegration:
+		integration = v.String()
+	default:
+		integration = CassandraOperatorIntegrationNo.String()
+	}
+
+	c.mu.Lock()
+	viper.Set(v1.FlagCassandraProvision, integration)
+	c.mu.Unlock()
+}
+
+func (c *operatorConfigurationWrapper) GetCassandraIntegration() CassandraOperatorIntegration {
+	c.mu.RLock()
+	e := viper.GetString(v1.FlagCassandraProvision)
+	c.mu.RUnlock()
+
+	if strings.ToLower(e) == "yes" {
+		return CassandraOperatorIntegrationYes
+	}
+	return CassandraOperatorIntegrationNo
+}
+
+// IsCassandraOperatorIntegrationEnabled returns true if the integration with cass-operator is enabled
+func (c *operatorConfigurationWrapper) IsCassandraOperatorIntegrationEnabled() bool {
+	return c.GetCassandraIntegration() == CassandraOperatorIntegrationYes
+}
+
+func (c *operatorConfigurationWrapper) SetK8ssandraAvailability(e interface{}) {
+	var availability string
+	switch v := e.(type) {
+	case string:
+		availability = v
+	case K8ssandraAvailability:
+		availability = v.String()
+	default:
+		availability = K8ssandraAvailabilityNo.String()
+	}
+
+	c.mu.Lock()
+	viper.Set(v1.FlagK8ssandra, availability)
+	c.mu.Unlock()
+}
+
+func (c *operatorConfigurationWrapper) GetK8ssandraAvailability() K8ssandraAvailability {
+	c.mu.RLock()
+	e := viper.GetString(v1.FlagK8ssandra)
+	c.mu.RUnlock()
+
+	if strings.ToLower(e) == "yes" {
+		return K8ssandraAvailabilityYes
+	}
+	return K8ssandraAvailabilityNo
+}
+
+// IsK8ssandraAvailable returns true if the K8ssandra operator is available, in which case the provisioned Cassandra
+// clusters are K8ssandraClusters
+func (c *operatorConfigurationWrapper) IsK8ssandraAvailable() bool {
+	return c.GetK8ssandraAvailability() == K8ssandraAvailabilityYes
+}
+
+func (c *operatorConfigurationWrapper) SetArgoRolloutsAvailability(e interface{}) {
+	var availability string
+	switch v := e.(type) {
+	case string:
+		availability = v
+	case ArgoRolloutsAvailability:
+		availability = v.String()
+	default:
+		availability = ArgoRolloutsAvailabilityNo.String()
+	}
+
+	c.mu.Lock()
+	viper.Set(v1.FlagArgoRollouts, availability)
+	c.mu.Unlock()
+}
+
+func (c *operatorConfigurationWrapper) GetArgoRolloutsAvailability() ArgoRolloutsAvailability {
+	c.mu.RLock()
+	e := viper.GetString(v1.FlagArgoRollouts)
+	c.mu.RUnlock()
+
+	if strings.ToLower(e) == "yes" {
+		return ArgoRolloutsAvailabilityYes
+	}
+	return ArgoRolloutsAvailabilityNo
+}
+
+// IsArgoRolloutsAvailable returns true if the Argo Rollouts API is available
+func (c *operatorConfigurationWrapper) IsArgoRolloutsAvailable() bool {
+	return c.GetArgoRolloutsAvailability() == ArgoRolloutsAvailabilityYes
+}
+
+func (c *operatorConfigurationWrapper) SetNativeSidecarsAvailability(e interface{}) {
+	var availability string
+	switch v := e.(type) {
+	case string:
+		availability = v
+	case NativeSidecarsAvailability:
+		availability = v.String()
+	default:
+		availability = NativeSidecarsAvailabilityNo.String()
+	}
+
+	c.mu.Lock()
+	viper.Set(v1.FlagNativeSidecars, availability)
+	c.mu.Unlock()
+}
+
+func (c *operatorConfigurationWrapper) GetNativeSidecarsAvailability() NativeSidecarsAvailability {
+	c.mu.RLock()
+	e := viper.GetString(v1.FlagNativeSidecars)
+	c.mu.RUnlock()
+
+	if strings.ToLower(e) == "yes" {
+		return NativeSidecarsAvailabilityYes
+	}
+	return NativeSidecarsAvailabilityNo
+}
+
+// IsNativeSidecarsAvailable returns true if the cluster runs init containers with the 'Always' restart policy as
+// sidecars, which keep running alongside the main containers without preventing the pod from completing
+func (c *operatorConfigurationWrapper) IsNativeSidecarsAvailable() bool {
+	return c.GetNativeSidecarsAvailability() == NativeSidecarsAvailabilityYes
+}
+
+func (c *operatorConfigurationWrapper) SetKEDAIntegration(e interface{}) {
+	var integration string
+	switch v := e.(type) {
+	case string:
+		integration = v
+	case KEDAIntegration:
+		integration = v.String()
+	default:
+		integration = KEDAIntegrationNo.String()
+	}
+
+	c.mu.Lock()
+	viper.Set(v1.FlagKEDAIntegration, integration)
+	c.mu.Unlock()
+}
+
+func (c *operatorConfigurationWrapper) GetKEDAIntegration() KEDAIntegration {
+	c.mu.RLock()
+	e := viper.GetString(v1.FlagKEDAIntegration)
+	c.mu.RUnlock()
+
+	if strings.ToLower(e) == "yes" {
+		return KEDAIntegrationYes
+	}
+	return KEDAIntegrationNo
+}
+
+// IsKEDAIntegrationEnabled returns true if the integration with KEDA is enabled
+func (c *operatorConfigurationWrapper) IsKEDAIntegrationEnabled() bool {
+	return c.GetKEDAIntegration() == KEDAIntegrationYes
+}
+
+func (c *operatorConfigurationWrapper) SetPrometheusOperatorIntegration(e interface{}) {
+	var integration string
+	switch v := e.(type) {
+	case string:
+		integration = v
+	case PrometheusOperatorIntegration:
+		integration = v.String()
+	default:
+		integration = PrometheusOperatorIntegrationNo.String()
+	}
+
+	c.mu.Lock()
+	viper.Set(v1.FlagPrometheusOperatorIntegration, integration)
+	c.mu.Unlock()
+}
+
+func (c *operatorConfigurationWrapper) GetPrometheusOperatorIntegration() PrometheusOperatorIntegration {
+	c.mu.RLock()
+	e := viper.GetString(v1.FlagPrometheusOperatorIntegration)
+	c.mu.RUnlock()
+
+	if strings.ToLower(e) == "yes" {
+		return PrometheusOperatorIntegrationYes
+	}
+	return PrometheusOperatorIntegrationNo
+}
+
+// IsPrometheusOperatorIntegrationEnabled returns true if the integration with the Prometheus Operator is enabled
+func (c *operatorConfigurationWrapper) IsPrometheusOperatorIntegrationEnabled() bool {
+	return c.GetPrometheusOperatorIntegration() == PrometheusOperatorIntegrationYes
+}
+
 func (c *operatorConfigurationWrapper) SetAuthDelegatorAvailability(e interface{}) {
 	var availability string
 	switch v := e.(type) {
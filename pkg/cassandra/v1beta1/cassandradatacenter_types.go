@@ -0,0 +1,55 @@
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+)
+
+// CassandraDatacenterSpec defines the desired state of CassandraDatacenter
+type CassandraDatacenterSpec struct {
+	v1.FreeForm `json:",inline"`
+}
+
+// CassandraDatacenterStatus defines the observed state of CassandraDatacenter
+type CassandraDatacenterStatus struct {
+	// CassandraOperatorProgress is "Ready" once the datacenter has been set up
+	CassandraOperatorProgress string `json:"cassandraOperatorProgress,omitempty"`
+
+	// +listType=set
+	Conditions []CassandraDatacenterCondition `json:"conditions,omitempty"`
+}
+
+// CassandraDatacenterCondition holds the different conditions affecting the CassandraDatacenter
+type CassandraDatacenterCondition struct {
+	Type               string `json:"type,omitempty"`
+	Status             string `json:"status,omitempty"`
+	LastTransitionTime string `json:"lastTransitionTime,omitempty"`
+	Reason             string `json:"reason,omitempty"`
+	Message            string `json:"message,omitempty"`
+}
+
+// CassandraDatacenter is the Schema for the cassandradatacenters API
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=cassandradatacenters,scope=Namespaced
+type CassandraDatacenter struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CassandraDatacenterSpec   `json:"spec,omitempty"`
+	Status CassandraDatacenterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CassandraDatacenterList contains a list of CassandraDatacenter
+type CassandraDatacenterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CassandraDatacenter `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&CassandraDatacenter{}, &CassandraDatacenterList{})
+}
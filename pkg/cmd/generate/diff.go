@@ -0,0 +1,403 @@
+package generate
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/viper"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
+	batchv1 "k8s.io/api/batch/v1"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/diff"
+	"github.com/jaegertracing/jaeger-operator/pkg/inventory"
+	"github.com/jaegertracing/jaeger-operator/pkg/util"
+)
+
+const (
+	actionCreate = "create"
+	actionUpdate = "update"
+	actionDelete = "delete"
+)
+
+var actionSymbols = map[string]string{
+	actionCreate: "+",
+	actionUpdate: "~",
+	actionDelete: "-",
+}
+
+// objectChange describes what applying the generated manifests would do to a single existing object
+type objectChange struct {
+	action    string
+	kind      string
+	namespace string
+	name      string
+	diff      string
+}
+
+// objectsByKind holds the objects we are able to compare, grouped in the way the inventory expects them
+type objectsByKind struct {
+	deployments              []appsv1.Deployment
+	services                 []corev1.Service
+	cronJobs                 []runtime.Object
+	horizontalPodAutoscalers []runtime.Object
+}
+
+func printDiff(ctx context.Context, out io.Writer, jaeger *v1.Jaeger, desired []runtime.Object) error {
+	existing, err := existingObjects(ctx, viper.GetString("existing"), jaeger)
+	if err != nil {
+		return err
+	}
+
+	changes, err := diffObjects(existing, desired)
+	if err != nil {
+		return err
+	}
+
+	counts := map[string]int{}
+	for _, c := range changes {
+		counts[c.action]++
+		fmt.Fprintf(out, "%s %s %s %s/%s\n", actionSymbols[c.action], c.action, c.kind, c.namespace, c.name)
+		if len(c.diff) > 0 {
+			fmt.Fprintln(out, c.diff)
+		}
+	}
+	fmt.Fprintf(out, "%d to create, %d to update, %d to delete\n", counts[actionCreate], counts[actionUpdate], counts[actionDelete])
+
+	return nil
+}
+
+// existingObjects returns the objects belonging to the given instance, read from the given file or directory with
+// exported objects or, when no path is given, from the cluster the current kubeconfig points to
+func existingObjects(ctx context.Context, path string, jaeger *v1.Jaeger) ([]runtime.Object, error) {
+	if len(path) == 0 {
+		return clusterObjects(ctx, jaeger)
+	}
+
+	objs, err := readObjects(path)
+	if err != nil {
+		return nil, err
+	}
+
+	// exported directories usually have more than what we own, we only compare against the objects for this instance
+	var owned []runtime.Object
+	for _, obj := range objs {
+		o, ok := obj.(client.Object)
+		if !ok {
+			continue
+		}
+		if o.GetNamespace() != jaeger.Namespace ||
+			o.GetLabels()["app.kubernetes.io/instance"] != jaeger.Name ||
+			o.GetLabels()["app.kubernetes.io/managed-by"] != "jaeger-operator" {
+			continue
+		}
+		owned = append(owned, obj)
+	}
+	return owned, nil
+}
+
+func readObjects(path string) ([]runtime.Object, error) {
+	var objs []runtime.Object
+	err := filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		if p != path && !strings.HasSuffix(p, ".yaml") && !strings.HasSuffix(p, ".yml") && !strings.HasSuffix(p, ".json") {
+			return nil
+		}
+
+		// #nosec   G304: Potential file inclusion via variable
+		f, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		defer util.CloseFile(f, &log.Log)
+
+		decoded, err := decodeObjects(f)
+		if err != nil {
+			return fmt.Errorf("failed to read the objects from %s: %w", p, err)
+		}
+		objs = append(objs, decoded...)
+		return nil
+	})
+	return objs, err
+}
+
+// decodeObjects decodes all the documents from the given YAML or JSON stream, expanding lists into their items
+func decodeObjects(r io.Reader) ([]runtime.Object, error) {
+	deserializer := scheme.Codecs.UniversalDeserializer()
+	reader := yaml.NewYAMLReader(bufio.NewReader(r))
+
+	var objs []runtime.Object
+	for {
+		doc, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return objs, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(bytes.TrimSpace(doc)) == 0 {
+			continue
+		}
+
+		obj, _, err := deserializer.Decode(doc, nil, nil)
+		if err != nil {
+			// not every exported object is known to us, but we don't compare those anyway
+			log.Log.V(1).Info("skipping object that couldn't be decoded", "error", err.Error())
+			continue
+		}
+
+		list, ok := obj.(*corev1.List)
+		if !ok {
+			objs = append(objs, obj)
+			continue
+		}
+		for _, item := range list.Items {
+			itemObj, _, err := deserializer.Decode(item.Raw, nil, nil)
+			if err != nil {
+				log.Log.V(1).Info("skipping list item that couldn't be decoded", "error", err.Error())
+				continue
+			}
+			objs = append(objs, itemObj)
+		}
+	}
+}
+
+func clusterObjects(ctx context.Context, jaeger *v1.Jaeger) ([]runtime.Object, error) {
+	cfg, err := ctrl.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+	cl, err := client.New(cfg, client.Options{Scheme: scheme.Scheme})
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []client.ListOption{
+		client.InNamespace(jaeger.Namespace),
+		client.MatchingLabels(map[string]string{
+			"app.kubernetes.io/instance":   jaeger.Name,
+			"app.kubernetes.io/managed-by": "jaeger-operator",
+		}),
+	}
+
+	var objs []runtime.Object
+	depList := &appsv1.DeploymentList{}
+	if err := cl.List(ctx, depList, opts...); err != nil {
+		return nil, err
+	}
+	for i := range depList.Items {
+		objs = append(objs, &depList.Items[i])
+	}
+
+	svcList := &corev1.ServiceList{}
+	if err := cl.List(ctx, svcList, opts...); err != nil {
+		return nil, err
+	}
+	for i := range svcList.Items {
+		objs = append(objs, &svcList.Items[i])
+	}
+
+	if viper.GetString(v1.FlagCronJobsVersion) == v1.FlagCronJobsVersionBatchV1Beta1 {
+		list := &batchv1beta1.CronJobList{}
+		if err := cl.List(ctx, list, opts...); err != nil {
+			return nil, err
+		}
+		for i := range list.Items {
+			objs = append(objs, &list.Items[i])
+		}
+	} else {
+		list := &batchv1.CronJobList{}
+		if err := cl.List(ctx, list, opts...); err != nil {
+			return nil, err
+		}
+		for i := range list.Items {
+			objs = append(objs, &list.Items[i])
+		}
+	}
+
+	if viper.GetString(v1.FlagAutoscalingVersion) == v1.FlagAutoscalingVersionV2Beta2 {
+		list := &autoscalingv2beta2.HorizontalPodAutoscalerList{}
+		if err := cl.List(ctx, list, opts...); err != nil {
+			return nil, err
+		}
+		for i := range list.Items {
+			objs = append(objs, &list.Items[i])
+		}
+	} else {
+		list := &autoscalingv2.HorizontalPodAutoscalerList{}
+		if err := cl.List(ctx, list, opts...); err != nil {
+			return nil, err
+		}
+		for i := range list.Items {
+			objs = append(objs, &list.Items[i])
+		}
+	}
+
+	return objs, nil
+}
+
+// diffObjects uses the same inventory the reconciliation uses to determine which of the existing objects
+// would be created, updated or deleted when the desired objects are applied
+func diffObjects(existing []runtime.Object, desired []runtime.Object) ([]objectChange, error) {
+	e := groupByKind(existing)
+	d := groupByKind(desired)
+
+	index := map[string]client.Object{}
+	for _, obj := range existing {
+		if o, ok := obj.(client.Object); ok {
+			index[objectKey(o)] = o
+		}
+	}
+
+	var create, update, remove []client.Object
+
+	depInventory := inventory.ForDeployments(e.deployments, d.deployments)
+	for i := range depInventory.Create {
+		create = append(create, &depInventory.Create[i])
+	}
+	for i := range depInventory.Update {
+		update = append(update, &depInventory.Update[i])
+	}
+	for i := range depInventory.Delete {
+		remove = append(remove, &depInventory.Delete[i])
+	}
+
+	svcInventory := inventory.ForServices(e.services, d.services)
+	for i := range svcInventory.Create {
+		create = append(create, &svcInventory.Create[i])
+	}
+	for i := range svcInventory.Update {
+		update = append(update, &svcInventory.Update[i])
+	}
+	for i := range svcInventory.Delete {
+		remove = append(remove, &svcInventory.Delete[i])
+	}
+
+	cronJobInventory := inventory.ForCronJobs(e.cronJobs, d.cronJobs)
+	create = append(create, clientObjects(cronJobInventory.Create)...)
+	update = append(update, clientObjects(cronJobInventory.Update)...)
+	remove = append(remove, clientObjects(cronJobInventory.Delete)...)
+
+	hpaInventory := inventory.ForHorizontalPodAutoscalers(e.horizontalPodAutoscalers, d.horizontalPodAutoscalers)
+	create = append(create, clientObjects(hpaInventory.Create)...)
+	update = append(update, clientObjects(hpaInventory.Update)...)
+	remove = append(remove, clientObjects(hpaInventory.Delete)...)
+
+	var changes []objectChange
+	for _, obj := range create {
+		changes = append(changes, newObjectChange(actionCreate, obj, ""))
+	}
+	for _, obj := range update {
+		current := index[objectKey(obj)]
+		fields, err := fieldDiff(current, obj)
+		if err != nil {
+			return nil, err
+		}
+		if len(fields) == 0 {
+			// nothing would change for this object
+			continue
+		}
+		changes = append(changes, newObjectChange(actionUpdate, obj, fields))
+	}
+	for _, obj := range remove {
+		changes = append(changes, newObjectChange(actionDelete, obj, ""))
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		if changes[i].kind != changes[j].kind {
+			return changes[i].kind < changes[j].kind
+		}
+		if changes[i].namespace != changes[j].namespace {
+			return changes[i].namespace < changes[j].namespace
+		}
+		return changes[i].name < changes[j].name
+	})
+	return changes, nil
+}
+
+// fieldDiff returns a field-level diff between the current object and the one that would be stored after the
+// update, one line per changed field. Fields that aren't part of the desired object are listed as removed when the
+// operator applied them before, as server-side apply removes them. The other ones are ignored, as they are typically
+// defaults or fields set by other controllers, which are left alone once the object is updated.
+func fieldDiff(current client.Object, updated client.Object) (string, error) {
+	// the owner references aren't known when generating the manifests
+	updated.SetOwnerReferences(current.GetOwnerReferences())
+
+	c, err := runtime.DefaultUnstructuredConverter.ToUnstructured(current)
+	if err != nil {
+		return "", err
+	}
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(updated)
+	if err != nil {
+		return "", err
+	}
+	delete(c, "status")
+	delete(u, "status")
+
+	return strings.Join(diff.Updates("", c, u, diff.OwnedFields(current, v1.FieldManager)), "\n"), nil
+}
+
+func groupByKind(objs []runtime.Object) objectsByKind {
+	g := objectsByKind{}
+	for _, obj := range objs {
+		switch o := obj.(type) {
+		case *appsv1.Deployment:
+			g.deployments = append(g.deployments, *o)
+		case *corev1.Service:
+			g.services = append(g.services, *o)
+		case *batchv1.CronJob, *batchv1beta1.CronJob:
+			g.cronJobs = append(g.cronJobs, obj)
+		case *autoscalingv2.HorizontalPodAutoscaler, *autoscalingv2beta2.HorizontalPodAutoscaler:
+			g.horizontalPodAutoscalers = append(g.horizontalPodAutoscalers, obj)
+		}
+	}
+	return g
+}
+
+func clientObjects(objs []runtime.Object) []client.Object {
+	var l []client.Object
+	for _, obj := range objs {
+		if o, ok := obj.(client.Object); ok {
+			l = append(l, o)
+		}
+	}
+	return l
+}
+
+func newObjectChange(action string, obj client.Object, fields string) objectChange {
+	return objectChange{
+		action:    action,
+		kind:      diff.Kind(obj),
+		namespace: obj.GetNamespace(),
+		name:      obj.GetName(),
+		diff:      fields,
+	}
+}
+
+func objectKey(obj client.Object) string {
+	return fmt.Sprintf("%s/%s/%s", diff.Kind(obj), obj.GetNamespace(), obj.GetName())
+}
@@ -0,0 +1,164 @@
+package generate
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+)
+
+const exported = `apiVersion: v1
+kind: List
+items:
+- apiVersion: apps/v1
+  kind: Deployment
+  metadata:
+    name: my-instance-collector
+    namespace: observability
+    resourceVersion: "42"
+    labels:
+      app.kubernetes.io/instance: my-instance
+      app.kubernetes.io/managed-by: jaeger-operator
+  spec:
+    progressDeadlineSeconds: 600
+    selector:
+      matchLabels:
+        app: jaeger
+    template:
+      spec:
+        containers:
+        - name: jaeger-collector
+          image: jaegertracing/jaeger-collector:1.50.0
+          terminationMessagePath: /dev/termination-log
+- apiVersion: v1
+  kind: Service
+  metadata:
+    name: my-instance-stale
+    namespace: observability
+    labels:
+      app.kubernetes.io/instance: my-instance
+      app.kubernetes.io/managed-by: jaeger-operator
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: someone-else
+  namespace: observability
+`
+
+func desiredObjects() []runtime.Object {
+	labels := map[string]string{
+		"app.kubernetes.io/instance":   "my-instance",
+		"app.kubernetes.io/managed-by": "jaeger-operator",
+	}
+	return []runtime.Object{
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-instance-collector", Namespace: "observability", Labels: labels},
+			Spec: appsv1.DeploymentSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "jaeger"}},
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{Containers: []corev1.Container{{
+						Name:  "jaeger-collector",
+						Image: "jaegertracing/jaeger-collector:1.51.0",
+					}}},
+				},
+			},
+		},
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-instance-query", Namespace: "observability", Labels: labels},
+		},
+	}
+}
+
+func TestDiffAgainstExportedObjects(t *testing.T) {
+	// prepare
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "exported.yaml"), []byte(exported), 0o600))
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance", Namespace: "observability"})
+
+	existing, err := existingObjects(context.Background(), dir, jaeger)
+	require.NoError(t, err)
+	require.Len(t, existing, 2)
+
+	// test
+	changes, err := diffObjects(existing, desiredObjects())
+
+	// verify
+	require.NoError(t, err)
+	require.Len(t, changes, 3)
+
+	assert.Equal(t, actionUpdate, changes[0].action)
+	assert.Equal(t, "Deployment", changes[0].kind)
+	assert.Equal(t, "my-instance-collector", changes[0].name)
+	assert.Equal(t, `  ~ spec.template.spec.containers[0].image: "jaegertracing/jaeger-collector:1.50.0" -> "jaegertracing/jaeger-collector:1.51.0"`, changes[0].diff)
+
+	assert.Equal(t, objectChange{action: actionCreate, kind: "Service", namespace: "observability", name: "my-instance-query"}, changes[1])
+	assert.Equal(t, objectChange{action: actionDelete, kind: "Service", namespace: "observability", name: "my-instance-stale"}, changes[2])
+}
+
+func TestDiffListsRemovedOwnedFields(t *testing.T) {
+	// prepare
+	desired := desiredObjects()
+	current := desired[0].DeepCopyObject().(*appsv1.Deployment)
+	current.Labels = map[string]string{
+		"app.kubernetes.io/instance":   "my-instance",
+		"app.kubernetes.io/managed-by": "jaeger-operator",
+		"removed-by-the-operator":      "true",
+		"set-by-someone-else":          "true",
+	}
+	current.Spec.Template.Spec.Containers[0].Image = "jaegertracing/jaeger-collector:1.51.0"
+	current.Spec.Template.Spec.Containers[0].Env = []corev1.EnvVar{{Name: "REMOVED", Value: "true"}}
+	current.ManagedFields = []metav1.ManagedFieldsEntry{{
+		Manager:   v1.FieldManager,
+		Operation: metav1.ManagedFieldsOperationApply,
+		FieldsV1: &metav1.FieldsV1{Raw: []byte(`{
+			"f:metadata":{"f:labels":{"f:app.kubernetes.io/instance":{},"f:removed-by-the-operator":{}}},
+			"f:spec":{"f:template":{"f:spec":{"f:containers":{"k:{\"name\":\"jaeger-collector\"}":{
+				".":{},"f:name":{},"f:image":{},"f:env":{"k:{\"name\":\"REMOVED\"}":{".":{},"f:name":{},"f:value":{}}}
+			}}}}}
+		}`)},
+	}}
+
+	// test
+	changes, err := diffObjects([]runtime.Object{current}, desired[:1])
+
+	// verify
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Equal(t, actionUpdate, changes[0].action)
+	assert.Equal(t, `  - metadata.labels.removed-by-the-operator: "true"
+  - spec.template.spec.containers[0].env: [{"name":"REMOVED","value":"true"}]`, changes[0].diff)
+}
+
+func TestDiffWithoutChanges(t *testing.T) {
+	// prepare
+	desired := desiredObjects()
+	existing := []runtime.Object{desired[0].DeepCopyObject(), desired[1].DeepCopyObject()}
+
+	// test
+	changes, err := diffObjects(existing, desired)
+
+	// verify
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+}
+
+func TestDecodeObjects(t *testing.T) {
+	// test
+	objs, err := decodeObjects(bytes.NewBufferString(exported))
+
+	// verify
+	require.NoError(t, err)
+	assert.Len(t, objs, 3)
+}
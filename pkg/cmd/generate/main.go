@@ -32,7 +32,12 @@ func NewGenerateCommand() *cobra.Command {
 		Short: "(experimental) Generate YAML manifests from Jaeger CRD",
 		Long: `Generate YAML manifests from Jaeger CRD.
 
-Defaults to reading Jaeger CRD from standard input and writing the manifest file to standard output, override with --cr <filename> and --output <filename>.`,
+Defaults to reading Jaeger CRD from standard input and writing the manifest file to standard output, override with --cr <filename> and --output <filename>.
+
+With --diff, prints the changes the manifests would make to the existing Deployments, Services, CronJobs and HorizontalPodAutoscalers
+of the instance instead of the manifests. The existing objects are read from the cluster the current kubeconfig points to,
+or from a file or directory with exported objects when --existing <path> is given. The fields the operator no longer sets
+are listed as removed based on the managed fields of the existing objects, exported with kubectl's --show-managed-fields.`,
 		PreRun: func(cmd *cobra.Command, args []string) {
 			viper.BindPFlags(cmd.Flags())
 		},
@@ -42,6 +47,8 @@ Defaults to reading Jaeger CRD from standard input and writing the manifest file
 	start.AddFlags(cmd)
 	cmd.Flags().String("cr", "/dev/stdin", "Input Jaeger CRD")
 	cmd.Flags().String("output", "/dev/stdout", "Where to print the generated YAML documents")
+	cmd.Flags().Bool("diff", false, "Print the changes the generated manifests would make to the existing objects, instead of the manifests")
+	cmd.Flags().String("existing", "", "File or directory with the exported objects to compare against when using --diff. When empty, the objects are read from the cluster")
 
 	return cmd
 }
@@ -100,7 +107,15 @@ func generate(_ *cobra.Command, _ []string) error {
 		return err
 	}
 
+	if viper.GetBool("diff") && len(spec.Namespace) == 0 {
+		// the objects we compare against always have a namespace
+		spec.Namespace = "default"
+	}
+
 	s := strategy.For(context.Background(), spec)
+	if err := s.Error(); err != nil {
+		return err
+	}
 
 	outputName := viper.GetString("output")
 	pathToFile := filepath.Clean(outputName)
@@ -111,8 +126,8 @@ func generate(_ *cobra.Command, _ []string) error {
 
 	defer util.CloseFile(out, &log.Log)
 
-	encoder := k8s_json.NewYAMLSerializer(k8s_json.DefaultMetaFactory, nil, nil)
-	for _, obj := range s.All() {
+	objs := s.All()
+	for _, obj := range objs {
 		// OwnerReferences normally references the CR, but it is not a
 		// resource in the cluster so we must remove it
 
@@ -122,7 +137,14 @@ func generate(_ *cobra.Command, _ []string) error {
 
 		meta := obj.(f)
 		meta.SetOwnerReferences(nil)
+	}
 
+	if viper.GetBool("diff") {
+		return printDiff(context.Background(), out, spec, objs)
+	}
+
+	encoder := k8s_json.NewYAMLSerializer(k8s_json.DefaultMetaFactory, nil, nil)
+	for _, obj := range objs {
 		fmt.Fprintln(out, "---")
 		if err := encoder.Encode(obj, out); err != nil {
 			log.Log.V(3).Info(fmt.Sprintf("Fatal error %s", err))
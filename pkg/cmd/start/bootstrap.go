@@ -42,10 +42,13 @@ import (
 	appsv1controllers "github.com/jaegertracing/jaeger-operator/controllers/appsv1"
 	esv1controllers "github.com/jaegertracing/jaeger-operator/controllers/elasticsearch"
 	jaegertracingcontrollers "github.com/jaegertracing/jaeger-operator/controllers/jaegertracing"
-	"github.com/jaegertracing/jaeger-operator/pkg/autoclean"
 	"github.com/jaegertracing/jaeger-operator/pkg/autodetect"
+	cassandrav1beta1 "github.com/jaegertracing/jaeger-operator/pkg/cassandra/v1beta1"
+	k8ssandrav1alpha1 "github.com/jaegertracing/jaeger-operator/pkg/k8ssandra/v1alpha1"
 	kafkav1beta2 "github.com/jaegertracing/jaeger-operator/pkg/kafka/v1beta2"
+	kedav1alpha1 "github.com/jaegertracing/jaeger-operator/pkg/keda/v1alpha1"
 	opmetrics "github.com/jaegertracing/jaeger-operator/pkg/metrics"
+	monitoringv1 "github.com/jaegertracing/jaeger-operator/pkg/monitoring/v1"
 	"github.com/jaegertracing/jaeger-operator/pkg/tracing"
 	"github.com/jaegertracing/jaeger-operator/pkg/upgrade"
 	"github.com/jaegertracing/jaeger-operator/pkg/util"
@@ -56,6 +59,9 @@ import (
 	esv1 "github.com/openshift/elasticsearch-operator/apis/logging/v1"
 )
 
+// eventSource is the component reported in the events recorded by the operator
+const eventSource = "jaeger-operator"
+
 var (
 	scheme   = k8sruntime.NewScheme()
 	setupLog = ctrl.Log.WithName("setup")
@@ -70,6 +76,10 @@ func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 	utilruntime.Must(jaegertracingv1.AddToScheme(scheme))
 	utilruntime.Must(kafkav1beta2.AddToScheme(scheme))
+	utilruntime.Must(cassandrav1beta1.AddToScheme(scheme))
+	utilruntime.Must(k8ssandrav1alpha1.AddToScheme(scheme))
+	utilruntime.Must(kedav1alpha1.AddToScheme(scheme))
+	utilruntime.Must(monitoringv1.AddToScheme(scheme))
 	utilruntime.Must(routev1.Install(scheme))
 	utilruntime.Must(osimagev1.Install(scheme))
 	utilruntime.Must(consolev1.Install(scheme))
@@ -126,15 +136,6 @@ func bootstrap(ctx context.Context) manager.Manager {
 		d.Start()
 	}
 
-	if c, err := autoclean.New(mgr); err != nil {
-		log.Log.Error(
-			err,
-			"failed to start the background process to auto-clean the operator objects",
-		)
-	} else {
-		c.Start()
-	}
-
 	detectNamespacePermissions(ctx, mgr)
 	performUpgrades(ctx, mgr)
 	setupControllers(ctx, mgr)
@@ -329,7 +330,7 @@ func performUpgrades(ctx context.Context, mgr manager.Manager) {
 	defer span.End()
 
 	// upgrades all the instances managed by this operator
-	if err := upgrade.ManagedInstances(ctx, mgr.GetClient(), mgr.GetAPIReader(), version.Get().Jaeger); err != nil {
+	if err := upgrade.ManagedInstances(ctx, mgr.GetClient(), mgr.GetAPIReader(), mgr.GetEventRecorderFor(eventSource), version.Get().Jaeger); err != nil {
 		log.Log.Error(err, "failed to upgrade managed instances")
 	}
 }
@@ -342,7 +343,7 @@ func setupControllers(ctx context.Context, mgr manager.Manager) {
 	schema := mgr.GetScheme()
 	defer span.End()
 
-	if err := jaegertracingcontrollers.NewReconciler(client, clientReader, schema).SetupWithManager(mgr); err != nil {
+	if err := jaegertracingcontrollers.NewReconciler(client, clientReader, schema, mgr.GetEventRecorderFor(eventSource)).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Jaeger")
 		os.Exit(1)
 	}
@@ -372,7 +373,10 @@ func setupWebhooks(_ context.Context, mgr manager.Manager) {
 	srv := mgr.GetWebhookServer()
 	decoder := admission.NewDecoder(mgr.GetScheme())
 	srv.Register("/mutate-v1-deployment", &webhook.Admission{
-		Handler: appsv1controllers.NewDeploymentInterceptorWebhook(mgr.GetClient(), decoder),
+		Handler: appsv1controllers.NewDeploymentInterceptorWebhook(mgr.GetClient(), decoder, mgr.GetEventRecorderFor(eventSource)),
+	})
+	srv.Register("/mutate-v1-workload", &webhook.Admission{
+		Handler: appsv1controllers.NewWorkloadInterceptorWebhook(mgr.GetClient(), decoder, mgr.GetEventRecorderFor(eventSource)),
 	})
 }
 
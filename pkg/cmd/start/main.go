@@ -24,6 +24,7 @@ func AddFlags(cmd *cobra.Command) {
 	cmd.Flags().String("jaeger-collector-image", "jaegertracing/jaeger-collector", "The Docker image for the Jaeger Collector")
 	cmd.Flags().String("jaeger-ingester-image", "jaegertracing/jaeger-ingester", "The Docker image for the Jaeger Ingester")
 	cmd.Flags().String("jaeger-all-in-one-image", "jaegertracing/all-in-one", "The Docker image for the Jaeger all-in-one")
+	cmd.Flags().String("jaeger-v2-image", "jaegertracing/jaeger", "The Docker image for the Jaeger v2 distribution, used by the 'otelCollector' strategy")
 	cmd.Flags().String("jaeger-cassandra-schema-image", "jaegertracing/jaeger-cassandra-schema", "The Docker image for the Jaeger Cassandra Schema")
 	cmd.Flags().String("jaeger-spark-dependencies-image", "ghcr.io/jaegertracing/spark-dependencies/spark-dependencies", "The Docker image for the Spark Dependencies Job")
 	cmd.Flags().String("jaeger-es-index-cleaner-image", "jaegertracing/jaeger-es-index-cleaner", "The Docker image for the Jaeger Elasticsearch Index Cleaner")
@@ -34,7 +35,12 @@ func AddFlags(cmd *cobra.Command) {
 	cmd.Flags().String("platform", v1.FlagPlatformAutoDetect, "The target platform the operator will run. Possible values: 'kubernetes', 'openshift', 'auto-detect'")
 	cmd.Flags().String("es-provision", v1.FlagProvisionElasticsearchAuto, "Whether to auto-provision an Elasticsearch cluster for suitable Jaeger instances. Possible values: 'yes', 'no', 'auto'. When set to 'auto' and the API name 'logging.openshift.io' is available, auto-provisioning is enabled.")
 	cmd.Flags().String("kafka-provision", "auto", "Whether to auto-provision a Kafka cluster for suitable Jaeger instances. Possible values: 'yes', 'no', 'auto'. When set to 'auto' and the API name 'kafka.strimzi.io' is available, auto-provisioning is enabled.")
-	cmd.Flags().Bool("kafka-provisioning-minimal", false, "(unsupported) Whether to provision Kafka clusters with minimal requirements, suitable for demos and tests.")
+	cmd.Flags().String(v1.FlagCassandraProvision, v1.FlagProvisionCassandraAuto, "Whether to auto-provision a Cassandra cluster for suitable Jaeger instances. Possible values: 'yes', 'no', 'auto'. When set to 'auto' and the API name 'cassandra.datastax.com' is available, auto-provisioning is enabled. K8ssandraClusters are provisioned when the API name 'k8ssandra.io' is available, CassandraDatacenters otherwise.")
+	cmd.Flags().String("keda-integration", "auto", "Whether to scale the ingesters of Jaeger instances requesting it with KEDA ScaledObjects. Possible values: 'yes', 'no', 'auto'. When set to 'auto' and the API name 'keda.sh' is available, the integration is enabled.")
+	cmd.Flags().String("prometheus-operator-integration", "auto", "Whether to create the ServiceMonitors, PodMonitors and PrometheusRules requested by Jaeger instances. Possible values: 'yes', 'no', 'auto'. When set to 'auto' and the API name 'monitoring.coreos.com' is available, the integration is enabled.")
+	cmd.Flags().String(v1.FlagKafkaProvisioningProfile, string(v1.KafkaProvisioningProfileProduction), "The size of the provisioned Kafka clusters, unless set by the Jaeger instance. Possible values: 'production', 'minimal'. The 'minimal' profile is suitable for demos and tests.")
+	cmd.Flags().Bool(v1.FlagKafkaProvisioningMinimal, false, "Whether to provision Kafka clusters with minimal requirements, suitable for demos and tests.")
+	_ = cmd.Flags().MarkDeprecated(v1.FlagKafkaProvisioningMinimal, fmt.Sprintf("use --%s=%s instead", v1.FlagKafkaProvisioningProfile, v1.KafkaProvisioningProfileMinimal))
 	cmd.Flags().String("secure-listen-address", "", "")
 	cmd.Flags().String("health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
 	cmd.Flags().Int("webhook-bind-port", 9443, "The address webhooks expose.")
@@ -48,6 +54,7 @@ func AddFlags(cmd *cobra.Command) {
 	_ = viper.BindEnv("jaeger-collector-image", "RELATED_IMAGE_JAEGER_COLLECTOR")
 	_ = viper.BindEnv("jaeger-ingester-image", "RELATED_IMAGE_JAEGER_INGESTER")
 	_ = viper.BindEnv("jaeger-all-in-one-image", "RELATED_IMAGE_JAEGER_ALL_IN_ONE")
+	_ = viper.BindEnv("jaeger-v2-image", "RELATED_IMAGE_JAEGER_V2")
 	_ = viper.BindEnv("jaeger-cassandra-schema-image", "RELATED_IMAGE_CASSANDRA_SCHEMA")
 	_ = viper.BindEnv("jaeger-spark-dependencies-image", "RELATED_IMAGE_SPARK_DEPENDENCIES")
 	_ = viper.BindEnv("jaeger-es-index-cleaner-image", "RELATED_IMAGE_JAEGER_ES_INDEX_CLEANER")
@@ -0,0 +1,36 @@
+package upgrade
+
+import (
+	"encoding/json"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/diff"
+)
+
+// specDiff returns a field-level diff between the spec before and after an upgrade step, one line per changed field
+func specDiff(before, after v1.JaegerSpec) ([]string, error) {
+	b, err := asMap(before)
+	if err != nil {
+		return nil, err
+	}
+	a, err := asMap(after)
+	if err != nil {
+		return nil, err
+	}
+
+	return diff.Changes("spec", b, a), nil
+}
+
+// asMap converts the spec into its JSON representation, which is what users see and edit
+func asMap(spec v1.JaegerSpec) (map[string]interface{}, error) {
+	b, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	m := map[string]interface{}{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
@@ -0,0 +1,78 @@
+package upgrade
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/upgrade"
+)
+
+func TestSpecDiff(t *testing.T) {
+	// prepare
+	before := v1.NewJaeger(types.NamespacedName{Name: "my-instance"}).Spec
+	before.Strategy = v1.DeploymentStrategyAllInOne
+	before.Collector.Options = v1.NewOptions(map[string]interface{}{
+		"es.tls":        true,
+		"collector.num": "1",
+	})
+
+	after := *before.DeepCopy()
+	after.Strategy = v1.DeploymentStrategyProduction
+	after.Collector.Options = v1.NewOptions(map[string]interface{}{
+		"es.tls.enabled": true,
+		"collector.num":  "1",
+	})
+
+	// test
+	lines, err := specDiff(before, after)
+
+	// verify
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		`  - spec.collector.options.es.tls: "true"`,
+		`  + spec.collector.options.es.tls.enabled: "true"`,
+		`  ~ spec.strategy: "allinone" -> "production"`,
+	}, lines)
+}
+
+func TestSpecDiffNoChanges(t *testing.T) {
+	// prepare
+	spec := v1.NewJaeger(types.NamespacedName{Name: "my-instance"}).Spec
+
+	// test
+	lines, err := specDiff(spec, spec)
+
+	// verify
+	require.NoError(t, err)
+	assert.Empty(t, lines)
+}
+
+func TestPrintSteps(t *testing.T) {
+	// prepare
+	before := *v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	after := *before.DeepCopy()
+	after.Spec.Strategy = v1.DeploymentStrategyProduction
+	steps := []upgrade.Step{
+		{Version: "1.28.0", Before: before, After: after},
+		{Version: "1.31.0", Before: after, After: after, Actions: []string{"delete Elasticsearch observability/elasticsearch"}},
+		{Version: "1.32.0", Before: after, After: after},
+	}
+	out := &bytes.Buffer{}
+
+	// test
+	require.NoError(t, printSteps(out, steps))
+
+	// verify
+	assert.Equal(t, `1.28.0:
+  + spec.strategy: "production"
+1.31.0:
+  ! delete Elasticsearch observability/elasticsearch
+1.32.0: no changes
+3 upgrade steps, 2 with changes
+`, out.String())
+}
@@ -0,0 +1,126 @@
+package upgrade
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/upgrade"
+	"github.com/jaegertracing/jaeger-operator/pkg/util"
+	"github.com/jaegertracing/jaeger-operator/pkg/version"
+)
+
+// NewUpgradeCommand creates the command that previews the upgrade of a Jaeger CR
+func NewUpgradeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade",
+		Short: "Preview the changes the upgrade of a Jaeger CR would make",
+		Long: `Preview the changes the upgrade of a Jaeger CR would make.
+
+Runs the upgrade steps from the version in the status of the CR, or the one given with --from, to the version of this
+operator, or the one given with --version, and prints the changes each of them makes to the spec. The changes the steps
+would make to other objects, like removing the self-provisioned Elasticsearch cluster, are listed but not made.
+
+Defaults to reading the Jaeger CR from standard input, override with --cr <filename>. The instances themselves are
+upgraded by the operator, which is why only --dry-run is supported.`,
+		PreRun: func(cmd *cobra.Command, args []string) {
+			viper.BindPFlags(cmd.Flags())
+		},
+		RunE: run,
+	}
+
+	cmd.Flags().String("cr", "/dev/stdin", "Input Jaeger CR")
+	cmd.Flags().Bool("dry-run", false, "Print the changes each upgrade step would make to the CR, without making them")
+	cmd.Flags().String("from", "", "Version the instance runs, defaults to the version from the status of the CR")
+	cmd.Flags().String("version", version.Get().Jaeger, "Version to upgrade the instance to")
+
+	return cmd
+}
+
+func run(cmd *cobra.Command, _ []string) error {
+	if !viper.GetBool("dry-run") {
+		return errors.New("only --dry-run is supported, the instances are upgraded by the operator")
+	}
+
+	log.SetLogger(zap.New(zap.WriteTo(os.Stderr)))
+
+	input := viper.GetString("cr")
+	if input == "/dev/stdin" {
+		log.Log.Info("Reading Jaeger CR from standard input (use --cr <filename> to override)")
+	}
+
+	jaeger, err := readJaeger(input)
+	if err != nil {
+		return err
+	}
+	if from := viper.GetString("from"); len(from) > 0 {
+		jaeger.Status.Version = from
+	}
+	if len(jaeger.Namespace) == 0 {
+		// the steps acting on other objects look for them in the namespace of the instance
+		jaeger.Namespace = "default"
+	}
+	if len(jaeger.Status.Version) == 0 {
+		return errors.New("the CR has no version in its status, use --from <version> to set the version the instance runs")
+	}
+
+	steps, err := upgrade.DryRun(context.Background(), *jaeger, viper.GetString("version"))
+	if err != nil {
+		return err
+	}
+
+	return printSteps(cmd.OutOrStdout(), steps)
+}
+
+func readJaeger(filename string) (*v1.Jaeger, error) {
+	// #nosec   G304: Potential file inclusion via variable
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer util.CloseFile(f, &log.Log)
+
+	var jaeger v1.Jaeger
+	decoder := yaml.NewYAMLOrJSONDecoder(f, 8192)
+	if err := decoder.Decode(&jaeger); err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	return &jaeger, nil
+}
+
+func printSteps(out io.Writer, steps []upgrade.Step) error {
+	changed := 0
+	for _, step := range steps {
+		lines, err := specDiff(step.Before.Spec, step.After.Spec)
+		if err != nil {
+			return err
+		}
+
+		if len(lines) == 0 && len(step.Actions) == 0 {
+			fmt.Fprintf(out, "%s: no changes\n", step.Version)
+			continue
+		}
+
+		changed++
+		fmt.Fprintf(out, "%s:\n", step.Version)
+		for _, line := range lines {
+			fmt.Fprintln(out, line)
+		}
+		for _, action := range step.Actions {
+			fmt.Fprintf(out, "  ! %s\n", action)
+		}
+	}
+	fmt.Fprintf(out, "%d upgrade steps, %d with changes\n", len(steps), changed)
+
+	return nil
+}
@@ -0,0 +1,424 @@
+package otelcol
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+	configmap "github.com/jaegertracing/jaeger-operator/pkg/config/ui"
+	"github.com/jaegertracing/jaeger-operator/pkg/util"
+)
+
+const (
+	// ConfigKey is the key holding the collector configuration in the config map
+	ConfigKey = "config.yaml"
+
+	// HealthCheckPort is the port where the health check extension listens
+	HealthCheckPort = 13133
+
+	// MetricsPort is the port where the collector exposes its own metrics
+	MetricsPort = 14269
+
+	// PasswordEnvVar is the environment variable the collector reads the storage password from
+	PasswordEnvVar = "JAEGER_STORAGE_PASSWORD"
+
+	storageName = "jaeger_storage"
+	mountPath   = "/etc/jaeger"
+	passwordKey = "password"
+)
+
+// Config represents the OpenTelemetry Collector configmap
+type Config struct {
+	jaeger *v1.Jaeger
+}
+
+// NewConfig builds a new Config struct based on the given spec
+func NewConfig(jaeger *v1.Jaeger) *Config {
+	return &Config{jaeger: jaeger}
+}
+
+// Get returns a configmap specification for the current instance, or an error when the configuration can't be rendered
+func (c *Config) Get() (*corev1.ConfigMap, error) {
+	config, err := Render(c.jaeger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render the OpenTelemetry Collector configuration: %w", err)
+	}
+
+	c.jaeger.Logger().V(-1).Info("Assembling the OpenTelemetry Collector configmap")
+
+	return &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "ConfigMap",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            configMapName(c.jaeger),
+			Namespace:       c.jaeger.Namespace,
+			Labels:          util.Labels(configMapName(c.jaeger), "otel-collector-configuration", *c.jaeger),
+			OwnerReferences: []metav1.OwnerReference{util.AsOwner(c.jaeger)},
+		},
+		Data: map[string]string{
+			ConfigKey: config,
+		},
+	}, nil
+}
+
+// CredentialsSecret returns the secret holding the storage password set in the storage options, so that the password
+// is exposed to the collector as an environment variable instead of being written into the config map. It returns nil
+// when no password is set.
+func CredentialsSecret(jaeger *v1.Jaeger) *corev1.Secret {
+	password, ok := storagePassword(jaeger.Spec.Storage)
+	if !ok {
+		return nil
+	}
+
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Secret",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            credentialsSecretName(jaeger),
+			Namespace:       jaeger.Namespace,
+			Labels:          util.Labels(credentialsSecretName(jaeger), "otel-collector-credentials", *jaeger),
+			OwnerReferences: []metav1.OwnerReference{util.AsOwner(jaeger)},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			passwordKey: []byte(password),
+		},
+	}
+}
+
+// Env returns the environment variables the collector configuration refers to, backed by the credentials secret
+func Env(jaeger *v1.Jaeger) []corev1.EnvVar {
+	if _, ok := storagePassword(jaeger.Spec.Storage); !ok {
+		return nil
+	}
+
+	return []corev1.EnvVar{{
+		Name: PasswordEnvVar,
+		ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: credentialsSecretName(jaeger),
+				},
+				Key: passwordKey,
+			},
+		},
+	}}
+}
+
+// Update will modify the supplied common spec and options to include
+// the OpenTelemetry Collector configmap
+func Update(jaeger *v1.Jaeger, commonSpec *v1.JaegerCommonSpec, options *[]string) {
+	volume := corev1.Volume{
+		Name: configVolumeName(jaeger),
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: configMapName(jaeger),
+				},
+				Items: []corev1.KeyToPath{
+					{
+						Key:  ConfigKey,
+						Path: ConfigKey,
+					},
+				},
+			},
+		},
+	}
+	volumeMount := corev1.VolumeMount{
+		Name:      configVolumeName(jaeger),
+		MountPath: mountPath,
+		ReadOnly:  true,
+	}
+	commonSpec.Volumes = append(commonSpec.Volumes, volume)
+	commonSpec.VolumeMounts = append(commonSpec.VolumeMounts, volumeMount)
+	*options = append(*options, fmt.Sprintf("--config=%s/%s", mountPath, ConfigKey))
+}
+
+// Render returns the OpenTelemetry Collector configuration for the given instance, built from
+// the storage spec and merged with the configuration provided by the user under spec.collector.config
+func Render(jaeger *v1.Jaeger) (string, error) {
+	config := defaultConfig(jaeger)
+
+	if !jaeger.Spec.Collector.Config.IsEmpty() {
+		userConfig, err := jaeger.Spec.Collector.Config.GetMap()
+		if err != nil {
+			return "", err
+		}
+		merge(config, userConfig)
+	}
+
+	out, err := yaml.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+func defaultConfig(jaeger *v1.Jaeger) map[string]interface{} {
+	query := map[string]interface{}{
+		"storage": map[string]interface{}{
+			"traces": storageName,
+		},
+	}
+	queryOptions := jaeger.Spec.Query.Options.StringMap()
+	if basePath, ok := queryOptions["query.base-path"]; ok {
+		query["base_path"] = basePath
+	}
+
+	// the UI configuration is mounted the same way it is for the all-in-one image
+	uiOptions := []string{}
+	configmap.Update(jaeger, &v1.JaegerCommonSpec{}, &uiOptions)
+	if len(uiOptions) > 0 {
+		query["ui"] = map[string]interface{}{
+			"config_file": strings.TrimPrefix(uiOptions[0], "--query.ui-config="),
+		}
+	}
+
+	return map[string]interface{}{
+		"receivers": map[string]interface{}{
+			"otlp": map[string]interface{}{
+				"protocols": map[string]interface{}{
+					"grpc": endpoint(4317),
+					"http": endpoint(4318),
+				},
+			},
+			"jaeger": map[string]interface{}{
+				"protocols": map[string]interface{}{
+					"grpc":        endpoint(14250),
+					"thrift_http": endpoint(14268),
+				},
+			},
+			"zipkin": endpoint(9411),
+		},
+		"processors": map[string]interface{}{
+			"batch": map[string]interface{}{},
+		},
+		"exporters": map[string]interface{}{
+			"jaeger_storage_exporter": map[string]interface{}{
+				"trace_storage": storageName,
+			},
+		},
+		"extensions": map[string]interface{}{
+			"jaeger_storage": map[string]interface{}{
+				"backends": map[string]interface{}{
+					storageName: storageBackend(jaeger.Spec.Storage),
+				},
+			},
+			"jaeger_query": query,
+			"healthcheckv2": map[string]interface{}{
+				"use_v2": true,
+				"http":   endpoint(HealthCheckPort),
+			},
+		},
+		"service": map[string]interface{}{
+			"extensions": []interface{}{"jaeger_storage", "jaeger_query", "healthcheckv2"},
+			"pipelines": map[string]interface{}{
+				"traces": map[string]interface{}{
+					"receivers":  []interface{}{"otlp", "jaeger", "zipkin"},
+					"processors": []interface{}{"batch"},
+					"exporters":  []interface{}{"jaeger_storage_exporter"},
+				},
+			},
+			"telemetry": map[string]interface{}{
+				"metrics": map[string]interface{}{
+					"address": fmt.Sprintf("0.0.0.0:%d", MetricsPort),
+				},
+			},
+		},
+	}
+}
+
+// storageBackend translates the storage options used by the Jaeger v1 binaries into
+// the backend configuration expected by the jaeger_storage extension
+func storageBackend(spec v1.JaegerStorageSpec) map[string]interface{} {
+	opts := spec.Options.StringMap()
+
+	switch spec.Type {
+	case v1.JaegerBadgerStorage:
+		badger := map[string]interface{}{
+			"ephemeral": parseBool(opts["badger.ephemeral"], true),
+		}
+		directories := map[string]interface{}{}
+		if dir, ok := opts["badger.directory-key"]; ok {
+			directories["keys"] = dir
+		}
+		if dir, ok := opts["badger.directory-value"]; ok {
+			directories["values"] = dir
+		}
+		if len(directories) > 0 {
+			badger["directories"] = directories
+		}
+		return map[string]interface{}{"badger": badger}
+
+	case v1.JaegerESStorage:
+		es := map[string]interface{}{
+			"server_urls": splitList(opts["es.server-urls"], "http://elasticsearch:9200"),
+		}
+		if prefix, ok := opts["es.index-prefix"]; ok {
+			es["indices"] = map[string]interface{}{
+				"index_prefix": prefix,
+			}
+		}
+		if parseBool(opts["es.use-aliases"], false) {
+			es["use_aliases"] = true
+		}
+		if username, ok := opts["es.username"]; ok {
+			es["auth"] = basicAuth(username, passwordRef(spec))
+		} else if len(spec.SecretName) > 0 {
+			// the secret is exposed to the collector as environment variables
+			es["auth"] = basicAuth("${env:ES_USERNAME}", "${env:ES_PASSWORD}")
+		}
+		if parseBool(opts["es.tls.enabled"], false) || parseBool(opts["es.tls.skip-host-verify"], false) {
+			tls := map[string]interface{}{}
+			if ca, ok := opts["es.tls.ca"]; ok {
+				tls["ca_file"] = ca
+			}
+			if parseBool(opts["es.tls.skip-host-verify"], false) {
+				tls["insecure_skip_verify"] = true
+			}
+			es["tls"] = tls
+		}
+		return map[string]interface{}{"elasticsearch": es}
+
+	case v1.JaegerCassandraStorage:
+		connection := map[string]interface{}{
+			"servers": splitList(opts["cassandra.servers"], "cassandra"),
+		}
+		if port, err := strconv.Atoi(opts["cassandra.port"]); err == nil {
+			connection["port"] = port
+		}
+		if username, ok := opts["cassandra.username"]; ok {
+			connection["auth"] = basicAuth(username, passwordRef(spec))
+		}
+		cassandra := map[string]interface{}{
+			"connection": connection,
+		}
+		if keyspace, ok := opts["cassandra.keyspace"]; ok {
+			cassandra["schema"] = map[string]interface{}{
+				"keyspace": keyspace,
+			}
+		}
+		return map[string]interface{}{"cassandra": cassandra}
+
+	case v1.JaegerGRPCPluginStorage:
+		grpc := map[string]interface{}{
+			"tls": map[string]interface{}{
+				"insecure": !parseBool(opts["grpc-storage.tls.enabled"], false),
+			},
+		}
+		if server, ok := opts["grpc-storage.server"]; ok {
+			grpc["endpoint"] = server
+		}
+		return map[string]interface{}{"grpc": grpc}
+	}
+
+	maxTraces := 100000
+	if val, err := strconv.Atoi(opts["memory.max-traces"]); err == nil {
+		maxTraces = val
+	}
+	return map[string]interface{}{
+		"memory": map[string]interface{}{
+			"max_traces": maxTraces,
+		},
+	}
+}
+
+// storagePassword returns the password set in the storage options, used along with the username
+func storagePassword(spec v1.JaegerStorageSpec) (string, bool) {
+	opts := spec.Options.StringMap()
+
+	var prefix string
+	switch spec.Type {
+	case v1.JaegerESStorage:
+		prefix = "es"
+	case v1.JaegerCassandraStorage:
+		prefix = "cassandra"
+	default:
+		return "", false
+	}
+
+	if _, ok := opts[prefix+".username"]; !ok {
+		return "", false
+	}
+	password, ok := opts[prefix+".password"]
+	return password, ok
+}
+
+// passwordRef returns the reference to the storage password, read by the collector from the credentials secret
+func passwordRef(spec v1.JaegerStorageSpec) string {
+	if _, ok := storagePassword(spec); !ok {
+		return ""
+	}
+	return fmt.Sprintf("${env:%s}", PasswordEnvVar)
+}
+
+// merge copies the entries from src into dst, descending into nested maps so that
+// users only have to provide the parts of the configuration they want to change
+func merge(dst, src map[string]interface{}) {
+	for k, v := range src {
+		srcMap, srcIsMap := v.(map[string]interface{})
+		dstMap, dstIsMap := dst[k].(map[string]interface{})
+		if srcIsMap && dstIsMap {
+			merge(dstMap, srcMap)
+			continue
+		}
+		dst[k] = v
+	}
+}
+
+func endpoint(port int) map[string]interface{} {
+	return map[string]interface{}{
+		"endpoint": fmt.Sprintf("0.0.0.0:%d", port),
+	}
+}
+
+func basicAuth(username, password string) map[string]interface{} {
+	return map[string]interface{}{
+		"basic": map[string]interface{}{
+			"username": username,
+			"password": password,
+		},
+	}
+}
+
+func splitList(val, fallback string) []interface{} {
+	if val == "" {
+		val = fallback
+	}
+	list := []interface{}{}
+	for _, item := range strings.Split(val, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+func parseBool(val string, fallback bool) bool {
+	b, err := strconv.ParseBool(val)
+	if err != nil {
+		return fallback
+	}
+	return b
+}
+
+func configMapName(jaeger *v1.Jaeger) string {
+	return fmt.Sprintf("%s-otel-collector-configuration", jaeger.Name)
+}
+
+func credentialsSecretName(jaeger *v1.Jaeger) string {
+	return util.DNSName(util.Truncate("%s-otel-collector-credentials", 63, jaeger.Name))
+}
+
+func configVolumeName(jaeger *v1.Jaeger) string {
+	return util.DNSName(util.Truncate("%s-otel-collector-configuration-volume", 63, jaeger.Name))
+}
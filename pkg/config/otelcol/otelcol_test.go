@@ -0,0 +1,312 @@
+package otelcol
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/yaml"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+)
+
+func renderMap(t *testing.T, jaeger *v1.Jaeger) map[string]interface{} {
+	cm, err := NewConfig(jaeger).Get()
+	require.NoError(t, err)
+
+	config := map[string]interface{}{}
+	require.NoError(t, yaml.Unmarshal([]byte(cm.Data[ConfigKey]), &config))
+	return config
+}
+
+func lookup(t *testing.T, config map[string]interface{}, path ...string) interface{} {
+	var current interface{} = config
+	for _, key := range path {
+		m, ok := current.(map[string]interface{})
+		require.True(t, ok, "expected a map at %q", key)
+		current = m[key]
+	}
+	return current
+}
+
+func TestDefaultConfig(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestDefaultConfig", Namespace: "observability"})
+
+	cm, err := NewConfig(jaeger).Get()
+	require.NoError(t, err)
+	assert.Equal(t, "TestDefaultConfig-otel-collector-configuration", cm.Name)
+	assert.Equal(t, "observability", cm.Namespace)
+	assert.Equal(t, "otel-collector-configuration", cm.Labels["app.kubernetes.io/component"])
+
+	config := renderMap(t, jaeger)
+	assert.Equal(t, float64(100000), lookup(t, config, "extensions", "jaeger_storage", "backends", "jaeger_storage", "memory", "max_traces"))
+	assert.Equal(t, "jaeger_storage", lookup(t, config, "extensions", "jaeger_query", "storage", "traces"))
+	assert.Equal(t, "jaeger_storage", lookup(t, config, "exporters", "jaeger_storage_exporter", "trace_storage"))
+	assert.Equal(t, "0.0.0.0:4317", lookup(t, config, "receivers", "otlp", "protocols", "grpc", "endpoint"))
+	assert.Equal(t, "0.0.0.0:14250", lookup(t, config, "receivers", "jaeger", "protocols", "grpc", "endpoint"))
+	assert.Equal(t, []interface{}{"otlp", "jaeger", "zipkin"}, lookup(t, config, "service", "pipelines", "traces", "receivers"))
+	assert.Nil(t, lookup(t, config, "extensions", "jaeger_query", "ui"))
+}
+
+func TestStorageBackends(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		storage  v1.JaegerStorageSpec
+		expected map[string]interface{}
+	}{
+		{
+			name: "badger",
+			storage: v1.JaegerStorageSpec{
+				Type: v1.JaegerBadgerStorage,
+				Options: v1.NewOptions(map[string]interface{}{
+					"badger.ephemeral":       "false",
+					"badger.directory-key":   "/badger/key",
+					"badger.directory-value": "/badger/data",
+				}),
+			},
+			expected: map[string]interface{}{
+				"badger": map[string]interface{}{
+					"ephemeral": false,
+					"directories": map[string]interface{}{
+						"keys":   "/badger/key",
+						"values": "/badger/data",
+					},
+				},
+			},
+		},
+		{
+			name: "elasticsearch",
+			storage: v1.JaegerStorageSpec{
+				Type: v1.JaegerESStorage,
+				Options: v1.NewOptions(map[string]interface{}{
+					"es.server-urls":  "http://es1:9200, http://es2:9200",
+					"es.index-prefix": "prod",
+					"es.use-aliases":  "true",
+				}),
+				SecretName: "es-secret",
+			},
+			expected: map[string]interface{}{
+				"elasticsearch": map[string]interface{}{
+					"server_urls": []interface{}{"http://es1:9200", "http://es2:9200"},
+					"indices": map[string]interface{}{
+						"index_prefix": "prod",
+					},
+					"use_aliases": true,
+					"auth": map[string]interface{}{
+						"basic": map[string]interface{}{
+							"username": "${env:ES_USERNAME}",
+							"password": "${env:ES_PASSWORD}",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "cassandra",
+			storage: v1.JaegerStorageSpec{
+				Type: v1.JaegerCassandraStorage,
+				Options: v1.NewOptions(map[string]interface{}{
+					"cassandra.servers":  "cassandra-0,cassandra-1",
+					"cassandra.port":     "9042",
+					"cassandra.keyspace": "jaeger_v1_dc1",
+				}),
+			},
+			expected: map[string]interface{}{
+				"cassandra": map[string]interface{}{
+					"connection": map[string]interface{}{
+						"servers": []interface{}{"cassandra-0", "cassandra-1"},
+						"port":    float64(9042),
+					},
+					"schema": map[string]interface{}{
+						"keyspace": "jaeger_v1_dc1",
+					},
+				},
+			},
+		},
+		{
+			name: "grpc-plugin",
+			storage: v1.JaegerStorageSpec{
+				Type: v1.JaegerGRPCPluginStorage,
+				Options: v1.NewOptions(map[string]interface{}{
+					"grpc-storage.server": "remote-storage:17271",
+				}),
+			},
+			expected: map[string]interface{}{
+				"grpc": map[string]interface{}{
+					"endpoint": "remote-storage:17271",
+					"tls": map[string]interface{}{
+						"insecure": true,
+					},
+				},
+			},
+		},
+		{
+			name: "memory",
+			storage: v1.JaegerStorageSpec{
+				Type: v1.JaegerMemoryStorage,
+				Options: v1.NewOptions(map[string]interface{}{
+					"memory.max-traces": "500",
+				}),
+			},
+			expected: map[string]interface{}{
+				"memory": map[string]interface{}{
+					"max_traces": float64(500),
+				},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestStorageBackends"})
+			jaeger.Spec.Storage = tt.storage
+
+			config := renderMap(t, jaeger)
+			assert.Equal(t, tt.expected, lookup(t, config, "extensions", "jaeger_storage", "backends", "jaeger_storage"))
+		})
+	}
+}
+
+func TestUserConfigIsMerged(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestUserConfigIsMerged"})
+	jaeger.Spec.Collector.Config = v1.NewFreeForm(map[string]interface{}{
+		"processors": map[string]interface{}{
+			"batch": map[string]interface{}{
+				"timeout": "5s",
+			},
+		},
+		"receivers": map[string]interface{}{
+			"otlp": map[string]interface{}{
+				"protocols": map[string]interface{}{
+					"grpc": map[string]interface{}{
+						"endpoint": "0.0.0.0:5317",
+					},
+				},
+			},
+		},
+		"service": map[string]interface{}{
+			"pipelines": map[string]interface{}{
+				"traces": map[string]interface{}{
+					"receivers": []interface{}{"otlp"},
+				},
+			},
+		},
+	})
+
+	config := renderMap(t, jaeger)
+
+	assert.Equal(t, "5s", lookup(t, config, "processors", "batch", "timeout"))
+	assert.Equal(t, "0.0.0.0:5317", lookup(t, config, "receivers", "otlp", "protocols", "grpc", "endpoint"))
+	// untouched parts of the defaults are kept
+	assert.Equal(t, "0.0.0.0:4318", lookup(t, config, "receivers", "otlp", "protocols", "http", "endpoint"))
+	assert.Equal(t, []interface{}{"otlp"}, lookup(t, config, "service", "pipelines", "traces", "receivers"))
+	assert.Equal(t, []interface{}{"jaeger_storage_exporter"}, lookup(t, config, "service", "pipelines", "traces", "exporters"))
+}
+
+func TestQueryOptions(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestQueryOptions"})
+	jaeger.Spec.Query.Options = v1.NewOptions(map[string]interface{}{
+		"query.base-path": "/jaeger",
+	})
+	jaeger.Spec.UI.Options = v1.NewFreeForm(map[string]interface{}{
+		"tracking": map[string]interface{}{
+			"gaID": "UA-000000-2",
+		},
+	})
+
+	config := renderMap(t, jaeger)
+
+	assert.Equal(t, "/jaeger", lookup(t, config, "extensions", "jaeger_query", "base_path"))
+	assert.Equal(t, "/etc/config/ui.json", lookup(t, config, "extensions", "jaeger_query", "ui", "config_file"))
+}
+
+func TestUpdate(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestUpdate"})
+
+	commonSpec := v1.JaegerCommonSpec{}
+	options := []string{}
+
+	Update(jaeger, &commonSpec, &options)
+	assert.Len(t, commonSpec.Volumes, 1)
+	assert.Equal(t, "testupdate-otel-collector-configuration-volume", commonSpec.Volumes[0].Name)
+	assert.Equal(t, "TestUpdate-otel-collector-configuration", commonSpec.Volumes[0].ConfigMap.Name)
+	assert.Len(t, commonSpec.VolumeMounts, 1)
+	assert.Equal(t, "/etc/jaeger", commonSpec.VolumeMounts[0].MountPath)
+	assert.Equal(t, []string{"--config=/etc/jaeger/config.yaml"}, options)
+}
+
+func TestInvalidUserConfig(t *testing.T) {
+	// prepare
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestInvalidUserConfig"})
+	require.NoError(t, jaeger.Spec.Collector.Config.UnmarshalJSON([]byte(`["not", "a", "map"]`)))
+
+	// test
+	cm, err := NewConfig(jaeger).Get()
+
+	// verify
+	assert.Error(t, err)
+	assert.Nil(t, cm)
+}
+
+func TestStoragePasswordFromSecret(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		storage v1.JaegerStorageSpec
+		auth    []string
+	}{
+		{
+			name: "elasticsearch",
+			storage: v1.JaegerStorageSpec{
+				Type: v1.JaegerESStorage,
+				Options: v1.NewOptions(map[string]interface{}{
+					"es.username": "elastic",
+					"es.password": "changeme",
+				}),
+			},
+			auth: []string{"extensions", "jaeger_storage", "backends", "jaeger_storage", "elasticsearch", "auth", "basic"},
+		},
+		{
+			name: "cassandra",
+			storage: v1.JaegerStorageSpec{
+				Type: v1.JaegerCassandraStorage,
+				Options: v1.NewOptions(map[string]interface{}{
+					"cassandra.username": "cassandra",
+					"cassandra.password": "changeme",
+				}),
+			},
+			auth: []string{"extensions", "jaeger_storage", "backends", "jaeger_storage", "cassandra", "connection", "auth", "basic"},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			// prepare
+			jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestStoragePasswordFromSecret", Namespace: "observability"})
+			jaeger.Spec.Storage = tt.storage
+
+			// test
+			config := renderMap(t, jaeger)
+			secret := CredentialsSecret(jaeger)
+			env := Env(jaeger)
+
+			// verify
+			auth := lookup(t, config, tt.auth...).(map[string]interface{})
+			assert.Equal(t, "${env:JAEGER_STORAGE_PASSWORD}", auth["password"])
+
+			require.NotNil(t, secret)
+			assert.Equal(t, "teststoragepasswordfromsecret-otel-collector-credentials", secret.Name)
+			assert.Equal(t, "observability", secret.Namespace)
+			assert.Equal(t, []byte("changeme"), secret.Data["password"])
+
+			require.Len(t, env, 1)
+			assert.Equal(t, PasswordEnvVar, env[0].Name)
+			assert.Equal(t, secret.Name, env[0].ValueFrom.SecretKeyRef.Name)
+			assert.Equal(t, "password", env[0].ValueFrom.SecretKeyRef.Key)
+		})
+	}
+}
+
+func TestNoCredentialsWithoutPassword(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestNoCredentialsWithoutPassword"})
+	jaeger.Spec.Storage.Type = v1.JaegerESStorage
+
+	assert.Nil(t, CredentialsSecret(jaeger))
+	assert.Empty(t, Env(jaeger))
+}
@@ -1,7 +1,10 @@
 package sampling
 
 import (
+	"encoding/json"
 	"fmt"
+	"sort"
+	"strconv"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -29,14 +32,17 @@ func (u *Config) Get() *corev1.ConfigMap {
 	var jsonObject []byte
 	var err error
 
-	if CheckForSamplingConfigFile(u.jaeger) {
+	if CheckForSamplingConfigFile(u.jaeger) || IsAdaptive(u.jaeger) {
 		return nil
 	}
 
-	// Check for empty map
-	if u.jaeger.Spec.Sampling.Options.IsEmpty() {
+	// the typed strategies take precedence, then the free-form options, for backwards compatibility
+	switch {
+	case u.jaeger.Spec.Sampling.Strategies != nil:
+		jsonObject, err = json.Marshal(u.jaeger.Spec.Sampling.Strategies)
+	case u.jaeger.Spec.Sampling.Options.IsEmpty():
 		jsonObject = []byte(defaultSamplingStrategy)
-	} else {
+	default:
 		jsonObject, err = u.jaeger.Spec.Sampling.Options.MarshalJSON()
 	}
 
@@ -94,9 +100,30 @@ func CheckForSamplingConfigFile(jaeger *v1.Jaeger) bool {
 	return false
 }
 
+// IsAdaptive returns whether the collector calculates the sampling strategies based on the observed traffic
+func IsAdaptive(jaeger *v1.Jaeger) bool {
+	return jaeger.Spec.Sampling.Type == v1.SamplingStrategiesTypeAdaptive
+}
+
+// EnvVars returns the environment variables selecting the sampling strategies type for the collector
+func EnvVars(jaeger *v1.Jaeger) []corev1.EnvVar {
+	if !IsAdaptive(jaeger) {
+		return nil
+	}
+	return []corev1.EnvVar{{
+		Name:  "SAMPLING_CONFIG_TYPE",
+		Value: string(v1.SamplingStrategiesTypeAdaptive),
+	}}
+}
+
 // Update will modify the supplied common spec and options to include
 // support for the Sampling configmap.
 func Update(jaeger *v1.Jaeger, commonSpec *v1.JaegerCommonSpec, options *[]string) {
+	if IsAdaptive(jaeger) {
+		*options = append(*options, adaptiveOptions(jaeger.Spec.Sampling.Adaptive, *options)...)
+		return
+	}
+
 	if CheckForSamplingConfigFile(jaeger) {
 		return
 	}
@@ -130,3 +157,51 @@ func Update(jaeger *v1.Jaeger, commonSpec *v1.JaegerCommonSpec, options *[]strin
 func samplingConfigVolumeName(jaeger *v1.Jaeger) string {
 	return util.DNSName(util.Truncate("%s-sampling-configuration-volume", 63, jaeger.Name))
 }
+
+// adaptiveOptions returns the collector flags for the adaptive sampling settings,
+// skipping the ones already provided by the user as options
+func adaptiveOptions(spec *v1.JaegerAdaptiveSamplingSpec, existing []string) []string {
+	if spec == nil {
+		return nil
+	}
+
+	flags := map[string]string{}
+	for flag, val := range map[string]*v1.Number{
+		"sampling.target-samples-per-second":    spec.TargetSamplesPerSecond,
+		"sampling.delta-tolerance":              spec.DeltaTolerance,
+		"sampling.initial-sampling-probability": spec.InitialSamplingProbability,
+		"sampling.min-sampling-probability":     spec.MinSamplingProbability,
+		"sampling.min-samples-per-second":       spec.MinSamplesPerSecond,
+	} {
+		if val != nil {
+			flags[flag] = string(*val)
+		}
+	}
+	for flag, val := range map[string]*int32{
+		"sampling.aggregation-buckets":     spec.AggregationBuckets,
+		"sampling.buckets-for-calculation": spec.BucketsForCalculation,
+	} {
+		if val != nil {
+			flags[flag] = strconv.Itoa(int(*val))
+		}
+	}
+	for flag, val := range map[string]*metav1.Duration{
+		"sampling.calculation-interval":            spec.CalculationInterval,
+		"sampling.leader-lease-refresh-interval":   spec.LeaderLeaseRefreshInterval,
+		"sampling.follower-lease-refresh-interval": spec.FollowerLeaseRefreshInterval,
+	} {
+		if val != nil {
+			flags[flag] = val.Duration.String()
+		}
+	}
+
+	options := []string{}
+	for flag, val := range flags {
+		if len(util.FindItem(fmt.Sprintf("--%s=", flag), existing)) > 0 {
+			continue
+		}
+		options = append(options, fmt.Sprintf("--%s=%s", flag, val))
+	}
+	sort.Strings(options)
+	return options
+}
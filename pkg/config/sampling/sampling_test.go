@@ -2,8 +2,11 @@ package sampling
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 
 	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
@@ -45,6 +48,31 @@ func TestWithSamplingConfig(t *testing.T) {
 	assert.Equal(t, json, cm.Data["sampling"])
 }
 
+func TestWithTypedSamplingStrategies(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestWithTypedSamplingStrategies"})
+	jaeger.Spec.Sampling.Strategies = &v1.SamplingStrategies{
+		DefaultStrategy: &v1.DefaultSamplingStrategy{
+			Type:  v1.SamplingStrategyTypeProbabilistic,
+			Param: "0.5",
+		},
+		ServiceStrategies: []v1.ServiceSamplingStrategy{{
+			Service: "foo",
+			Type:    v1.SamplingStrategyTypeRateLimiting,
+			Param:   "10",
+			OperationStrategies: []v1.OperationSamplingStrategy{{
+				Operation: "op",
+				Type:      v1.SamplingStrategyTypeProbabilistic,
+				Param:     "0.1",
+			}},
+		}},
+	}
+	json := `{"default_strategy":{"type":"probabilistic","param":0.5},"service_strategies":[{"service":"foo","type":"ratelimiting","param":10,"operation_strategies":[{"operation":"op","type":"probabilistic","param":0.1}]}]}`
+
+	config := NewConfig(jaeger)
+	cm := config.Get()
+	assert.Equal(t, json, cm.Data["sampling"])
+}
+
 func TestUpdateNoSamplingConfig(t *testing.T) {
 	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestUpdateNoSamplingConfig"})
 
@@ -106,3 +134,44 @@ func TestGetWithSamplingConfigFileOption(t *testing.T) {
 	cm := config.Get()
 	assert.Nil(t, cm)
 }
+
+func TestGetWithAdaptiveSampling(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestGetWithAdaptiveSampling"})
+	jaeger.Spec.Sampling.Type = v1.SamplingStrategiesTypeAdaptive
+
+	config := NewConfig(jaeger)
+	cm := config.Get()
+	assert.Nil(t, cm)
+}
+
+func TestUpdateWithAdaptiveSampling(t *testing.T) {
+	target := v1.Number("2.5")
+	buckets := int32(10)
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestUpdateWithAdaptiveSampling"})
+	jaeger.Spec.Sampling.Type = v1.SamplingStrategiesTypeAdaptive
+	jaeger.Spec.Sampling.Adaptive = &v1.JaegerAdaptiveSamplingSpec{
+		TargetSamplesPerSecond: &target,
+		AggregationBuckets:     &buckets,
+		CalculationInterval:    &metav1.Duration{Duration: 30 * time.Second},
+	}
+
+	commonSpec := v1.JaegerCommonSpec{}
+	options := []string{"--sampling.aggregation-buckets=5"}
+
+	Update(jaeger, &commonSpec, &options)
+	assert.Empty(t, commonSpec.Volumes)
+	assert.Empty(t, commonSpec.VolumeMounts)
+	assert.Equal(t, []string{
+		"--sampling.aggregation-buckets=5",
+		"--sampling.calculation-interval=30s",
+		"--sampling.target-samples-per-second=2.5",
+	}, options)
+}
+
+func TestEnvVars(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestEnvVars"})
+	assert.Empty(t, EnvVars(jaeger))
+
+	jaeger.Spec.Sampling.Type = v1.SamplingStrategiesTypeAdaptive
+	assert.Equal(t, []corev1.EnvVar{{Name: "SAMPLING_CONFIG_TYPE", Value: "adaptive"}}, EnvVars(jaeger))
+}
@@ -37,9 +37,10 @@ func (r *ReconcileJaeger) applyAccounts(ctx context.Context, jaeger v1.Jaeger, d
 			"account", d.Name,
 			"namespace", d.Namespace,
 		)
-		if err := r.client.Create(ctx, &d); err != nil {
+		if err := r.serverSideApply(ctx, &d); err != nil {
 			return tracing.HandleError(err, span)
 		}
+		r.recorder.Eventf(&jaeger, corev1.EventTypeNormal, EventReasonCreated, "Created service account %s", d.GetName())
 	}
 
 	for i := range inv.Update {
@@ -49,7 +50,7 @@ func (r *ReconcileJaeger) applyAccounts(ctx context.Context, jaeger v1.Jaeger, d
 			"account", d.Name,
 			"namespace", d.Namespace,
 		)
-		if err := r.client.Update(ctx, &d); err != nil {
+		if err := r.serverSideApply(ctx, &d); err != nil {
 			return tracing.HandleError(err, span)
 		}
 	}
@@ -64,6 +65,7 @@ func (r *ReconcileJaeger) applyAccounts(ctx context.Context, jaeger v1.Jaeger, d
 		if err := r.client.Delete(ctx, &d); err != nil {
 			return tracing.HandleError(err, span)
 		}
+		r.recorder.Eventf(&jaeger, corev1.EventTypeNormal, EventReasonDeleted, "Deleted service account %s", d.GetName())
 	}
 
 	return nil
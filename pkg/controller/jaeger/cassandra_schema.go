@@ -0,0 +1,70 @@
+package jaeger
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	otelattribute "go.opentelemetry.io/otel/attribute"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/storage"
+	"github.com/jaegertracing/jaeger-operator/pkg/strategy"
+	"github.com/jaegertracing/jaeger-operator/pkg/tracing"
+)
+
+// handleCassandraSchema runs the create-schema job when the keyspace doesn't match the revision of the job yet,
+// recording the progress of the migration in the status of the instance. As the dependencies complete before the
+// deployments are applied, the collectors are only rolled out once the keyspace has been migrated.
+func (r *ReconcileJaeger) handleCassandraSchema(ctx context.Context, jaeger *v1.Jaeger, str strategy.S, dep batchv1.Job, schema v1.JaegerCassandraSchemaStatus) error {
+	tracer := otel.GetTracerProvider().Tracer(v1.ReconciliationTracer)
+	ctx, span := tracer.Start(ctx, "handleCassandraSchema")
+	defer span.End()
+
+	span.SetAttributes(otelattribute.String("cassandra-schema.revision", schema.Revision))
+
+	previous := jaeger.Status.CassandraSchema
+	if previous != nil && previous.Revision == schema.Revision && previous.Phase == v1.CassandraSchemaPhaseReady {
+		// the keyspace already matches the instance
+		return nil
+	}
+
+	migrating := schema
+	migrating.Phase = v1.CassandraSchemaPhaseMigrating
+	jaeger.Status.CassandraSchema = &migrating
+	if err := r.client.Status().Update(ctx, jaeger); err != nil {
+		// the progress is stored along with the rest of the status once the reconciliation completes
+		jaeger.Logger().V(1).Info("failed to store the progress of the cassandra schema migration", "error", err.Error())
+	}
+
+	if err := r.handleDependency(ctx, str, dep); err != nil {
+		failed := schema
+		failed.Phase = v1.CassandraSchemaPhaseFailed
+		failed.Message = err.Error()
+		jaeger.Status.CassandraSchema = &failed
+		return tracing.HandleError(err, span)
+	}
+
+	jaeger.Status.CassandraSchema = &schema
+	r.recorder.Eventf(jaeger, corev1.EventTypeNormal, EventReasonCassandraSchemaMigrated, "Migrated the keyspace %s to the schema revision %s", schema.Keyspace, schema.Revision)
+
+	// the job of the previous revision isn't needed anymore. On the first migration, the previous job is the one
+	// created before the revisions were recorded in the status.
+	previousJob := storage.LegacyCassandraSchemaJobName(jaeger)
+	if previous != nil {
+		previousJob = previous.Job
+	}
+	if previousJob != "" && previousJob != dep.Name {
+		old := &batchv1.Job{}
+		old.Name = previousJob
+		old.Namespace = jaeger.Namespace
+		if err := r.client.Delete(ctx, old, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil && !k8serrors.IsNotFound(err) {
+			jaeger.Logger().V(1).Info("failed to remove the job of the previous cassandra schema revision", "job", previousJob, "error", err.Error())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+package jaeger
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/storage"
+	"github.com/jaegertracing/jaeger-operator/pkg/strategy"
+)
+
+func TestHandleCassandraSchemaMigration(t *testing.T) {
+	// prepare
+	nsn := types.NamespacedName{
+		Name: "TestHandleCassandraSchemaMigration",
+	}
+
+	jaeger := v1.NewJaeger(nsn)
+	jaeger.Spec.Storage.Type = v1.JaegerCassandraStorage
+	jaeger.Status.CassandraSchema = &v1.JaegerCassandraSchemaStatus{
+		Phase:    v1.CassandraSchemaPhaseReady,
+		Revision: "0123456789",
+		Job:      "TestHandleCassandraSchemaMigration-cassandra-schema-0123456789",
+	}
+	old := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: jaeger.Status.CassandraSchema.Job}}
+	objs := []client.Object{jaeger, old}
+
+	deps := storage.Dependencies(jaeger.DeepCopy())
+	require.Len(t, deps, 1)
+	dep := deps[0]
+
+	r, cl := getReconciler(objs)
+	r.strategyChooser = func(ctx context.Context, jaeger *v1.Jaeger) strategy.S {
+		return strategy.New().WithDependencies([]batchv1.Job{dep})
+	}
+
+	// test
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		_, err := r.Reconcile(reconcile.Request{NamespacedName: nsn})
+		require.NoError(t, err)
+		wg.Done()
+	}()
+
+	// we assume that this sleep time is enough for the reconcile to reach the "wait" logic
+	time.Sleep(100 * time.Millisecond)
+
+	migrating := &v1.Jaeger{}
+	require.NoError(t, cl.Get(context.Background(), nsn, migrating))
+	require.NotNil(t, migrating.Status.CassandraSchema)
+	assert.Equal(t, v1.CassandraSchemaPhaseMigrating, migrating.Status.CassandraSchema.Phase)
+	assert.Equal(t, dep.Name, migrating.Status.CassandraSchema.Job)
+
+	persisted := &batchv1.Job{}
+	require.NoError(t, cl.Get(context.Background(), types.NamespacedName{Name: dep.Name}, persisted))
+	persisted.Status.Succeeded = 1
+	require.NoError(t, cl.Status().Update(context.Background(), persisted))
+
+	wg.Wait()
+
+	// verify
+	migrated := &v1.Jaeger{}
+	require.NoError(t, cl.Get(context.Background(), nsn, migrated))
+	require.NotNil(t, migrated.Status.CassandraSchema)
+	assert.Equal(t, v1.CassandraSchemaPhaseReady, migrated.Status.CassandraSchema.Phase)
+	assert.Equal(t, dep.Annotations[v1.AnnotationCassandraSchemaRevision], migrated.Status.CassandraSchema.Revision)
+	assert.Equal(t, "jaeger_v1_test", migrated.Status.CassandraSchema.Keyspace)
+	assert.EqualValues(t, 172800, migrated.Status.CassandraSchema.TraceTTLSeconds)
+
+	// the job of the previous revision has been removed
+	err := cl.Get(context.Background(), types.NamespacedName{Name: old.Name}, &batchv1.Job{})
+	assert.True(t, k8serrors.IsNotFound(err))
+}
+
+func TestHandleCassandraSchemaFirstMigrationRemovesLegacyJob(t *testing.T) {
+	// prepare
+	nsn := types.NamespacedName{
+		Name: "TestLegacyCassandraSchemaJob",
+	}
+
+	// the instance predates the revisions recorded in the status
+	jaeger := v1.NewJaeger(nsn)
+	jaeger.Spec.Storage.Type = v1.JaegerCassandraStorage
+	legacy := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: storage.LegacyCassandraSchemaJobName(jaeger)}}
+	objs := []client.Object{jaeger, legacy}
+
+	deps := storage.Dependencies(jaeger.DeepCopy())
+	require.Len(t, deps, 1)
+	dep := deps[0]
+
+	r, cl := getReconciler(objs)
+	r.strategyChooser = func(ctx context.Context, jaeger *v1.Jaeger) strategy.S {
+		return strategy.New().WithDependencies([]batchv1.Job{dep})
+	}
+
+	// test
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		_, err := r.Reconcile(reconcile.Request{NamespacedName: nsn})
+		require.NoError(t, err)
+		wg.Done()
+	}()
+
+	// we assume that this sleep time is enough for the reconcile to reach the "wait" logic
+	time.Sleep(100 * time.Millisecond)
+
+	persisted := &batchv1.Job{}
+	require.NoError(t, cl.Get(context.Background(), types.NamespacedName{Name: dep.Name}, persisted))
+	persisted.Status.Succeeded = 1
+	require.NoError(t, cl.Status().Update(context.Background(), persisted))
+
+	wg.Wait()
+
+	// verify
+	assert.Equal(t, "TestLegacyCassandraSchemaJob-cassandra-schema-job", legacy.Name)
+	err := cl.Get(context.Background(), types.NamespacedName{Name: legacy.Name}, &batchv1.Job{})
+	assert.True(t, k8serrors.IsNotFound(err))
+}
+
+func TestHandleCassandraSchemaUpToDate(t *testing.T) {
+	// prepare
+	nsn := types.NamespacedName{
+		Name: "TestHandleCassandraSchemaUpToDate",
+	}
+
+	jaeger := v1.NewJaeger(nsn)
+	jaeger.Spec.Storage.Type = v1.JaegerCassandraStorage
+	deps := storage.Dependencies(jaeger.DeepCopy())
+	require.Len(t, deps, 1)
+	dep := deps[0]
+
+	jaeger.Status.CassandraSchema = storage.CassandraSchemaStatus(dep)
+	objs := []client.Object{jaeger}
+
+	r, cl := getReconciler(objs)
+	r.strategyChooser = func(ctx context.Context, jaeger *v1.Jaeger) strategy.S {
+		return strategy.New().WithDependencies([]batchv1.Job{dep})
+	}
+
+	// test
+	_, err := r.Reconcile(reconcile.Request{NamespacedName: nsn})
+
+	// verify
+	require.NoError(t, err)
+
+	// the keyspace already matches the instance, so the job doesn't run again
+	err = cl.Get(context.Background(), types.NamespacedName{Name: dep.Name}, &batchv1.Job{})
+	assert.True(t, k8serrors.IsNotFound(err))
+}
@@ -0,0 +1,76 @@
+package jaeger
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+	cassandrav1beta1 "github.com/jaegertracing/jaeger-operator/pkg/cassandra/v1beta1"
+	"github.com/jaegertracing/jaeger-operator/pkg/inventory"
+	"github.com/jaegertracing/jaeger-operator/pkg/tracing"
+)
+
+// applyCassandraDatacenters applies the datacenters of the Cassandra clusters provisioned via cass-operator. Their
+// readiness isn't awaited: the create-schema job retries until the nodes accept connections, and the collectors are
+// only rolled out once it completes.
+func (r *ReconcileJaeger) applyCassandraDatacenters(ctx context.Context, jaeger v1.Jaeger, desired []cassandrav1beta1.CassandraDatacenter) error {
+	tracer := otel.GetTracerProvider().Tracer(v1.ReconciliationTracer)
+	ctx, span := tracer.Start(ctx, "applyCassandraDatacenters")
+	defer span.End()
+
+	opts := []client.ListOption{
+		client.InNamespace(jaeger.Namespace),
+		client.MatchingLabels(map[string]string{
+			"app.kubernetes.io/instance":   jaeger.Name,
+			"app.kubernetes.io/managed-by": "jaeger-operator",
+		}),
+	}
+	list := &cassandrav1beta1.CassandraDatacenterList{}
+	if err := r.rClient.List(ctx, list, opts...); err != nil {
+		return tracing.HandleError(err, span)
+	}
+
+	inv := inventory.ForCassandraDatacenters(list.Items, desired)
+	for i := range inv.Create {
+		d := inv.Create[i]
+		jaeger.Logger().V(-1).Info(
+			"creating cassandra datacenter",
+			"cassandradatacenter", d.GetName(),
+			"namespace", d.GetNamespace(),
+		)
+		if err := r.serverSideApply(ctx, &d); err != nil {
+			return tracing.HandleError(err, span)
+		}
+		r.recorder.Eventf(&jaeger, corev1.EventTypeNormal, EventReasonCreated, "Created cassandra datacenter %s", d.GetName())
+	}
+
+	for i := range inv.Update {
+		d := inv.Update[i]
+		jaeger.Logger().V(-1).Info(
+			"updating cassandra datacenter",
+			"cassandradatacenter", d.GetName(),
+			"namespace", d.GetNamespace(),
+		)
+		if err := r.serverSideApply(ctx, &d); err != nil {
+			return tracing.HandleError(err, span)
+		}
+	}
+
+	for i := range inv.Delete {
+		d := inv.Delete[i]
+		jaeger.Logger().V(-1).Info(
+			"deleting cassandra datacenter",
+			"cassandradatacenter", d.GetName(),
+			"namespace", d.GetNamespace(),
+		)
+		if err := r.client.Delete(ctx, &d); err != nil {
+			return tracing.HandleError(err, span)
+		}
+		r.recorder.Eventf(&jaeger, corev1.EventTypeNormal, EventReasonDeleted, "Deleted cassandra datacenter %s", d.GetName())
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/spf13/viper"
 	"go.opentelemetry.io/otel"
+	corev1 "k8s.io/api/core/v1"
 	rbac "k8s.io/api/rbac/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -40,9 +41,10 @@ func (r *ReconcileJaeger) applyClusterRoleBindingBindings(ctx context.Context, j
 			"clusteRoleBinding", d.Name,
 			"namespace", d.Namespace,
 		)
-		if err := r.client.Create(ctx, &d); err != nil {
+		if err := r.serverSideApply(ctx, &d); err != nil {
 			return tracing.HandleError(err, span)
 		}
+		r.recorder.Eventf(&jaeger, corev1.EventTypeNormal, EventReasonCreated, "Created cluster role binding %s", d.GetName())
 	}
 
 	for i := range inv.Update {
@@ -52,7 +54,7 @@ func (r *ReconcileJaeger) applyClusterRoleBindingBindings(ctx context.Context, j
 			"clusteRoleBinding", d.Name,
 			"namespace", d.Namespace,
 		)
-		if err := r.client.Update(ctx, &d); err != nil {
+		if err := r.serverSideApply(ctx, &d); err != nil {
 			return tracing.HandleError(err, span)
 		}
 	}
@@ -67,6 +69,7 @@ func (r *ReconcileJaeger) applyClusterRoleBindingBindings(ctx context.Context, j
 		if err := r.client.Delete(ctx, &d); err != nil {
 			return tracing.HandleError(err, span)
 		}
+		r.recorder.Eventf(&jaeger, corev1.EventTypeNormal, EventReasonDeleted, "Deleted cluster role binding %s", d.GetName())
 	}
 
 	return nil
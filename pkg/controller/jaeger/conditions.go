@@ -0,0 +1,47 @@
+package jaeger
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+)
+
+// setCondition records the condition into the instance's status, using the instance's generation as the observed one
+func setCondition(jaeger *v1.Jaeger, conditionType string, status metav1.ConditionStatus, reason, message string) {
+	meta.SetStatusCondition(&jaeger.Status.Conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: jaeger.Generation,
+	})
+}
+
+// stepFailed marks the given conditions as false, stating which reconciliation step failed and why
+func stepFailed(jaeger *v1.Jaeger, step string, err error, conditionTypes ...string) {
+	for _, conditionType := range conditionTypes {
+		setCondition(jaeger, conditionType, metav1.ConditionFalse, v1.JaegerReasonReconcileFailed, fmt.Sprintf("failed to apply %s: %v", step, err))
+	}
+}
+
+// deploymentAvailable determines whether the deployment is available, returning a human-readable explanation
+func deploymentAvailable(dep appsv1.Deployment) (bool, string) {
+	for _, c := range dep.Status.Conditions {
+		if c.Type == appsv1.DeploymentAvailable {
+			return c.Status == corev1.ConditionTrue, fmt.Sprintf("deployment %s: %s", dep.Name, c.Message)
+		}
+	}
+
+	// the deployment controller hasn't reported its conditions yet, we fall back to the replica count
+	desired := int32(1)
+	if dep.Spec.Replicas != nil {
+		desired = *dep.Spec.Replicas
+	}
+	message := fmt.Sprintf("deployment %s has %d/%d ready replicas", dep.Name, dep.Status.ReadyReplicas, desired)
+	return dep.Status.ReadyReplicas >= desired, message
+}
@@ -0,0 +1,191 @@
+package jaeger
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/strategy"
+)
+
+func TestStepFailed(t *testing.T) {
+	// prepare
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestStepFailed"})
+	jaeger.Generation = 3
+
+	// test
+	stepFailed(jaeger, "services", errors.New("boom"), v1.JaegerConditionCollectorAvailable, v1.JaegerConditionQueryAvailable)
+
+	// verify
+	assert.Len(t, jaeger.Status.Conditions, 2)
+	for _, conditionType := range []string{v1.JaegerConditionCollectorAvailable, v1.JaegerConditionQueryAvailable} {
+		c := meta.FindStatusCondition(jaeger.Status.Conditions, conditionType)
+		require.NotNil(t, c)
+		assert.Equal(t, metav1.ConditionFalse, c.Status)
+		assert.Equal(t, v1.JaegerReasonReconcileFailed, c.Reason)
+		assert.Equal(t, "failed to apply services: boom", c.Message)
+		assert.Equal(t, int64(3), c.ObservedGeneration)
+	}
+}
+
+func TestDeploymentAvailable(t *testing.T) {
+	replicas := int32(2)
+	for _, tt := range []struct {
+		name      string
+		dep       appsv1.Deployment
+		available bool
+	}{
+		{
+			name: "available condition",
+			dep: appsv1.Deployment{Status: appsv1.DeploymentStatus{Conditions: []appsv1.DeploymentCondition{{
+				Type:   appsv1.DeploymentAvailable,
+				Status: corev1.ConditionTrue,
+			}}}},
+			available: true,
+		},
+		{
+			name: "unavailable condition",
+			dep: appsv1.Deployment{Status: appsv1.DeploymentStatus{ReadyReplicas: 1, Conditions: []appsv1.DeploymentCondition{{
+				Type:   appsv1.DeploymentAvailable,
+				Status: corev1.ConditionFalse,
+			}}}},
+			available: false,
+		},
+		{
+			name:      "no conditions, enough replicas",
+			dep:       appsv1.Deployment{Spec: appsv1.DeploymentSpec{Replicas: &replicas}, Status: appsv1.DeploymentStatus{ReadyReplicas: 2}},
+			available: true,
+		},
+		{
+			name:      "no conditions, not enough replicas",
+			dep:       appsv1.Deployment{Spec: appsv1.DeploymentSpec{Replicas: &replicas}, Status: appsv1.DeploymentStatus{ReadyReplicas: 1}},
+			available: false,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			available, message := deploymentAvailable(tt.dep)
+			assert.Equal(t, tt.available, available)
+			assert.NotEmpty(t, message)
+		})
+	}
+}
+
+func TestConditionsOnSuccessfulReconciliation(t *testing.T) {
+	// prepare
+	nsn := types.NamespacedName{Name: "TestConditionsOnSuccessfulReconciliation"}
+	jaeger := v1.NewJaeger(nsn)
+	jaeger.Generation = 2
+
+	r, cl := getReconciler([]client.Object{jaeger})
+	r.strategyChooser = func(ctx context.Context, jaeger *v1.Jaeger) strategy.S {
+		return strategy.S{}
+	}
+
+	// test
+	_, err := r.Reconcile(reconcile.Request{NamespacedName: nsn})
+
+	// verify
+	require.NoError(t, err)
+	persisted := &v1.Jaeger{}
+	require.NoError(t, cl.Get(context.Background(), nsn, persisted))
+	assert.Equal(t, int64(2), persisted.Status.ObservedGeneration)
+	assert.True(t, meta.IsStatusConditionTrue(persisted.Status.Conditions, v1.JaegerConditionUpgradeApplied))
+	assert.True(t, meta.IsStatusConditionTrue(persisted.Status.Conditions, v1.JaegerConditionStorageReady))
+	assert.Nil(t, meta.FindStatusCondition(persisted.Status.Conditions, v1.JaegerConditionDependenciesComplete))
+	assert.Nil(t, meta.FindStatusCondition(persisted.Status.Conditions, v1.JaegerConditionIngressReady))
+}
+
+func TestConditionsWithUnresolvedImages(t *testing.T) {
+	// prepare
+	viper.Set("jaeger-all-in-one-image", "org/all-in-one@sha256:0123")
+	defer viper.Reset()
+
+	nsn := types.NamespacedName{Name: "TestConditionsWithUnresolvedImages"}
+	jaeger := v1.NewJaeger(nsn)
+	jaeger.Spec.Version = "0.0.0-old"
+	jaeger.Status.Version = "0.0.0-old"
+
+	r, cl := getReconciler([]client.Object{jaeger})
+	r.strategyChooser = func(ctx context.Context, jaeger *v1.Jaeger) strategy.S {
+		return strategy.S{}
+	}
+
+	// test
+	_, err := r.Reconcile(reconcile.Request{NamespacedName: nsn})
+
+	// verify
+	require.NoError(t, err)
+	persisted := &v1.Jaeger{}
+	require.NoError(t, cl.Get(context.Background(), nsn, persisted))
+	condition := meta.FindStatusCondition(persisted.Status.Conditions, v1.JaegerConditionUpgradeApplied)
+	require.NotNil(t, condition)
+	assert.Equal(t, metav1.ConditionFalse, condition.Status)
+	assert.Equal(t, v1.JaegerReasonImagesUnresolved, condition.Reason)
+	assert.Contains(t, condition.Message, "jaeger-all-in-one-image")
+}
+
+func TestConditionsOnFailedReconciliation(t *testing.T) {
+	// prepare
+	nsn := types.NamespacedName{Name: "TestConditionsOnFailedReconciliation"}
+	r, cl := getReconciler([]client.Object{v1.NewJaeger(nsn)})
+	r.strategyChooser = func(ctx context.Context, jaeger *v1.Jaeger) strategy.S {
+		// services without a name can't be created
+		return strategy.New().WithServices([]corev1.Service{{}})
+	}
+
+	// test
+	_, err := r.Reconcile(reconcile.Request{NamespacedName: nsn})
+
+	// verify
+	require.Error(t, err)
+	persisted := &v1.Jaeger{}
+	require.NoError(t, cl.Get(context.Background(), nsn, persisted))
+	assert.Equal(t, v1.JaegerPhaseFailed, persisted.Status.Phase)
+
+	c := meta.FindStatusCondition(persisted.Status.Conditions, v1.JaegerConditionCollectorAvailable)
+	require.NotNil(t, c)
+	assert.Equal(t, metav1.ConditionFalse, c.Status)
+	assert.Equal(t, v1.JaegerReasonReconcileFailed, c.Reason)
+	assert.Contains(t, c.Message, "failed to apply services")
+	assert.True(t, meta.IsStatusConditionTrue(persisted.Status.Conditions, v1.JaegerConditionStorageReady))
+}
+
+func TestConditionsWithInvalidCollectorConfig(t *testing.T) {
+	// prepare
+	nsn := types.NamespacedName{Name: "TestConditionsWithInvalidCollectorConfig"}
+	jaeger := v1.NewJaeger(nsn)
+	jaeger.Spec.Strategy = v1.DeploymentStrategyOTelCollector
+	require.NoError(t, jaeger.Spec.Collector.Config.UnmarshalJSON([]byte(`["not", "a", "map"]`)))
+	r, cl := getReconciler([]client.Object{jaeger})
+
+	// test
+	_, err := r.Reconcile(reconcile.Request{NamespacedName: nsn})
+
+	// verify
+	require.Error(t, err)
+	persisted := &v1.Jaeger{}
+	require.NoError(t, cl.Get(context.Background(), nsn, persisted))
+	assert.Equal(t, v1.JaegerPhaseFailed, persisted.Status.Phase)
+
+	c := meta.FindStatusCondition(persisted.Status.Conditions, v1.JaegerConditionCollectorAvailable)
+	require.NotNil(t, c)
+	assert.Equal(t, metav1.ConditionFalse, c.Status)
+	assert.Contains(t, c.Message, "failed to render the OpenTelemetry Collector configuration")
+
+	// the deployment mounting the config map isn't created without it
+	deployments := &appsv1.DeploymentList{}
+	require.NoError(t, cl.List(context.Background(), deployments))
+	assert.Empty(t, deployments.Items)
+}
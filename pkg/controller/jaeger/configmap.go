@@ -5,6 +5,7 @@ import (
 
 	"go.opentelemetry.io/otel"
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -41,9 +42,10 @@ func (r *ReconcileJaeger) applyConfigMaps(ctx context.Context, jaeger v1.Jaeger,
 			"configMap", d.Name,
 			"namespace", d.Namespace,
 		)
-		if err := r.client.Create(ctx, &d); err != nil {
+		if err := r.serverSideApply(ctx, &d); err != nil {
 			return tracing.HandleError(err, span)
 		}
+		r.recorder.Eventf(&jaeger, corev1.EventTypeNormal, EventReasonCreated, "Created config map %s", d.GetName())
 	}
 
 	for i := range inv.Update {
@@ -53,7 +55,7 @@ func (r *ReconcileJaeger) applyConfigMaps(ctx context.Context, jaeger v1.Jaeger,
 			"configMap", d.Name,
 			"namespace", d.Namespace,
 		)
-		if err := r.client.Update(ctx, &d); err != nil {
+		if err := r.serverSideApply(ctx, &d); err != nil {
 			return tracing.HandleError(err, span)
 		}
 	}
@@ -68,6 +70,7 @@ func (r *ReconcileJaeger) applyConfigMaps(ctx context.Context, jaeger v1.Jaeger,
 		if err := r.client.Delete(ctx, &d); err != nil {
 			return tracing.HandleError(err, span)
 		}
+		r.recorder.Eventf(&jaeger, corev1.EventTypeNormal, EventReasonDeleted, "Deleted config map %s", d.GetName())
 	}
 
 	return nil
@@ -76,31 +79,36 @@ func (r *ReconcileJaeger) applyConfigMaps(ctx context.Context, jaeger v1.Jaeger,
 func (r *ReconcileJaeger) getSelectorsForConfigMaps(instanceName string, components []string) labels.Selector {
 	// We are swallowing the errors here because we are sure that the label requirements are valid
 	// e.g. "DoubleEqual" has one single value, "In" has at least 1 value, etc... so don't require to check for errors
-	nameReq, _ := labels.NewRequirement("app.kubernetes.io/name", selection.DoubleEquals, []string{util.Truncate(instanceName, 63)})
+	instanceReq, _ := labels.NewRequirement("app.kubernetes.io/instance", selection.DoubleEquals, []string{util.Truncate(instanceName, 63)})
 	componentReq, _ := labels.NewRequirement("app.kubernetes.io/component", selection.In, components)
 	managerReq, _ := labels.NewRequirement("app.kubernetes.io/managed-by", selection.Equals, []string{"jaeger-operator"})
 	selector := labels.Everything()
-	return selector.Add(*nameReq, *componentReq, *managerReq)
+	return selector.Add(*instanceReq, *componentReq, *managerReq)
 }
 
+// cleanConfigMaps removes the CA config maps of the given instance, including the ones copied into the namespaces
+// of the workloads with a sidecar
 func (r *ReconcileJaeger) cleanConfigMaps(ctx context.Context, instanceName string) error {
-	configmaps := corev1.ConfigMapList{}
-	if err := r.rClient.List(ctx, &configmaps, &client.ListOptions{
-		LabelSelector: r.getSelectorsForConfigMaps(instanceName, []string{"ca-configmap", "service-ca-configmap"}),
-	}); err != nil {
-		return err
-	}
+	return forEachWatchedNamespace(func(opts ...client.ListOption) error {
+		configmaps := corev1.ConfigMapList{}
+		if err := r.rClient.List(ctx, &configmaps, append(opts, &client.ListOptions{
+			LabelSelector: r.getSelectorsForConfigMaps(instanceName, []string{"ca-configmap", "service-ca-configmap"}),
+		})...); err != nil {
+			return err
+		}
 
-	for i := range configmaps.Items {
-		cfgMap := configmaps.Items[i]
-		if err := r.client.Delete(ctx, &cfgMap); err != nil {
-			log.Log.Error(
-				err,
-				"error cleaning configmap deployment",
-				"configMapName", cfgMap.Name,
-				"configMapNamespace", cfgMap.Namespace,
-			)
+		for i := range configmaps.Items {
+			cfgMap := configmaps.Items[i]
+			if err := r.client.Delete(ctx, &cfgMap); err != nil && !k8serrors.IsNotFound(err) {
+				log.Log.Error(
+					err,
+					"error cleaning configmap deployment",
+					"configMapName", cfgMap.Name,
+					"configMapNamespace", cfgMap.Namespace,
+				)
+				return err
+			}
 		}
-	}
-	return nil
+		return nil
+	})
 }
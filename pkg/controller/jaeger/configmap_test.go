@@ -211,7 +211,7 @@ func TestConfigMapsClean(t *testing.T) {
 	trustedCAConfig := &corev1.ConfigMap{}
 	trustedCAConfig.Name = fmt.Sprintf("%s-trusted-ca", nsnNonExist.Name)
 	trustedCAConfig.Labels = map[string]string{
-		"app.kubernetes.io/name":       nsnNonExist.Name,
+		"app.kubernetes.io/instance":   nsnNonExist.Name,
 		"app.kubernetes.io/component":  "ca-configmap",
 		"app.kubernetes.io/managed-by": "jaeger-operator",
 	}
@@ -219,7 +219,7 @@ func TestConfigMapsClean(t *testing.T) {
 	serviceCAConfig := &corev1.ConfigMap{}
 	serviceCAConfig.Name = fmt.Sprintf("%s-service-ca", nsnNonExist.Name)
 	serviceCAConfig.Labels = map[string]string{
-		"app.kubernetes.io/name":       nsnNonExist.Name,
+		"app.kubernetes.io/instance":   nsnNonExist.Name,
 		"app.kubernetes.io/component":  "service-ca-configmap",
 		"app.kubernetes.io/managed-by": "jaeger-operator",
 	}
@@ -228,7 +228,7 @@ func TestConfigMapsClean(t *testing.T) {
 	serviceCAConfigExist := &corev1.ConfigMap{}
 	serviceCAConfigExist.Name = fmt.Sprintf("%s-service-ca", nsnExisting.Name)
 	serviceCAConfigExist.Labels = map[string]string{
-		"app.kubernetes.io/name":       nsnExisting.Name,
+		"app.kubernetes.io/instance":   nsnExisting.Name,
 		"app.kubernetes.io/component":  "service-ca-configmap",
 		"app.kubernetes.io/managed-by": "jaeger-operator",
 	}
@@ -6,6 +6,7 @@ import (
 	osconsolev1 "github.com/openshift/api/console/v1"
 	"github.com/spf13/viper"
 	"go.opentelemetry.io/otel"
+	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
@@ -43,9 +44,10 @@ func (r *ReconcileJaeger) applyConsoleLinks(ctx context.Context, jaeger v1.Jaege
 			"consoleLink", d.Name,
 			"namespace", d.Namespace,
 		)
-		if err := r.client.Create(ctx, &d); err != nil {
+		if err := r.serverSideApply(ctx, &d); err != nil {
 			return tracing.HandleError(err, span)
 		}
+		r.recorder.Eventf(&jaeger, corev1.EventTypeNormal, EventReasonCreated, "Created console link %s", d.GetName())
 	}
 
 	for i := range inv.Update {
@@ -55,7 +57,7 @@ func (r *ReconcileJaeger) applyConsoleLinks(ctx context.Context, jaeger v1.Jaege
 			"consoleLink", d.Name,
 			"namespace", d.Namespace,
 		)
-		if err := r.client.Update(ctx, &d); err != nil {
+		if err := r.serverSideApply(ctx, &d); err != nil {
 			return tracing.HandleError(err, span)
 		}
 	}
@@ -70,6 +72,7 @@ func (r *ReconcileJaeger) applyConsoleLinks(ctx context.Context, jaeger v1.Jaege
 		if err := r.client.Delete(ctx, &d); err != nil {
 			return tracing.HandleError(err, span)
 		}
+		r.recorder.Eventf(&jaeger, corev1.EventTypeNormal, EventReasonDeleted, "Deleted console link %s", d.GetName())
 	}
 
 	return nil
@@ -9,6 +9,7 @@ import (
 
 	"go.opentelemetry.io/otel"
 	batchv1beta1 "k8s.io/api/batch/v1beta1"
+	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
@@ -49,9 +50,10 @@ func (r *ReconcileJaeger) applyCronJobs(ctx context.Context, jaeger v1.Jaeger, d
 				"cronjob", d.Name,
 				"namespace", d.Namespace,
 			)
-			if err := r.client.Create(ctx, d); err != nil {
+			if err := r.serverSideApply(ctx, d); err != nil {
 				return tracing.HandleError(err, span)
 			}
+			r.recorder.Eventf(&jaeger, corev1.EventTypeNormal, EventReasonCreated, "Created cron job %s", d.GetName())
 		}
 
 		for _, d1 := range inv.Update {
@@ -61,7 +63,7 @@ func (r *ReconcileJaeger) applyCronJobs(ctx context.Context, jaeger v1.Jaeger, d
 				"cronjob", d.Name,
 				"namespace", d.Namespace,
 			)
-			if err := r.client.Update(ctx, d); err != nil {
+			if err := r.serverSideApply(ctx, d); err != nil {
 				return tracing.HandleError(err, span)
 			}
 		}
@@ -76,6 +78,7 @@ func (r *ReconcileJaeger) applyCronJobs(ctx context.Context, jaeger v1.Jaeger, d
 			if err := r.client.Delete(ctx, d); err != nil {
 				return tracing.HandleError(err, span)
 			}
+			r.recorder.Eventf(&jaeger, corev1.EventTypeNormal, EventReasonDeleted, "Deleted cron job %s", d.GetName())
 		}
 	} else {
 		list := &batchv1.CronJobList{}
@@ -96,9 +99,10 @@ func (r *ReconcileJaeger) applyCronJobs(ctx context.Context, jaeger v1.Jaeger, d
 				"cronjob", d.Name,
 				"namespace", d.Namespace,
 			)
-			if err := r.client.Create(ctx, d); err != nil {
+			if err := r.serverSideApply(ctx, d); err != nil {
 				return tracing.HandleError(err, span)
 			}
+			r.recorder.Eventf(&jaeger, corev1.EventTypeNormal, EventReasonCreated, "Created cron job %s", d.GetName())
 		}
 
 		for _, d1 := range inv.Update {
@@ -108,7 +112,7 @@ func (r *ReconcileJaeger) applyCronJobs(ctx context.Context, jaeger v1.Jaeger, d
 				"cronjob", d.Name,
 				"namespace", d.Namespace,
 			)
-			if err := r.client.Update(ctx, d); err != nil {
+			if err := r.serverSideApply(ctx, d); err != nil {
 				return tracing.HandleError(err, span)
 			}
 		}
@@ -123,6 +127,7 @@ func (r *ReconcileJaeger) applyCronJobs(ctx context.Context, jaeger v1.Jaeger, d
 			if err := r.client.Delete(ctx, d); err != nil {
 				return tracing.HandleError(err, span)
 			}
+			r.recorder.Eventf(&jaeger, corev1.EventTypeNormal, EventReasonDeleted, "Deleted cron job %s", d.GetName())
 		}
 	}
 
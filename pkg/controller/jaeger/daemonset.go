@@ -5,6 +5,7 @@ import (
 
 	"go.opentelemetry.io/otel"
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
@@ -37,9 +38,10 @@ func (r *ReconcileJaeger) applyDaemonSets(ctx context.Context, jaeger v1.Jaeger,
 			"daemonset", d.Name,
 			"namespace", d.Namespace,
 		)
-		if err := r.client.Create(ctx, &d); err != nil {
+		if err := r.serverSideApply(ctx, &d); err != nil {
 			return tracing.HandleError(err, span)
 		}
+		r.recorder.Eventf(&jaeger, corev1.EventTypeNormal, EventReasonCreated, "Created daemon set %s", d.GetName())
 	}
 
 	for i := range inv.Update {
@@ -49,7 +51,7 @@ func (r *ReconcileJaeger) applyDaemonSets(ctx context.Context, jaeger v1.Jaeger,
 			"daemonset", d.Name,
 			"namespace", d.Namespace,
 		)
-		if err := r.client.Update(ctx, &d); err != nil {
+		if err := r.serverSideApply(ctx, &d); err != nil {
 			return tracing.HandleError(err, span)
 		}
 	}
@@ -64,6 +66,7 @@ func (r *ReconcileJaeger) applyDaemonSets(ctx context.Context, jaeger v1.Jaeger,
 		if err := r.client.Delete(ctx, &d); err != nil {
 			return tracing.HandleError(err, span)
 		}
+		r.recorder.Eventf(&jaeger, corev1.EventTypeNormal, EventReasonDeleted, "Deleted daemon set %s", d.GetName())
 	}
 
 	return nil
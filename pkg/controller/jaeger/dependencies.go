@@ -10,6 +10,7 @@ import (
 	otelattribute "go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
 	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
@@ -17,6 +18,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/storage"
 	"github.com/jaegertracing/jaeger-operator/pkg/strategy"
 	"github.com/jaegertracing/jaeger-operator/pkg/tracing"
 )
@@ -24,13 +26,21 @@ import (
 // ErrDependencyRemoved is returned when a dependency existed but has been removed
 var ErrDependencyRemoved = errors.New("dependency has been removed")
 
-func (r *ReconcileJaeger) handleDependencies(ctx context.Context, str strategy.S) error {
+func (r *ReconcileJaeger) handleDependencies(ctx context.Context, jaeger *v1.Jaeger, str strategy.S) error {
 	tracer := otel.GetTracerProvider().Tracer(v1.ReconciliationTracer)
 	ctx, span := tracer.Start(ctx, "handleDependencies")
 	defer span.End()
 
 	for _, dep := range str.Dependencies() {
-		err := r.handleDependency(ctx, str, dep)
+		var err error
+		if schema := storage.CassandraSchemaStatus(dep); schema != nil {
+			err = r.handleCassandraSchema(ctx, jaeger, str, dep, *schema)
+		} else {
+			err = r.handleDependency(ctx, str, dep)
+		}
+		if wait.Interrupted(err) {
+			r.recorder.Eventf(jaeger, corev1.EventTypeWarning, EventReasonDependencyTimeout, "Dependency %s didn't complete within its deadline", dep.Name)
+		}
 		if err != nil {
 			return tracing.HandleError(err, span)
 		}
@@ -0,0 +1,40 @@
+package jaeger
+
+// Reasons for the events recorded against the Jaeger instances
+const (
+	// EventReasonCreated is used when an object owned by the instance has been created
+	EventReasonCreated = "Created"
+
+	// EventReasonDeleted is used when an object owned by the instance has been removed
+	EventReasonDeleted = "Deleted"
+
+	// EventReasonUpdated is used when a new generation of the instance has been applied
+	EventReasonUpdated = "Updated"
+
+	// EventReasonUpgradeAvailable is used when a newer version is available for an instance held back on its version
+	EventReasonUpgradeAvailable = "UpgradeAvailable"
+
+	// EventReasonRolledBack is used when the instance has been restored to the spec and version it had before its last upgrade
+	EventReasonRolledBack = "RolledBack"
+
+	// EventReasonRollbackFailed is used when the rollback of the last upgrade was requested but couldn't be done
+	EventReasonRollbackFailed = "RollbackFailed"
+
+	// EventReasonReconcileFailed is used when the instance couldn't be reconciled
+	EventReasonReconcileFailed = "ReconcileFailed"
+
+	// EventReasonCertificateGenerationFailed is used when the Elasticsearch certificates couldn't be generated
+	EventReasonCertificateGenerationFailed = "CertificateGenerationFailed"
+
+	// EventReasonDependencyTimeout is used when a dependency job hasn't completed within its deadline
+	EventReasonDependencyTimeout = "DependencyTimeout"
+
+	// EventReasonCassandraSchemaMigrated is used when the Cassandra keyspace has been created or migrated to the settings of the instance
+	EventReasonCassandraSchemaMigrated = "CassandraSchemaMigrated"
+
+	// EventReasonSidecarRemoved is used when the sidecar pointing to the instance being deleted has been removed from a workload
+	EventReasonSidecarRemoved = "SidecarRemoved"
+
+	// EventReasonProvisioningSkipped is used when the storage should be provisioned, but provisioning is disabled
+	EventReasonProvisioningSkipped = "ProvisioningSkipped"
+)
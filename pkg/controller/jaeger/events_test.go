@@ -0,0 +1,134 @@
+package jaeger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/autodetect"
+	k8ssandrav1alpha1 "github.com/jaegertracing/jaeger-operator/pkg/k8ssandra/v1alpha1"
+	"github.com/jaegertracing/jaeger-operator/pkg/kafka/v1beta2"
+	"github.com/jaegertracing/jaeger-operator/pkg/strategy"
+)
+
+func TestEventsOnSuccessfulReconciliation(t *testing.T) {
+	// prepare
+	nsn := types.NamespacedName{Name: "TestEventsOnSuccessfulReconciliation"}
+	jaeger := v1.NewJaeger(nsn)
+	jaeger.Generation = 1
+
+	recorder := record.NewFakeRecorder(10)
+	r, _ := getReconciler([]client.Object{jaeger})
+	r.recorder = recorder
+	r.strategyChooser = func(ctx context.Context, jaeger *v1.Jaeger) strategy.S {
+		return strategy.New().WithServices([]corev1.Service{{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: nsn.Name,
+				Labels: map[string]string{
+					"app.kubernetes.io/instance":   nsn.Name,
+					"app.kubernetes.io/managed-by": "jaeger-operator",
+				},
+			},
+		}})
+	}
+
+	// test
+	_, err := r.Reconcile(reconcile.Request{NamespacedName: nsn})
+
+	// verify
+	require.NoError(t, err)
+	require.Len(t, recorder.Events, 2)
+	assert.Equal(t, "Normal Created Created service TestEventsOnSuccessfulReconciliation", <-recorder.Events)
+	assert.Equal(t, "Normal Updated Applied generation 1", <-recorder.Events)
+
+	// a second reconciliation of the same generation doesn't record anything new
+	_, err = r.Reconcile(reconcile.Request{NamespacedName: nsn})
+	require.NoError(t, err)
+	assert.Empty(t, recorder.Events)
+}
+
+func TestEventsOnFailedReconciliation(t *testing.T) {
+	// prepare
+	nsn := types.NamespacedName{Name: "TestEventsOnFailedReconciliation"}
+
+	recorder := record.NewFakeRecorder(10)
+	r, _ := getReconciler([]client.Object{v1.NewJaeger(nsn)})
+	r.recorder = recorder
+	r.strategyChooser = func(ctx context.Context, jaeger *v1.Jaeger) strategy.S {
+		// services without a name can't be created
+		return strategy.New().WithServices([]corev1.Service{{}})
+	}
+
+	// test
+	_, err := r.Reconcile(reconcile.Request{NamespacedName: nsn})
+
+	// verify
+	require.Error(t, err)
+	require.Len(t, recorder.Events, 1)
+	assert.Contains(t, <-recorder.Events, "Warning ReconcileFailed Failed to apply the changes")
+}
+
+func TestEventsOnKafkaProvisioningSkipped(t *testing.T) {
+	// prepare
+	autodetect.OperatorConfiguration.SetKafkaIntegration(autodetect.KafkaOperatorIntegrationNo)
+	defer viper.Reset()
+
+	nsn := types.NamespacedName{Name: "TestEventsOnKafkaProvisioningSkipped"}
+
+	recorder := record.NewFakeRecorder(10)
+	r, _ := getReconciler([]client.Object{v1.NewJaeger(nsn)})
+	r.recorder = recorder
+	r.strategyChooser = func(ctx context.Context, jaeger *v1.Jaeger) strategy.S {
+		return strategy.New().WithKafkas([]v1beta2.Kafka{{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: nsn.Name,
+			},
+		}})
+	}
+
+	// test
+	_, err := r.Reconcile(reconcile.Request{NamespacedName: nsn})
+
+	// verify
+	require.NoError(t, err)
+	require.NotEmpty(t, recorder.Events)
+	assert.Equal(t, "Warning ProvisioningSkipped A Kafka cluster should be provisioned, but provisioning is disabled for this Jaeger Operator", <-recorder.Events)
+}
+
+func TestEventsOnK8ssandraProvisioningSkipped(t *testing.T) {
+	// prepare
+	autodetect.OperatorConfiguration.SetCassandraIntegration(autodetect.CassandraOperatorIntegrationYes)
+	autodetect.OperatorConfiguration.SetK8ssandraAvailability(autodetect.K8ssandraAvailabilityNo)
+	defer viper.Reset()
+
+	nsn := types.NamespacedName{Name: "TestEventsOnK8ssandraProvisioningSkipped"}
+
+	recorder := record.NewFakeRecorder(10)
+	r, _ := getReconciler([]client.Object{v1.NewJaeger(nsn)})
+	r.recorder = recorder
+	r.strategyChooser = func(ctx context.Context, jaeger *v1.Jaeger) strategy.S {
+		return strategy.New().WithK8ssandraClusters([]k8ssandrav1alpha1.K8ssandraCluster{{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: nsn.Name,
+			},
+		}})
+	}
+
+	// test
+	_, err := r.Reconcile(reconcile.Request{NamespacedName: nsn})
+
+	// verify
+	require.NoError(t, err)
+	require.NotEmpty(t, recorder.Events)
+	assert.Equal(t, "Warning ProvisioningSkipped A K8ssandra cluster should be provisioned, but the K8ssandra operator isn't available", <-recorder.Events)
+}
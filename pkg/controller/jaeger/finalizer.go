@@ -0,0 +1,179 @@
+package jaeger
+
+import (
+	"context"
+	"strings"
+
+	osconsolev1 "github.com/openshift/api/console/v1"
+	"github.com/spf13/viper"
+	"go.opentelemetry.io/otel"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/autodetect"
+	"github.com/jaegertracing/jaeger-operator/pkg/inject"
+	"github.com/jaegertracing/jaeger-operator/pkg/tracing"
+)
+
+// finalize cleans up what the instance being deleted left behind and isn't garbage collected: the sidecars injected
+// into the workloads, the CA config maps copied into the namespaces of these workloads and the console links.
+// The finalizer is removed only once everything has been cleaned up, letting the deletion complete.
+func (r *ReconcileJaeger) finalize(ctx context.Context, jaeger *v1.Jaeger) error {
+	tracer := otel.GetTracerProvider().Tracer(v1.ReconciliationTracer)
+	ctx, span := tracer.Start(ctx, "finalize")
+	defer span.End()
+
+	if !controllerutil.ContainsFinalizer(jaeger, v1.FinalizerCleanup) {
+		return nil
+	}
+
+	if err := r.cleanInjected(ctx, jaeger); err != nil {
+		return tracing.HandleError(err, span)
+	}
+
+	if err := r.cleanConsoleLinks(ctx, jaeger); err != nil {
+		return tracing.HandleError(err, span)
+	}
+
+	controllerutil.RemoveFinalizer(jaeger, v1.FinalizerCleanup)
+	if err := r.client.Update(ctx, jaeger); err != nil {
+		return tracing.HandleError(err, span)
+	}
+
+	jaeger.Logger().V(-1).Info("cleaned up the instance being deleted")
+	return nil
+}
+
+// cleanInjected removes the sidecars pointing to the given instance and the CA config maps copied along with them,
+// unless another instance has the same name: they refer to the instance by its name only, so they might belong to
+// the other instance
+func (r *ReconcileJaeger) cleanInjected(ctx context.Context, jaeger *v1.Jaeger) error {
+	shared, err := r.sharesNameWithOtherInstances(ctx, jaeger)
+	if err != nil {
+		return err
+	}
+	if shared {
+		jaeger.Logger().V(-1).Info("another instance has the same name, skipping the clean up of the sidecars and config maps")
+		return nil
+	}
+
+	if err := r.cleanSidecars(ctx, jaeger); err != nil {
+		return err
+	}
+	return r.cleanConfigMaps(ctx, jaeger.Name)
+}
+
+// sharesNameWithOtherInstances returns whether another instance, not being deleted, has the same name as the given one
+func (r *ReconcileJaeger) sharesNameWithOtherInstances(ctx context.Context, jaeger *v1.Jaeger) (bool, error) {
+	shared := false
+	err := forEachWatchedNamespace(func(opts ...client.ListOption) error {
+		instances := &v1.JaegerList{}
+		if err := r.rClient.List(ctx, instances, opts...); err != nil {
+			return err
+		}
+		for _, instance := range instances.Items {
+			if instance.Name == jaeger.Name && instance.Namespace != jaeger.Namespace && instance.DeletionTimestamp == nil {
+				shared = true
+			}
+		}
+		return nil
+	})
+	return shared, err
+}
+
+// cleanSidecars removes the sidecars pointing to the given instance from the workloads they were injected into
+func (r *ReconcileJaeger) cleanSidecars(ctx context.Context, jaeger *v1.Jaeger) error {
+	return forEachWatchedNamespace(func(opts ...client.ListOption) error {
+		workloads, err := r.injectedWorkloads(ctx, append(opts, client.MatchingLabels{inject.Label: jaeger.Name})...)
+		if err != nil {
+			return err
+		}
+
+		for _, w := range workloads {
+			jaeger.Logger().V(-1).Info(
+				"removing the sidecar",
+				"kind", w.Kind,
+				"name", w.Name,
+				"namespace", w.Namespace,
+			)
+			inject.CleanSidecarForWorkload(jaeger.Name, w.Workload)
+			obj, err := w.Object()
+			if err != nil {
+				return err
+			}
+			if err := r.client.Update(ctx, obj); err != nil && !k8serrors.IsNotFound(err) {
+				return err
+			}
+			r.recorder.Eventf(jaeger, corev1.EventTypeNormal, EventReasonSidecarRemoved, "Removed the sidecar from the %s %s/%s", w.Kind, w.Namespace, w.Name)
+		}
+		return nil
+	})
+}
+
+// injectedWorkloads lists the workloads of every kind the sidecar can be injected into, matching the given options
+func (r *ReconcileJaeger) injectedWorkloads(ctx context.Context, opts ...client.ListOption) ([]*inject.ObjectWorkload, error) {
+	workloads, err := inject.ListWorkloads(ctx, r.rClient, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs := &batchv1.JobList{}
+	if err := r.rClient.List(ctx, jobs, opts...); err != nil {
+		return nil, err
+	}
+	for i := range jobs.Items {
+		job := &jobs.Items[i]
+		// the pod template of a job is immutable: the sidecar is left in its pods, which run to completion anyway,
+		// and only the label marking the job as injected is removed
+		workloads = append(workloads, inject.NewObjectWorkload("job", job, inject.NewBatchWorkload(&job.ObjectMeta, job.Spec.Template.DeepCopy())))
+	}
+
+	return workloads, nil
+}
+
+// cleanConsoleLinks removes the console links of the given instance, which are cluster-scoped
+func (r *ReconcileJaeger) cleanConsoleLinks(ctx context.Context, jaeger *v1.Jaeger) error {
+	if autodetect.OperatorConfiguration.GetPlatform() != autodetect.OpenShiftPlatform ||
+		viper.GetString(v1.ConfigOperatorScope) != v1.OperatorScopeCluster {
+		// console links are created only by cluster-wide operators on OpenShift
+		return nil
+	}
+
+	list := &osconsolev1.ConsoleLinkList{}
+	if err := r.rClient.List(ctx, list, client.MatchingLabels{
+		"app.kubernetes.io/instance":   jaeger.Name,
+		"app.kubernetes.io/namespace":  jaeger.Namespace,
+		"app.kubernetes.io/managed-by": "jaeger-operator",
+	}); err != nil {
+		return err
+	}
+
+	for i := range list.Items {
+		d := list.Items[i]
+		if err := r.client.Delete(ctx, &d); err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+		r.recorder.Eventf(jaeger, corev1.EventTypeNormal, EventReasonDeleted, "Deleted console link %s", d.GetName())
+	}
+	return nil
+}
+
+// forEachWatchedNamespace calls the given function with the list options for each of the watched namespaces, or
+// once without namespace when all namespaces are watched
+func forEachWatchedNamespace(fn func(opts ...client.ListOption) error) error {
+	namespaces := viper.GetString(v1.ConfigWatchNamespace)
+	if namespaces == v1.WatchAllNamespaces {
+		return fn()
+	}
+
+	for _, ns := range strings.Split(namespaces, ",") {
+		if err := fn(client.InNamespace(ns)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
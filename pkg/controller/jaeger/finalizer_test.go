@@ -0,0 +1,290 @@
+package jaeger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/autodetect"
+	"github.com/jaegertracing/jaeger-operator/pkg/inject"
+	"github.com/jaegertracing/jaeger-operator/pkg/strategy"
+)
+
+func TestFinalizerAddedOnReconcile(t *testing.T) {
+	// prepare
+	viper.Set(v1.ConfigIdentity, "my-identity")
+	defer viper.Reset()
+
+	nsn := types.NamespacedName{Name: "my-instance", Namespace: "observability"}
+	jaeger := v1.NewJaeger(nsn)
+	jaeger.Labels = map[string]string{v1.LabelOperatedBy: "my-identity"}
+
+	r, cl := getReconciler([]client.Object{jaeger})
+	r.strategyChooser = func(ctx context.Context, jaeger *v1.Jaeger) strategy.S {
+		return *strategy.New()
+	}
+
+	// test
+	_, err := r.Reconcile(reconcile.Request{NamespacedName: nsn})
+
+	// verify
+	require.NoError(t, err)
+	persisted := &v1.Jaeger{}
+	require.NoError(t, cl.Get(context.Background(), nsn, persisted))
+	assert.Contains(t, persisted.Finalizers, v1.FinalizerCleanup)
+}
+
+func TestFinalizeCleansUpSidecarsAndConfigMaps(t *testing.T) {
+	// prepare
+	viper.Set(v1.ConfigIdentity, "my-identity")
+	defer viper.Reset()
+
+	nsn := types.NamespacedName{Name: "my-instance", Namespace: "observability"}
+	jaeger := deletedJaeger(nsn)
+	dep := deploymentWithSidecar(nsn.Name, "my-app")
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-instance-trusted-ca",
+			Namespace: "my-app",
+			Labels: map[string]string{
+				"app.kubernetes.io/instance":   nsn.Name,
+				"app.kubernetes.io/component":  "ca-configmap",
+				"app.kubernetes.io/managed-by": "jaeger-operator",
+			},
+		},
+	}
+
+	r, cl := getReconciler([]client.Object{jaeger, dep, cm})
+
+	// test
+	_, err := r.Reconcile(reconcile.Request{NamespacedName: nsn})
+
+	// verify
+	require.NoError(t, err)
+
+	persistedDep := &appsv1.Deployment{}
+	require.NoError(t, cl.Get(context.Background(), client.ObjectKeyFromObject(dep), persistedDep))
+	assert.NotContains(t, persistedDep.Labels, inject.Label)
+	assert.Len(t, persistedDep.Spec.Template.Spec.Containers, 1)
+	assert.Equal(t, "my-app", persistedDep.Spec.Template.Spec.Containers[0].Name)
+
+	err = cl.Get(context.Background(), client.ObjectKeyFromObject(cm), &corev1.ConfigMap{})
+	assert.True(t, errors.IsNotFound(err))
+
+	// the deletion completes once the finalizer is removed
+	err = cl.Get(context.Background(), nsn, &v1.Jaeger{})
+	assert.True(t, errors.IsNotFound(err))
+}
+
+func TestFinalizeCleansUpSidecarsOfAllWorkloads(t *testing.T) {
+	// prepare
+	viper.Set(v1.ConfigIdentity, "my-identity")
+	defer viper.Reset()
+
+	nsn := types.NamespacedName{Name: "my-instance", Namespace: "observability"}
+	jaeger := deletedJaeger(nsn)
+	meta := metav1.ObjectMeta{
+		Name:      "my-app",
+		Namespace: "my-app",
+		Labels:    map[string]string{inject.Label: nsn.Name},
+	}
+	template := corev1.PodTemplateSpec{
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "my-app"}, {Name: "jaeger-agent"}},
+		},
+	}
+	always := corev1.ContainerRestartPolicyAlways
+	batchTemplate := corev1.PodTemplateSpec{
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{{Name: "jaeger-agent", RestartPolicy: &always}},
+			Containers:     []corev1.Container{{Name: "my-app"}},
+		},
+	}
+	ss := &appsv1.StatefulSet{ObjectMeta: *meta.DeepCopy(), Spec: appsv1.StatefulSetSpec{Template: *template.DeepCopy()}}
+	ds := &appsv1.DaemonSet{ObjectMeta: *meta.DeepCopy(), Spec: appsv1.DaemonSetSpec{Template: *template.DeepCopy()}}
+	job := &batchv1.Job{ObjectMeta: *meta.DeepCopy(), Spec: batchv1.JobSpec{Template: *batchTemplate.DeepCopy()}}
+	cj := &batchv1.CronJob{ObjectMeta: *meta.DeepCopy(), Spec: batchv1.CronJobSpec{
+		JobTemplate: batchv1.JobTemplateSpec{Spec: batchv1.JobSpec{Template: *batchTemplate.DeepCopy()}},
+	}}
+
+	r, cl := getReconciler([]client.Object{jaeger, ss, ds, job, cj})
+
+	// test
+	_, err := r.Reconcile(reconcile.Request{NamespacedName: nsn})
+
+	// verify
+	require.NoError(t, err)
+
+	persistedSS := &appsv1.StatefulSet{}
+	require.NoError(t, cl.Get(context.Background(), client.ObjectKeyFromObject(ss), persistedSS))
+	assert.NotContains(t, persistedSS.Labels, inject.Label)
+	assert.Len(t, persistedSS.Spec.Template.Spec.Containers, 1)
+
+	persistedDS := &appsv1.DaemonSet{}
+	require.NoError(t, cl.Get(context.Background(), client.ObjectKeyFromObject(ds), persistedDS))
+	assert.NotContains(t, persistedDS.Labels, inject.Label)
+	assert.Len(t, persistedDS.Spec.Template.Spec.Containers, 1)
+
+	persistedCJ := &batchv1.CronJob{}
+	require.NoError(t, cl.Get(context.Background(), client.ObjectKeyFromObject(cj), persistedCJ))
+	assert.NotContains(t, persistedCJ.Labels, inject.Label)
+	assert.Empty(t, persistedCJ.Spec.JobTemplate.Spec.Template.Spec.InitContainers)
+	assert.Len(t, persistedCJ.Spec.JobTemplate.Spec.Template.Spec.Containers, 1)
+
+	// the pod template of a job is immutable, only its label is removed
+	persistedJob := &batchv1.Job{}
+	require.NoError(t, cl.Get(context.Background(), client.ObjectKeyFromObject(job), persistedJob))
+	assert.NotContains(t, persistedJob.Labels, inject.Label)
+	assert.Len(t, persistedJob.Spec.Template.Spec.InitContainers, 1)
+
+	err = cl.Get(context.Background(), nsn, &v1.Jaeger{})
+	assert.True(t, errors.IsNotFound(err))
+}
+
+func TestFinalizeCleansUpSidecarsOfRollouts(t *testing.T) {
+	// prepare
+	viper.Set(v1.ConfigIdentity, "my-identity")
+	autodetect.OperatorConfiguration.SetArgoRolloutsAvailability(autodetect.ArgoRolloutsAvailabilityYes)
+	defer viper.Reset()
+
+	nsn := types.NamespacedName{Name: "my-instance", Namespace: "observability"}
+	jaeger := deletedJaeger(nsn)
+	rollout := &unstructured.Unstructured{}
+	rollout.SetGroupVersionKind(inject.RolloutGroupVersionKind)
+	rollout.SetName("my-app")
+	rollout.SetNamespace("my-app")
+	rollout.SetLabels(map[string]string{inject.Label: nsn.Name})
+	require.NoError(t, unstructured.SetNestedMap(rollout.Object, map[string]interface{}{
+		"spec": map[string]interface{}{"containers": []interface{}{
+			map[string]interface{}{"name": "my-app"},
+			map[string]interface{}{"name": "jaeger-agent"},
+		}},
+	}, "spec", "template"))
+
+	r, cl := getReconciler([]client.Object{jaeger, rollout})
+
+	// test
+	_, err := r.Reconcile(reconcile.Request{NamespacedName: nsn})
+
+	// verify
+	require.NoError(t, err)
+
+	persisted := &unstructured.Unstructured{}
+	persisted.SetGroupVersionKind(inject.RolloutGroupVersionKind)
+	require.NoError(t, cl.Get(context.Background(), client.ObjectKeyFromObject(rollout), persisted))
+	assert.NotContains(t, persisted.GetLabels(), inject.Label)
+	containers, _, err := unstructured.NestedSlice(persisted.Object, "spec", "template", "spec", "containers")
+	require.NoError(t, err)
+	assert.Len(t, containers, 1)
+}
+
+func TestCleanUpSidecarsOfInstanceDeletedWithoutFinalizer(t *testing.T) {
+	// prepare
+	nsn := types.NamespacedName{Name: "my-instance", Namespace: "observability"}
+	dep := deploymentWithSidecar(nsn.Name, "my-app")
+
+	r, cl := getReconciler([]client.Object{dep})
+
+	// test
+	_, err := r.Reconcile(reconcile.Request{NamespacedName: nsn})
+
+	// verify
+	require.NoError(t, err)
+
+	persistedDep := &appsv1.Deployment{}
+	require.NoError(t, cl.Get(context.Background(), client.ObjectKeyFromObject(dep), persistedDep))
+	assert.NotContains(t, persistedDep.Labels, inject.Label)
+	assert.Len(t, persistedDep.Spec.Template.Spec.Containers, 1)
+}
+
+func TestFinalizeKeepsSidecarsOfInstanceWithSameName(t *testing.T) {
+	// prepare
+	viper.Set(v1.ConfigIdentity, "my-identity")
+	defer viper.Reset()
+
+	nsn := types.NamespacedName{Name: "my-instance", Namespace: "observability"}
+	jaeger := deletedJaeger(nsn)
+	other := v1.NewJaeger(types.NamespacedName{Name: "my-instance", Namespace: "other-observability"})
+	dep := deploymentWithSidecar(nsn.Name, "my-app")
+
+	r, cl := getReconciler([]client.Object{jaeger, other, dep})
+
+	// test
+	_, err := r.Reconcile(reconcile.Request{NamespacedName: nsn})
+
+	// verify
+	require.NoError(t, err)
+
+	persistedDep := &appsv1.Deployment{}
+	require.NoError(t, cl.Get(context.Background(), client.ObjectKeyFromObject(dep), persistedDep))
+	assert.Equal(t, nsn.Name, persistedDep.Labels[inject.Label])
+	assert.Len(t, persistedDep.Spec.Template.Spec.Containers, 2)
+
+	err = cl.Get(context.Background(), nsn, &v1.Jaeger{})
+	assert.True(t, errors.IsNotFound(err))
+}
+
+func TestFinalizeSkippedForInstanceManagedByOthers(t *testing.T) {
+	// prepare
+	viper.Set(v1.ConfigIdentity, "my-identity")
+	defer viper.Reset()
+
+	nsn := types.NamespacedName{Name: "my-instance", Namespace: "observability"}
+	jaeger := deletedJaeger(nsn)
+	jaeger.Labels[v1.LabelOperatedBy] = "another-identity"
+	dep := deploymentWithSidecar(nsn.Name, "my-app")
+
+	r, cl := getReconciler([]client.Object{jaeger, dep})
+
+	// test
+	_, err := r.Reconcile(reconcile.Request{NamespacedName: nsn})
+
+	// verify
+	require.NoError(t, err)
+
+	persistedDep := &appsv1.Deployment{}
+	require.NoError(t, cl.Get(context.Background(), client.ObjectKeyFromObject(dep), persistedDep))
+	assert.Len(t, persistedDep.Spec.Template.Spec.Containers, 2)
+
+	persisted := &v1.Jaeger{}
+	require.NoError(t, cl.Get(context.Background(), nsn, persisted))
+	assert.Contains(t, persisted.Finalizers, v1.FinalizerCleanup)
+}
+
+func deletedJaeger(nsn types.NamespacedName) *v1.Jaeger {
+	jaeger := v1.NewJaeger(nsn)
+	jaeger.Labels = map[string]string{v1.LabelOperatedBy: "my-identity"}
+	jaeger.Finalizers = []string{v1.FinalizerCleanup}
+	jaeger.DeletionTimestamp = &metav1.Time{Time: metav1.Now().Time}
+	return jaeger
+}
+
+func deploymentWithSidecar(instance, namespace string) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-app",
+			Namespace: namespace,
+			Labels:    map[string]string{inject.Label: instance},
+		},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "my-app"}, {Name: "jaeger-agent"}},
+				},
+			},
+		},
+	}
+}
@@ -2,8 +2,11 @@ package jaeger
 
 import (
 	"context"
+	"strings"
 
 	"go.opentelemetry.io/otel"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -14,9 +17,13 @@ import (
 
 	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
 	"github.com/jaegertracing/jaeger-operator/pkg/inventory"
+	kedav1alpha1 "github.com/jaegertracing/jaeger-operator/pkg/keda/v1alpha1"
 	"github.com/jaegertracing/jaeger-operator/pkg/tracing"
 )
 
+// kedaScaledObjectLabel is the label KEDA sets on the autoscalers it creates, holding the name of the scaled object
+const kedaScaledObjectLabel = "scaledobject.keda.sh/name"
+
 func (r *ReconcileJaeger) applyHorizontalPodAutoscalers(ctx context.Context, jaeger v1.Jaeger, desired []runtime.Object) error {
 	tracer := otel.GetTracerProvider().Tracer(v1.ReconciliationTracer)
 	ctx, span := tracer.Start(ctx, "applyHorizontalPodAutoscalers")
@@ -41,6 +48,9 @@ func (r *ReconcileJaeger) applyHorizontalPodAutoscalers(ctx context.Context, jae
 
 		var existing []runtime.Object
 		for _, i := range hpaList.Items {
+			if ownedByScaledObject(i.ObjectMeta) {
+				continue
+			}
 			existing = append(existing, i.DeepCopyObject())
 		}
 
@@ -52,9 +62,10 @@ func (r *ReconcileJaeger) applyHorizontalPodAutoscalers(ctx context.Context, jae
 				"hpa", d.Name,
 				"namespace", d.Namespace,
 			)
-			if err := r.client.Create(ctx, d); err != nil {
+			if err := r.serverSideApply(ctx, d); err != nil {
 				return tracing.HandleError(err, span)
 			}
+			r.recorder.Eventf(&jaeger, corev1.EventTypeNormal, EventReasonCreated, "Created horizontal pod autoscaler %s", d.GetName())
 		}
 
 		for i := range hpaInventory.Update {
@@ -64,7 +75,7 @@ func (r *ReconcileJaeger) applyHorizontalPodAutoscalers(ctx context.Context, jae
 				"hpa", d.Name,
 				"namespace", d.Namespace,
 			)
-			if err := r.client.Update(ctx, d); err != nil {
+			if err := r.serverSideApply(ctx, d); err != nil {
 				return tracing.HandleError(err, span)
 			}
 		}
@@ -79,6 +90,7 @@ func (r *ReconcileJaeger) applyHorizontalPodAutoscalers(ctx context.Context, jae
 			if err := r.client.Delete(ctx, d); err != nil {
 				return tracing.HandleError(err, span)
 			}
+			r.recorder.Eventf(&jaeger, corev1.EventTypeNormal, EventReasonDeleted, "Deleted horizontal pod autoscaler %s", d.GetName())
 		}
 	} else {
 		hpaList := &autoscalingv2.HorizontalPodAutoscalerList{}
@@ -89,6 +101,9 @@ func (r *ReconcileJaeger) applyHorizontalPodAutoscalers(ctx context.Context, jae
 
 		var existing []runtime.Object
 		for _, i := range hpaList.Items {
+			if ownedByScaledObject(i.ObjectMeta) {
+				continue
+			}
 			existing = append(existing, i.DeepCopyObject())
 		}
 
@@ -100,9 +115,10 @@ func (r *ReconcileJaeger) applyHorizontalPodAutoscalers(ctx context.Context, jae
 				"hpa", d.Name,
 				"namespace", d.Namespace,
 			)
-			if err := r.client.Create(ctx, d); err != nil {
+			if err := r.serverSideApply(ctx, d); err != nil {
 				return tracing.HandleError(err, span)
 			}
+			r.recorder.Eventf(&jaeger, corev1.EventTypeNormal, EventReasonCreated, "Created horizontal pod autoscaler %s", d.GetName())
 		}
 
 		for i := range hpaInventory.Update {
@@ -112,7 +128,7 @@ func (r *ReconcileJaeger) applyHorizontalPodAutoscalers(ctx context.Context, jae
 				"hpa", d.Name,
 				"namespace", d.Namespace,
 			)
-			if err := r.client.Update(ctx, d); err != nil {
+			if err := r.serverSideApply(ctx, d); err != nil {
 				return tracing.HandleError(err, span)
 			}
 		}
@@ -127,8 +143,23 @@ func (r *ReconcileJaeger) applyHorizontalPodAutoscalers(ctx context.Context, jae
 			if err := r.client.Delete(ctx, d); err != nil {
 				return tracing.HandleError(err, span)
 			}
+			r.recorder.Eventf(&jaeger, corev1.EventTypeNormal, EventReasonDeleted, "Deleted horizontal pod autoscaler %s", d.GetName())
 		}
 	}
 
 	return nil
 }
+
+// ownedByScaledObject returns whether the given autoscaler is managed by KEDA on behalf of a scaled object, which copies
+// its labels, including the ones of the instance, onto the autoscaler: such autoscalers are left to KEDA
+func ownedByScaledObject(hpa metav1.ObjectMeta) bool {
+	if _, ok := hpa.Labels[kedaScaledObjectLabel]; ok {
+		return true
+	}
+	for _, ref := range hpa.OwnerReferences {
+		if ref.Kind == "ScaledObject" && strings.HasPrefix(ref.APIVersion, kedav1alpha1.GroupVersion.Group+"/") {
+			return true
+		}
+	}
+	return false
+}
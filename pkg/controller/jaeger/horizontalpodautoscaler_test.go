@@ -250,6 +250,58 @@ func TestHorizontalPodAutoscalerDeleteV2(t *testing.T) {
 	require.Error(t, err) // not found
 }
 
+func TestHorizontalPodAutoscalerKeepsScaledObjectAutoscalers(t *testing.T) {
+	// prepare
+	viper.SetDefault(v1.FlagAutoscalingVersion, v1.FlagAutoscalingVersionV2)
+	defer viper.Reset()
+	nsn := types.NamespacedName{
+		Name: "TestHorizontalPodAutoscalerKeepsScaledObjectAutoscalers",
+	}
+
+	// KEDA copies the labels of the scaled object, including the ones of the instance, onto its autoscaler
+	labels := map[string]string{
+		"app.kubernetes.io/instance":   nsn.Name,
+		"app.kubernetes.io/managed-by": "jaeger-operator",
+	}
+	byLabel := &autoscalingv2.HorizontalPodAutoscaler{ObjectMeta: metav1.ObjectMeta{
+		Name:   "keda-hpa-my-ingester",
+		Labels: map[string]string{kedaScaledObjectLabel: "my-ingester"},
+	}}
+	byOwner := &autoscalingv2.HorizontalPodAutoscaler{ObjectMeta: metav1.ObjectMeta{
+		Name: "keda-hpa-my-other-ingester",
+		OwnerReferences: []metav1.OwnerReference{{
+			APIVersion: "keda.sh/v1alpha1",
+			Kind:       "ScaledObject",
+			Name:       "my-other-ingester",
+		}},
+	}}
+	for k, v := range labels {
+		byLabel.Labels[k] = v
+	}
+	byOwner.Labels = labels
+
+	objs := []client.Object{
+		v1.NewJaeger(nsn),
+		byLabel,
+		byOwner,
+	}
+
+	r, cl := getReconciler(objs)
+	r.strategyChooser = func(ctx context.Context, jaeger *v1.Jaeger) strategy.S {
+		return strategy.S{}
+	}
+
+	// test
+	_, err := r.Reconcile(reconcile.Request{NamespacedName: nsn})
+	require.NoError(t, err)
+
+	// verify
+	for _, hpa := range []*autoscalingv2.HorizontalPodAutoscaler{byLabel, byOwner} {
+		persisted := &autoscalingv2.HorizontalPodAutoscaler{}
+		require.NoError(t, cl.Get(context.Background(), types.NamespacedName{Name: hpa.Name}, persisted))
+	}
+}
+
 func TestHorizontalPodAutoscalerDeleteV2Beta2(t *testing.T) {
 	// prepare
 	viper.SetDefault(v1.FlagAutoscalingVersion, v1.FlagAutoscalingVersionV2Beta2)
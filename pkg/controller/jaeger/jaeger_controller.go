@@ -12,13 +12,15 @@ import (
 	"go.opentelemetry.io/otel"
 	otelattribute "go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
-	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
@@ -28,27 +30,35 @@ import (
 	"github.com/jaegertracing/jaeger-operator/pkg/storage"
 	"github.com/jaegertracing/jaeger-operator/pkg/strategy"
 	"github.com/jaegertracing/jaeger-operator/pkg/tracing"
+	"github.com/jaegertracing/jaeger-operator/pkg/version"
 )
 
+// pendingRequeueInterval is how long to wait before reconciling an instance whose workloads are not available yet
+const pendingRequeueInterval = 10 * time.Second
+
 // ReconcileJaeger reconciles a Jaeger object
 type ReconcileJaeger struct {
 	// This client, initialized using mgr.Client() above, is a split client
 	// that reads objects from the cache and writes to the apiserver
-	client               client.Client
-	rClient              client.Reader
-	scheme               *runtime.Scheme
-	strategyChooser      func(context.Context, *v1.Jaeger) strategy.S
-	certGenerationScript string
+	client          client.Client
+	rClient         client.Reader
+	scheme          *runtime.Scheme
+	recorder        record.EventRecorder
+	strategyChooser func(context.Context, *v1.Jaeger) strategy.S
+
+	// upgradedObjects holds the objects whose managed fields were handed over to the operator's apply requests
+	upgradedObjects *objectSet
 }
 
 // New creates new jaeger controller
-func New(client client.Client, clientReader client.Reader, scheme *runtime.Scheme) *ReconcileJaeger {
+func New(client client.Client, clientReader client.Reader, scheme *runtime.Scheme, recorder record.EventRecorder) *ReconcileJaeger {
 	return &ReconcileJaeger{
-		client:               client,
-		rClient:              clientReader,
-		scheme:               scheme,
-		strategyChooser:      defaultStrategyChooser,
-		certGenerationScript: "./scripts/cert_generation.sh",
+		client:          client,
+		rClient:         clientReader,
+		scheme:          scheme,
+		recorder:        recorder,
+		strategyChooser: defaultStrategyChooser,
+		upgradedObjects: newObjectSet(),
 	}
 }
 
@@ -82,9 +92,10 @@ func (r *ReconcileJaeger) Reconcile(request reconcile.Request) (reconcile.Result
 	if err != nil {
 		if k8serrors.IsNotFound(err) {
 			// Request object not found, could have been deleted after reconcile request.
-			// Owned objects are automatically garbage collected. For additional cleanup logic use finalizers.
+			// Owned objects are automatically garbage collected, the rest is cleaned up by the finalizer.
+			// The sidecars and config maps are cleaned up here too for instances deleted before they got the finalizer.
 			// Return and don't requeue
-			if err := r.cleanConfigMaps(ctx, request.Name); err != nil {
+			if err := r.cleanInjected(ctx, v1.NewJaeger(request.NamespacedName)); err != nil {
 				return reconcile.Result{}, tracing.HandleError(err, span)
 			}
 			return reconcile.Result{}, nil
@@ -93,6 +104,17 @@ func (r *ReconcileJaeger) Reconcile(request reconcile.Request) (reconcile.Result
 		return reconcile.Result{}, tracing.HandleError(err, span)
 	}
 
+	if instance.DeletionTimestamp != nil {
+		// the instance is being deleted: when we are the ones managing it, we clean up what isn't garbage collected
+		if instance.Labels[v1.LabelOperatedBy] == viper.GetString(v1.ConfigIdentity) {
+			if err := r.finalize(ctx, instance); err != nil {
+				instance.Logger().Error(err, "failed to clean up the instance being deleted")
+				return reconcile.Result{}, tracing.HandleError(err, span)
+			}
+		}
+		return reconcile.Result{}, nil
+	}
+
 	if err := syncOnJaegerChanges(r.rClient, r.client, instance.Name); err != nil {
 		return reconcile.Result{}, tracing.HandleError(err, span)
 	}
@@ -150,6 +172,17 @@ func (r *ReconcileJaeger) Reconcile(request reconcile.Request) (reconcile.Result
 		return reconcile.Result{}, nil
 	}
 
+	if controllerutil.AddFinalizer(instance, v1.FinalizerCleanup) {
+		// the sidecars and the objects copied into other namespaces are cleaned up before the instance goes away
+		if err := r.client.Update(ctx, instance); err != nil {
+			logFields.Error(
+				err,
+				"failed to add the finalizer to the instance",
+			)
+			return reconcile.Result{}, tracing.HandleError(err, span)
+		}
+	}
+
 	// workaround for https://github.com/jaegertracing/jaeger-operator/pull/558
 	instance.APIVersion = fmt.Sprintf("%s/%s", v1.GroupVersion.Group, v1.GroupVersion.Version)
 	instance.Kind = "Jaeger"
@@ -160,8 +193,13 @@ func (r *ReconcileJaeger) Reconcile(request reconcile.Request) (reconcile.Result
 
 	updated, err := r.apply(ctx, *instance, str)
 	if err != nil {
-		// update the status to "Failed"
+		// update the status to "Failed", keeping the conditions describing which step failed
 		instance.Status.Phase = v1.JaegerPhaseFailed
+		instance.Status.Conditions = updated.Status.Conditions
+		instance.Status.CassandraSchema = updated.Status.CassandraSchema
+		instance.Status.ObservedGeneration = instance.Generation
+		// the status might have been stored while applying the changes, such as the progress of the schema migration
+		instance.ResourceVersion = updated.ResourceVersion
 		if err := r.client.Status().Update(ctx, instance); err != nil {
 			// we let it return the real error later
 			logFields.Error(
@@ -174,11 +212,25 @@ func (r *ReconcileJaeger) Reconcile(request reconcile.Request) (reconcile.Result
 			err,
 			"failed to apply the changes",
 		)
+		r.recorder.Eventf(instance, corev1.EventTypeWarning, EventReasonReconcileFailed, "Failed to apply the changes: %v", err)
 		return reconcile.Result{}, tracing.HandleError(err, span)
 	}
 	instance = &updated
-	// Need to copy the version from status because the Update will populate the status field with empty strings.
-	instanceVersion := instance.Status.Version
+
+	if instance.Generation != originalInstance.Status.ObservedGeneration {
+		r.recorder.Eventf(instance, corev1.EventTypeNormal, EventReasonUpdated, "Applied generation %d", instance.Generation)
+	}
+
+	if err := r.observeWorkloads(ctx, instance); err != nil {
+		logFields.Error(
+			err,
+			"failed to observe the state of the workloads",
+		)
+		return reconcile.Result{}, tracing.HandleError(err, span)
+	}
+
+	// Need to copy the status because the Update will populate the status field with empty strings.
+	status := instance.Status
 
 	if !reflect.DeepEqual(originalInstance, *instance) {
 		// we store back the changed CR, so that what is stored reflects what is being used
@@ -191,10 +243,12 @@ func (r *ReconcileJaeger) Reconcile(request reconcile.Request) (reconcile.Result
 		}
 	}
 
-	// set the status version to the updated instance version if versions doesn't match
-	if instanceVersion != originalInstance.Status.Version || instance.Status.Phase != v1.JaegerPhaseRunning {
-		instance.Status.Phase = v1.JaegerPhaseRunning
-		instance.Status.Version = instanceVersion
+	status.Phase = phaseFor(status)
+	status.ObservedGeneration = instance.Generation
+
+	// store the status when the version, phase or any of the conditions changed
+	if !reflect.DeepEqual(status, originalInstance.Status) {
+		instance.Status = status
 		if err := r.client.Status().Update(ctx, instance); err != nil {
 			logFields.Error(
 				err,
@@ -211,6 +265,11 @@ func (r *ReconcileJaeger) Reconcile(request reconcile.Request) (reconcile.Result
 		"execution", execution,
 	)
 
+	if status.Phase == v1.JaegerPhasePending {
+		// check again later, so that the status reflects the workloads once they become available
+		return reconcile.Result{RequeueAfter: pendingRequeueInterval}, nil
+	}
+
 	return reconcile.Result{}, nil
 }
 
@@ -243,8 +302,24 @@ func (r *ReconcileJaeger) apply(ctx context.Context, jaeger v1.Jaeger, str strat
 
 	jaeger, err := r.applyUpgrades(ctx, jaeger)
 	if err != nil {
+		stepFailed(&jaeger, "upgrades", err, v1.JaegerConditionUpgradeApplied)
 		return jaeger, tracing.HandleError(err, span)
 	}
+	upgradeMessage := fmt.Sprintf("running version %s", jaeger.Status.Version)
+	if jaeger.Status.AvailableUpgrade != "" {
+		upgradeMessage = fmt.Sprintf("%s, version %s is available", upgradeMessage, jaeger.Status.AvailableUpgrade)
+	}
+	var unresolved []string
+	if jaeger.Status.Version != version.Get().Jaeger {
+		unresolved = jaeger.UnresolvedImages(jaeger.Status.Version)
+	}
+	if len(unresolved) > 0 {
+		// the instance runs the images of the operator for some of its components
+		setCondition(&jaeger, v1.JaegerConditionUpgradeApplied, metav1.ConditionFalse, v1.JaegerReasonImagesUnresolved,
+			fmt.Sprintf("the images set with %s can't be resolved for version %s, the images of the operator are used instead", strings.Join(unresolved, ", "), jaeger.Status.Version))
+	} else {
+		setCondition(&jaeger, v1.JaegerConditionUpgradeApplied, metav1.ConditionTrue, v1.JaegerReasonUpgraded, upgradeMessage)
+	}
 
 	// ES cert handling requires secrets from environment
 	// therefore running this here and not in the strategy
@@ -259,6 +334,7 @@ func (r *ReconcileJaeger) apply(ctx context.Context, jaeger v1.Jaeger, str strat
 		})
 		secrets := &corev1.SecretList{}
 		if err := r.rClient.List(ctx, secrets, opts); err != nil {
+			stepFailed(&jaeger, "elasticsearch certificates", err, v1.JaegerConditionStorageReady)
 			jaeger.Status.Phase = v1.JaegerPhaseFailed
 			if err := r.client.Status().Update(ctx, &jaeger); err != nil {
 				// we let it return the real error later
@@ -271,25 +347,29 @@ func (r *ReconcileJaeger) apply(ctx context.Context, jaeger v1.Jaeger, str strat
 		}
 		secretsForNamespace := r.getSecretsForNamespace(secrets.Items, jaeger.Namespace)
 
-		es := &storage.ElasticsearchDeployment{Jaeger: &jaeger, CertScript: r.certGenerationScript, Secrets: secretsForNamespace}
+		es := &storage.ElasticsearchDeployment{Jaeger: &jaeger, Secrets: secretsForNamespace}
 		err = es.CreateCerts()
 		if err != nil {
 			es.Jaeger.Logger().Error(
 				err,
 				"failed to create Elasticsearch certificates, Elasticsearch won't be deployed",
 			)
+			r.recorder.Eventf(&jaeger, corev1.EventTypeWarning, EventReasonCertificateGenerationFailed, "Failed to create Elasticsearch certificates, Elasticsearch won't be deployed: %v", err)
+			stepFailed(&jaeger, "elasticsearch certificates", err, v1.JaegerConditionStorageReady)
 			return jaeger, err
 		}
 		str = str.WithSecrets(append(str.Secrets(), es.ExtractSecrets()...))
 	}
 
 	if err := r.applySecrets(ctx, jaeger, str.Secrets()); err != nil {
+		stepFailed(&jaeger, "secrets", err, v1.JaegerConditionStorageReady)
 		return jaeger, tracing.HandleError(err, span)
 	}
 
 	elasticsearches := str.Elasticsearches()
 	if autodetect.OperatorConfiguration.IsESOperatorIntegrationEnabled() {
 		if err := r.applyElasticsearches(ctx, jaeger, elasticsearches); err != nil {
+			stepFailed(&jaeger, "elasticsearches", err, v1.JaegerConditionStorageReady)
 			return jaeger, tracing.HandleError(err, span)
 		}
 	} else if len(elasticsearches) > 0 {
@@ -298,44 +378,114 @@ func (r *ReconcileJaeger) apply(ctx context.Context, jaeger v1.Jaeger, str strat
 			"namespace", jaeger.Namespace,
 			"instance", jaeger.Name,
 		)
+		r.recorder.Event(&jaeger, corev1.EventTypeWarning, EventReasonProvisioningSkipped, "An Elasticsearch cluster should be provisioned, but provisioning is disabled for this Jaeger Operator")
 	}
 
 	kafkas := str.Kafkas()
+	kafkaNodePools := str.KafkaNodePools()
 	kafkaUsers := str.KafkaUsers()
+	kafkaTopics := str.KafkaTopics()
 	if autodetect.OperatorConfiguration.IsKafkaOperatorIntegrationEnabled() {
+		if err := r.applyKafkaNodePools(ctx, jaeger, kafkaNodePools); err != nil {
+			stepFailed(&jaeger, "kafka node pools", err, v1.JaegerConditionStorageReady)
+			return jaeger, tracing.HandleError(err, span)
+		}
+
 		if err := r.applyKafkas(ctx, jaeger, kafkas); err != nil {
+			stepFailed(&jaeger, "kafkas", err, v1.JaegerConditionStorageReady)
 			return jaeger, tracing.HandleError(err, span)
 		}
 
 		if err := r.applyKafkaUsers(ctx, jaeger, kafkaUsers); err != nil {
+			stepFailed(&jaeger, "kafka users", err, v1.JaegerConditionStorageReady)
+			return jaeger, tracing.HandleError(err, span)
+		}
+
+		if err := r.applyKafkaTopics(ctx, jaeger, kafkaTopics); err != nil {
+			stepFailed(&jaeger, "kafka topics", err, v1.JaegerConditionStorageReady)
 			return jaeger, tracing.HandleError(err, span)
 		}
-	} else if len(kafkas) > 0 || len(kafkaUsers) > 0 {
+	} else if len(kafkas) > 0 || len(kafkaNodePools) > 0 || len(kafkaUsers) > 0 || len(kafkaTopics) > 0 {
 		log.Log.V(1).Info(
 			"A Kafka cluster should be provisioned, but provisioning is disabled for this Jaeger Operator",
 			"namespace", jaeger.Namespace,
 			"instance", jaeger.Name,
 		)
+		r.recorder.Event(&jaeger, corev1.EventTypeWarning, EventReasonProvisioningSkipped, "A Kafka cluster should be provisioned, but provisioning is disabled for this Jaeger Operator")
+	}
+
+	cassandraDatacenters := str.CassandraDatacenters()
+	k8ssandraClusters := str.K8ssandraClusters()
+	if autodetect.OperatorConfiguration.IsCassandraOperatorIntegrationEnabled() {
+		if err := r.applyCassandraDatacenters(ctx, jaeger, cassandraDatacenters); err != nil {
+			stepFailed(&jaeger, "cassandra datacenters", err, v1.JaegerConditionStorageReady)
+			return jaeger, tracing.HandleError(err, span)
+		}
+
+		// the K8ssandraClusters can't be listed without the K8ssandra operator
+		if autodetect.OperatorConfiguration.IsK8ssandraAvailable() {
+			if err := r.applyK8ssandraClusters(ctx, jaeger, k8ssandraClusters); err != nil {
+				stepFailed(&jaeger, "k8ssandra clusters", err, v1.JaegerConditionStorageReady)
+				return jaeger, tracing.HandleError(err, span)
+			}
+		} else if len(k8ssandraClusters) > 0 {
+			// the cluster was provisioned through K8ssandra, which has been removed since
+			log.Log.V(1).Info(
+				"A K8ssandra cluster should be provisioned, but the K8ssandra operator isn't available",
+				"namespace", jaeger.Namespace,
+				"instance", jaeger.Name,
+			)
+			r.recorder.Event(&jaeger, corev1.EventTypeWarning, EventReasonProvisioningSkipped, "A K8ssandra cluster should be provisioned, but the K8ssandra operator isn't available")
+		}
+	} else if len(cassandraDatacenters) > 0 || len(k8ssandraClusters) > 0 {
+		log.Log.V(1).Info(
+			"A Cassandra cluster should be provisioned, but provisioning is disabled for this Jaeger Operator",
+			"namespace", jaeger.Namespace,
+			"instance", jaeger.Name,
+		)
+		r.recorder.Event(&jaeger, corev1.EventTypeWarning, EventReasonProvisioningSkipped, "A Cassandra cluster should be provisioned, but provisioning is disabled for this Jaeger Operator")
 	}
 
 	if err := r.applyAccounts(ctx, jaeger, str.Accounts()); err != nil {
+		stepFailed(&jaeger, "service accounts", err, v1.JaegerConditionCollectorAvailable, v1.JaegerConditionQueryAvailable)
 		return jaeger, tracing.HandleError(err, span)
 	}
 
 	// storage dependencies have to be deployed after ES is ready
-	if err := r.handleDependencies(ctx, str); err != nil {
+	if err := r.handleDependencies(ctx, &jaeger, str); err != nil {
+		stepFailed(&jaeger, "dependencies", err, v1.JaegerConditionDependenciesComplete)
 		return jaeger, tracing.HandleError(err, span)
 	}
+	if len(str.Dependencies()) > 0 {
+		setCondition(&jaeger, v1.JaegerConditionDependenciesComplete, metav1.ConditionTrue, v1.JaegerReasonJobsCompleted, "all dependency jobs have completed")
+	} else {
+		meta.RemoveStatusCondition(&jaeger.Status.Conditions, v1.JaegerConditionDependenciesComplete)
+	}
 
 	if err := r.applyClusterRoleBindingBindings(ctx, jaeger, str.ClusterRoleBindings()); err != nil {
+		stepFailed(&jaeger, "cluster role bindings", err, v1.JaegerConditionCollectorAvailable, v1.JaegerConditionQueryAvailable)
+		return jaeger, tracing.HandleError(err, span)
+	}
+
+	if err := str.Error(); err != nil {
+		// the workloads would refer to objects that couldn't be built
+		stepFailed(&jaeger, "config maps", err, v1.JaegerConditionCollectorAvailable, v1.JaegerConditionQueryAvailable)
 		return jaeger, tracing.HandleError(err, span)
 	}
 
 	if err := r.applyConfigMaps(ctx, jaeger, str.ConfigMaps()); err != nil {
+		stepFailed(&jaeger, "config maps", err, v1.JaegerConditionCollectorAvailable, v1.JaegerConditionQueryAvailable)
 		return jaeger, tracing.HandleError(err, span)
 	}
 
 	if err := r.applyCronJobs(ctx, jaeger, str.CronJobs()); err != nil {
+		stepFailed(&jaeger, "cron jobs", err, v1.JaegerConditionStorageReady)
+		return jaeger, tracing.HandleError(err, span)
+	}
+	setCondition(&jaeger, v1.JaegerConditionStorageReady, metav1.ConditionTrue, v1.JaegerReasonReconciled, fmt.Sprintf("storage %s has been provisioned", jaeger.Spec.Storage.Type))
+
+	if err := r.applyNetworkPolicies(ctx, jaeger, str.NetworkPolicies()); err != nil {
+		stepFailed(&jaeger, "network policies", err, v1.JaegerConditionCollectorAvailable, v1.JaegerConditionQueryAvailable)
 		return jaeger, tracing.HandleError(err, span)
 	}
 
@@ -343,17 +493,27 @@ func (r *ReconcileJaeger) apply(ctx context.Context, jaeger v1.Jaeger, str strat
 	// but some resources used by deployments are created by services, such as TLS certs
 	// for the oauth proxy, if one is used
 	if err := r.applyServices(ctx, jaeger, str.Services()); err != nil {
+		stepFailed(&jaeger, "services", err, v1.JaegerConditionCollectorAvailable, v1.JaegerConditionQueryAvailable)
 		return jaeger, tracing.HandleError(err, span)
 	}
 
 	if err := r.applyDeployments(ctx, jaeger, str.Deployments()); err != nil {
+		stepFailed(&jaeger, "deployments", err, v1.JaegerConditionCollectorAvailable, v1.JaegerConditionQueryAvailable)
+		return jaeger, tracing.HandleError(err, span)
+	}
+
+	if err := r.applyPodDisruptionBudgets(ctx, jaeger, str.PodDisruptionBudgets()); err != nil {
+		stepFailed(&jaeger, "pod disruption budgets", err, v1.JaegerConditionCollectorAvailable, v1.JaegerConditionQueryAvailable)
 		return jaeger, tracing.HandleError(err, span)
 	}
 
+	exposed := false
 	if autodetect.OperatorConfiguration.GetPlatform() == autodetect.OpenShiftPlatform {
 		if err := r.applyRoutes(ctx, jaeger, str.Routes()); err != nil {
+			stepFailed(&jaeger, "routes", err, v1.JaegerConditionIngressReady)
 			return jaeger, tracing.HandleError(err, span)
 		}
+		exposed = len(str.Routes()) > 0
 		routes := osv1.RouteList{}
 		err = r.rClient.List(ctx, &routes, client.InNamespace(jaeger.Namespace))
 		if err == nil {
@@ -371,8 +531,25 @@ func (r *ReconcileJaeger) apply(ctx context.Context, jaeger v1.Jaeger, str strat
 		}
 	} else {
 		if err := r.applyIngresses(ctx, jaeger, str.Ingresses()); err != nil {
+			stepFailed(&jaeger, "ingresses", err, v1.JaegerConditionIngressReady)
 			return jaeger, tracing.HandleError(err, span)
 		}
+		exposed = len(str.Ingresses()) > 0
+	}
+	if exposed {
+		setCondition(&jaeger, v1.JaegerConditionIngressReady, metav1.ConditionTrue, v1.JaegerReasonReconciled, "the query service has been exposed")
+	} else {
+		meta.RemoveStatusCondition(&jaeger.Status.Conditions, v1.JaegerConditionIngressReady)
+	}
+
+	if autodetect.OperatorConfiguration.IsKEDAIntegrationEnabled() {
+		if err := r.applyScaledObjects(ctx, jaeger, str.ScaledObjects()); err != nil {
+			// this shouldn't fail the whole reconciliation
+			jaeger.Logger().Error(
+				tracing.HandleError(err, span),
+				"failed to reconcile scaled objects",
+			)
+		}
 	}
 
 	if err := r.applyHorizontalPodAutoscalers(ctx, jaeger, str.HorizontalPodAutoscalers()); err != nil {
@@ -384,9 +561,32 @@ func (r *ReconcileJaeger) apply(ctx context.Context, jaeger v1.Jaeger, str strat
 		return jaeger, nil
 	}
 
+	if autodetect.OperatorConfiguration.IsPrometheusOperatorIntegrationEnabled() {
+		// the monitoring of the instance shouldn't fail the whole reconciliation either
+		if err := r.applyServiceMonitors(ctx, jaeger, str.ServiceMonitors()); err != nil {
+			jaeger.Logger().Error(
+				tracing.HandleError(err, span),
+				"failed to reconcile service monitors",
+			)
+		}
+		if err := r.applyPodMonitors(ctx, jaeger, str.PodMonitors()); err != nil {
+			jaeger.Logger().Error(
+				tracing.HandleError(err, span),
+				"failed to reconcile pod monitors",
+			)
+		}
+		if err := r.applyPrometheusRules(ctx, jaeger, str.PrometheusRules()); err != nil {
+			jaeger.Logger().Error(
+				tracing.HandleError(err, span),
+				"failed to reconcile prometheus rules",
+			)
+		}
+	}
+
 	// we apply the daemonsets after everything else, to increase the chances of having services and deployments
 	// ready by the time the daemonset is started, so that it gets at least one collector to connect to
 	if err := r.applyDaemonSets(ctx, jaeger, str.DaemonSets()); err != nil {
+		stepFailed(&jaeger, "daemon sets", err, v1.JaegerConditionCollectorAvailable)
 		return jaeger, tracing.HandleError(err, span)
 	}
 
@@ -403,76 +603,66 @@ func (r ReconcileJaeger) getSecretsForNamespace(secrets []corev1.Secret, namespa
 	return secretsForNamespace
 }
 
-// syncOnJaegerChanges sync deployments with sidecars when a jaeger CR changes
+// syncOnJaegerChanges sync the workloads with sidecars when a jaeger CR changes
 func syncOnJaegerChanges(rClient client.Reader, kclient client.Client, jaegerName string) error {
-	deps := []appsv1.Deployment{}
-	nssupdate := []corev1.Namespace{}
+	workloads := []*inject.ObjectWorkload{}
 	nss := map[string]corev1.Namespace{} // namespace cache
 
-	deployments := appsv1.DeploymentList{}
-
-	if namespaces := viper.GetString(v1.ConfigWatchNamespace); namespaces != v1.WatchAllNamespaces {
-		for _, ns := range strings.Split(namespaces, ",") {
-			nsDeps := &appsv1.DeploymentList{}
-			if err := rClient.List(context.Background(), nsDeps, client.InNamespace(ns)); err != nil {
-				return err
-			}
-			deployments.Items = append(deployments.Items, nsDeps.Items...)
-		}
-	} else {
-		err := rClient.List(context.Background(), &deployments)
+	err := forEachWatchedNamespace(func(opts ...client.ListOption) error {
+		nsWorkloads, err := inject.ListWorkloads(context.Background(), rClient, opts...)
 		if err != nil {
 			return err
 		}
+		workloads = append(workloads, nsWorkloads...)
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
-	for _, dep := range deployments.Items {
-		// if there's an assigned instance to this deployment, and it's not the one that triggered the current event,
+	updates := []*inject.ObjectWorkload{}
+	for _, w := range workloads {
+		// if there's an assigned instance to this workload, and it's not the one that triggered the current event,
 		// we don't need to trigger a reconciliation for it
-		if val, ok := dep.Labels[inject.Label]; ok && val != jaegerName {
+		if val, ok := w.Labels[inject.Label]; ok && val != jaegerName {
 			continue
 		}
 
-		// if the deployment has the sidecar annotation, trigger a deployment evaluation (webhook)
-		if _, ok := dep.Annotations[inject.Annotation]; ok {
-			inject.IncreaseRevision(dep.Annotations)
-			deps = append(deps, dep)
+		// if the workload has the sidecar annotation, trigger a workload evaluation (webhook)
+		if _, ok := w.Annotations[inject.Annotation]; ok {
+			inject.IncreaseRevision(w.Annotations)
+			updates = append(updates, w)
 			continue
 		}
 
 		// if we don't have the namespace in the cache yet, retrieve it
-		ns, ok := nss[dep.Namespace]
+		ns, ok := nss[w.Namespace]
 		if !ok {
-			err := rClient.Get(context.Background(), types.NamespacedName{Name: dep.Namespace}, &ns)
+			err := rClient.Get(context.Background(), types.NamespacedName{Name: w.Namespace}, &ns)
 			if err != nil {
 				continue
 			}
 			nss[ns.Name] = ns
 		}
 
-		// if the namespace has the sidecar annotation, trigger a deployment evaluation (webhook)
+		// if the namespace has the sidecar annotation, trigger a workload evaluation (webhook)
 		if _, ok := ns.Annotations[inject.Annotation]; ok {
-			inject.IncreaseRevision(dep.Annotations)
-			deps = append(deps, dep)
+			inject.IncreaseRevision(w.Annotations)
+			updates = append(updates, w)
 			continue
 		}
 	}
-	for i := range deps {
-		if err := kclient.Update(context.Background(), &deps[i]); err != nil {
-			log.Log.Error(
-				err,
-				"error while updating the dependency",
-				"component", "jaeger-cr-sync",
-			)
-			return err
+	for _, w := range updates {
+		obj, err := w.Object()
+		if err == nil {
+			err = kclient.Update(context.Background(), obj)
 		}
-	}
-	for i := range nssupdate {
-		if err := kclient.Update(context.Background(), &nssupdate[i]); err != nil {
+		if err != nil {
 			log.Log.Error(
 				err,
-				"error while upgrading the namespace",
+				"error while updating the dependency",
 				"component", "jaeger-cr-sync",
+				"kind", w.Kind,
 			)
 			return err
 		}
@@ -12,18 +12,26 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+	cassandrav1beta1 "github.com/jaegertracing/jaeger-operator/pkg/cassandra/v1beta1"
 	"github.com/jaegertracing/jaeger-operator/pkg/inject"
+	k8ssandrav1alpha1 "github.com/jaegertracing/jaeger-operator/pkg/k8ssandra/v1alpha1"
 	"github.com/jaegertracing/jaeger-operator/pkg/kafka/v1beta2"
+	kedav1alpha1 "github.com/jaegertracing/jaeger-operator/pkg/keda/v1alpha1"
+	monitoringv1 "github.com/jaegertracing/jaeger-operator/pkg/monitoring/v1"
 	"github.com/jaegertracing/jaeger-operator/pkg/strategy"
 )
 
@@ -195,6 +203,43 @@ func TestSyncOnJaegerChanges(t *testing.T) {
 	assert.Equal(t, errUpdate, err)
 }
 
+func TestSyncOnJaegerChangesOfEveryWorkloadKind(t *testing.T) {
+	// prepare
+	jaeger := v1.NewJaeger(types.NamespacedName{
+		Namespace: "observability",
+		Name:      "my-instance",
+	})
+
+	annotated := func(name string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   "my-ns",
+			Annotations: map[string]string{inject.Annotation: "true"},
+		}
+	}
+	objs := []client.Object{
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "my-ns"}},
+		&appsv1.StatefulSet{ObjectMeta: annotated("my-sts")},
+		&appsv1.DaemonSet{ObjectMeta: annotated("my-ds")},
+		&batchv1.CronJob{ObjectMeta: annotated("my-cj")},
+	}
+	cl := fake.NewClientBuilder().WithObjects(objs...).Build()
+
+	// test
+	err := syncOnJaegerChanges(cl, cl, jaeger.Name)
+
+	// verify
+	require.NoError(t, err)
+	for name, obj := range map[string]client.Object{
+		"my-sts": &appsv1.StatefulSet{},
+		"my-ds":  &appsv1.DaemonSet{},
+		"my-cj":  &batchv1.CronJob{},
+	} {
+		require.NoError(t, cl.Get(context.Background(), types.NamespacedName{Name: name, Namespace: "my-ns"}, obj))
+		assert.Equal(t, "0", obj.GetAnnotations()[inject.AnnotationRev], name)
+	}
+}
+
 func TestNewJaegerInstance(t *testing.T) {
 	// prepare
 	nsn := types.NamespacedName{
@@ -246,7 +291,7 @@ func TestDeletedInstance(t *testing.T) {
 	// no known objects
 	cl := fake.NewClientBuilder().Build()
 
-	r := &ReconcileJaeger{client: cl, scheme: s, rClient: cl}
+	r := &ReconcileJaeger{client: cl, scheme: s, rClient: cl, recorder: &record.FakeRecorder{}}
 
 	req := reconcile.Request{
 		NamespacedName: types.NamespacedName{
@@ -278,9 +323,13 @@ func TestSetOwnerOnNewInstance(t *testing.T) {
 
 	s := scheme.Scheme
 	s.AddKnownTypes(v1.GroupVersion, jaeger)
-	cl := fake.NewClientBuilder().WithStatusSubresource(jaeger).WithObjects(jaeger).Build()
+	cl := fake.NewClientBuilder().
+		WithStatusSubresource(jaeger).
+		WithObjects(jaeger).
+		WithInterceptorFuncs(interceptor.Funcs{Patch: fakeServerSideApply}).
+		Build()
 
-	r := &ReconcileJaeger{client: cl, scheme: s, rClient: cl}
+	r := &ReconcileJaeger{client: cl, scheme: s, rClient: cl, recorder: &record.FakeRecorder{}}
 	req := reconcile.Request{NamespacedName: nsn}
 
 	// test
@@ -308,7 +357,7 @@ func TestSkipOnNonOwnedCR(t *testing.T) {
 	s := scheme.Scheme
 	s.AddKnownTypes(v1.GroupVersion, jaeger)
 	cl := fake.NewClientBuilder().WithObjects(jaeger).Build()
-	r := &ReconcileJaeger{client: cl, scheme: s, rClient: cl}
+	r := &ReconcileJaeger{client: cl, scheme: s, rClient: cl, recorder: &record.FakeRecorder{}}
 	req := reconcile.Request{NamespacedName: nsn}
 
 	// test
@@ -340,7 +389,7 @@ func TestGetResourceFromNonCachedClient(t *testing.T) {
 	cachedClient := fake.NewClientBuilder().WithObjects(jaeger).Build()
 	client := fake.NewClientBuilder().Build()
 
-	r := &ReconcileJaeger{client: cachedClient, scheme: s, rClient: client}
+	r := &ReconcileJaeger{client: cachedClient, scheme: s, rClient: client, recorder: &record.FakeRecorder{}}
 	req := reconcile.Request{NamespacedName: nsn}
 
 	// test
@@ -383,7 +432,8 @@ func TestElasticsearchProvisioning(t *testing.T) {
 	req := reconcile.Request{NamespacedName: namespacedName}
 	result, err := reconciler.Reconcile(req)
 	require.NoError(t, err)
-	assert.Equal(t, reconcile.Result{}, result)
+	// the deployments never become ready in the fake cluster, so the instance stays pending
+	assert.Equal(t, reconcile.Result{RequeueAfter: pendingRequeueInterval}, result)
 
 	secrets := &corev1.SecretList{}
 	err = cl.List(context.Background(), secrets, client.InNamespace("jaeger"))
@@ -424,17 +474,58 @@ func getReconciler(objs []client.Object) (*ReconcileJaeger, client.Client) {
 	osconsolev1.Install(s)
 
 	// Jaeger
-	s.AddKnownTypes(v1.GroupVersion, &v1.Jaeger{})
+	s.AddKnownTypes(v1.GroupVersion, &v1.Jaeger{}, &v1.JaegerList{})
 
 	// Jaeger's Elasticsearch
 	s.AddKnownTypes(v1.GroupVersion, &esv1.Elasticsearch{}, &esv1.ElasticsearchList{})
 
 	// Kafka
-	s.AddKnownTypes(v1beta2.GroupVersion, &v1beta2.Kafka{}, &v1beta2.KafkaList{}, &v1beta2.KafkaUser{}, &v1beta2.KafkaUserList{})
+	s.AddKnownTypes(v1beta2.GroupVersion, &v1beta2.Kafka{}, &v1beta2.KafkaList{}, &v1beta2.KafkaUser{}, &v1beta2.KafkaUserList{}, &v1beta2.KafkaTopic{}, &v1beta2.KafkaTopicList{}, &v1beta2.KafkaNodePool{}, &v1beta2.KafkaNodePoolList{})
+
+	// Cassandra
+	s.AddKnownTypes(cassandrav1beta1.GroupVersion, &cassandrav1beta1.CassandraDatacenter{}, &cassandrav1beta1.CassandraDatacenterList{})
+	s.AddKnownTypes(k8ssandrav1alpha1.GroupVersion, &k8ssandrav1alpha1.K8ssandraCluster{}, &k8ssandrav1alpha1.K8ssandraClusterList{})
+
+	// KEDA
+	s.AddKnownTypes(kedav1alpha1.GroupVersion, &kedav1alpha1.ScaledObject{}, &kedav1alpha1.ScaledObjectList{})
+
+	// Argo Rollouts, for which we don't have the Go types
+	s.AddKnownTypeWithName(inject.RolloutGroupVersionKind, &unstructured.Unstructured{})
+	s.AddKnownTypeWithName(inject.RolloutGroupVersionKind.GroupVersion().WithKind("RolloutList"), &unstructured.UnstructuredList{})
+
+	// Prometheus Operator
+	s.AddKnownTypes(monitoringv1.GroupVersion,
+		&monitoringv1.ServiceMonitor{}, &monitoringv1.ServiceMonitorList{},
+		&monitoringv1.PodMonitor{}, &monitoringv1.PodMonitorList{},
+		&monitoringv1.PrometheusRule{}, &monitoringv1.PrometheusRuleList{},
+	)
 
-	cl := fake.NewClientBuilder().WithScheme(s).WithStatusSubresource(objs...).WithObjects(objs...).Build()
+	cl := fake.NewClientBuilder().
+		WithScheme(s).
+		WithStatusSubresource(objs...).
+		WithObjects(objs...).
+		WithInterceptorFuncs(interceptor.Funcs{Patch: fakeServerSideApply}).
+		Build()
 
-	r := New(cl, cl, s)
-	r.certGenerationScript = "../../../scripts/cert_generation.sh"
+	r := New(cl, cl, s, &record.FakeRecorder{})
 	return r, cl
 }
+
+// fakeServerSideApply emulates the server-side apply, which isn't supported by the fake client, by creating or
+// replacing the given object. Fields set by other managers are therefore not kept as the API server would do.
+func fakeServerSideApply(ctx context.Context, cl client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	if patch.Type() != types.ApplyPatchType {
+		return cl.Patch(ctx, obj, patch, opts...)
+	}
+
+	existing := obj.DeepCopyObject().(client.Object)
+	if err := cl.Get(ctx, client.ObjectKeyFromObject(obj), existing); err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+		return cl.Create(ctx, obj)
+	}
+
+	obj.SetResourceVersion(existing.GetResourceVersion())
+	return cl.Update(ctx, obj)
+}
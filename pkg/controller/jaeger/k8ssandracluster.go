@@ -0,0 +1,75 @@
+package jaeger
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/inventory"
+	k8ssandrav1alpha1 "github.com/jaegertracing/jaeger-operator/pkg/k8ssandra/v1alpha1"
+	"github.com/jaegertracing/jaeger-operator/pkg/tracing"
+)
+
+// applyK8ssandraClusters applies the Cassandra clusters provisioned via K8ssandra, which then creates their
+// datacenters. As for the datacenters provisioned via cass-operator, their readiness isn't awaited.
+func (r *ReconcileJaeger) applyK8ssandraClusters(ctx context.Context, jaeger v1.Jaeger, desired []k8ssandrav1alpha1.K8ssandraCluster) error {
+	tracer := otel.GetTracerProvider().Tracer(v1.ReconciliationTracer)
+	ctx, span := tracer.Start(ctx, "applyK8ssandraClusters")
+	defer span.End()
+
+	opts := []client.ListOption{
+		client.InNamespace(jaeger.Namespace),
+		client.MatchingLabels(map[string]string{
+			"app.kubernetes.io/instance":   jaeger.Name,
+			"app.kubernetes.io/managed-by": "jaeger-operator",
+		}),
+	}
+	list := &k8ssandrav1alpha1.K8ssandraClusterList{}
+	if err := r.rClient.List(ctx, list, opts...); err != nil {
+		return tracing.HandleError(err, span)
+	}
+
+	inv := inventory.ForK8ssandraClusters(list.Items, desired)
+	for i := range inv.Create {
+		d := inv.Create[i]
+		jaeger.Logger().V(-1).Info(
+			"creating k8ssandra cluster",
+			"k8ssandracluster", d.GetName(),
+			"namespace", d.GetNamespace(),
+		)
+		if err := r.serverSideApply(ctx, &d); err != nil {
+			return tracing.HandleError(err, span)
+		}
+		r.recorder.Eventf(&jaeger, corev1.EventTypeNormal, EventReasonCreated, "Created k8ssandra cluster %s", d.GetName())
+	}
+
+	for i := range inv.Update {
+		d := inv.Update[i]
+		jaeger.Logger().V(-1).Info(
+			"updating k8ssandra cluster",
+			"k8ssandracluster", d.GetName(),
+			"namespace", d.GetNamespace(),
+		)
+		if err := r.serverSideApply(ctx, &d); err != nil {
+			return tracing.HandleError(err, span)
+		}
+	}
+
+	for i := range inv.Delete {
+		d := inv.Delete[i]
+		jaeger.Logger().V(-1).Info(
+			"deleting k8ssandra cluster",
+			"k8ssandracluster", d.GetName(),
+			"namespace", d.GetNamespace(),
+		)
+		if err := r.client.Delete(ctx, &d); err != nil {
+			return tracing.HandleError(err, span)
+		}
+		r.recorder.Eventf(&jaeger, corev1.EventTypeNormal, EventReasonDeleted, "Deleted k8ssandra cluster %s", d.GetName())
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package jaeger
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/inventory"
+	kafkav1beta2 "github.com/jaegertracing/jaeger-operator/pkg/kafka/v1beta2"
+	"github.com/jaegertracing/jaeger-operator/pkg/tracing"
+)
+
+// applyKafkaNodePools applies the node pools of the provisioned Kafka clusters. They are applied before the clusters,
+// which don't get ready without them, and their readiness is covered by the clusters'.
+func (r *ReconcileJaeger) applyKafkaNodePools(ctx context.Context, jaeger v1.Jaeger, desired []kafkav1beta2.KafkaNodePool) error {
+	tracer := otel.GetTracerProvider().Tracer(v1.ReconciliationTracer)
+	ctx, span := tracer.Start(ctx, "applyKafkaNodePools")
+	defer span.End()
+
+	opts := []client.ListOption{
+		client.InNamespace(jaeger.Namespace),
+		client.MatchingLabels(map[string]string{
+			"app.kubernetes.io/instance":   jaeger.Name,
+			"app.kubernetes.io/managed-by": "jaeger-operator",
+		}),
+	}
+	list := &kafkav1beta2.KafkaNodePoolList{}
+	if err := r.rClient.List(ctx, list, opts...); err != nil {
+		return tracing.HandleError(err, span)
+	}
+
+	inv := inventory.ForKafkaNodePools(list.Items, desired)
+	for i := range inv.Create {
+		d := inv.Create[i]
+		jaeger.Logger().V(-1).Info(
+			"creating kafka node pool",
+			"kafka", d.GetName(),
+			"namespace", d.GetNamespace(),
+		)
+		if err := r.serverSideApply(ctx, &d); err != nil {
+			return tracing.HandleError(err, span)
+		}
+		r.recorder.Eventf(&jaeger, corev1.EventTypeNormal, EventReasonCreated, "Created kafka node pool %s", d.GetName())
+	}
+
+	for i := range inv.Update {
+		d := inv.Update[i]
+		jaeger.Logger().V(-1).Info(
+			"updating kafka node pool",
+			"kafka", d.GetName(),
+			"namespace", d.GetNamespace(),
+		)
+		if err := r.serverSideApply(ctx, &d); err != nil {
+			return tracing.HandleError(err, span)
+		}
+	}
+
+	for i := range inv.Delete {
+		d := inv.Delete[i]
+		jaeger.Logger().V(-1).Info(
+			"deleting kafka node pool",
+			"kafka", d.GetName(),
+			"namespace", d.GetNamespace(),
+		)
+		if err := r.client.Delete(ctx, &d); err != nil {
+			return tracing.HandleError(err, span)
+		}
+		r.recorder.Eventf(&jaeger, corev1.EventTypeNormal, EventReasonDeleted, "Deleted kafka node pool %s", d.GetName())
+	}
+
+	return nil
+}
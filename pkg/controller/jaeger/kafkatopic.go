@@ -0,0 +1,161 @@
+package jaeger
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/inventory"
+	kafkav1beta2 "github.com/jaegertracing/jaeger-operator/pkg/kafka/v1beta2"
+	"github.com/jaegertracing/jaeger-operator/pkg/tracing"
+)
+
+// ErrKafkaTopicRemoved is returned when a kafka topic existed but has been removed
+var ErrKafkaTopicRemoved = errors.New("kafka topic has been removed")
+
+func (r *ReconcileJaeger) applyKafkaTopics(ctx context.Context, jaeger v1.Jaeger, desired []kafkav1beta2.KafkaTopic) error {
+	tracer := otel.GetTracerProvider().Tracer(v1.ReconciliationTracer)
+	ctx, span := tracer.Start(ctx, "applyKafkaTopics")
+	defer span.End()
+
+	opts := []client.ListOption{
+		client.InNamespace(jaeger.Namespace),
+		client.MatchingLabels(map[string]string{
+			"app.kubernetes.io/instance":   jaeger.Name,
+			"app.kubernetes.io/managed-by": "jaeger-operator",
+		}),
+	}
+	list := &kafkav1beta2.KafkaTopicList{}
+	if err := r.rClient.List(ctx, list, opts...); err != nil {
+		return tracing.HandleError(err, span)
+	}
+
+	inv := inventory.ForKafkaTopics(list.Items, desired)
+	for i := range inv.Create {
+		d := inv.Create[i]
+		jaeger.Logger().V(-1).Info(
+			"creating kafka topics",
+			"kafka", d.GetName(),
+			"namespace", d.GetNamespace(),
+		)
+		if err := r.serverSideApply(ctx, &d); err != nil {
+			return tracing.HandleError(err, span)
+		}
+		r.recorder.Eventf(&jaeger, corev1.EventTypeNormal, EventReasonCreated, "Created kafka topic %s", d.GetName())
+	}
+
+	for i := range inv.Update {
+		d := inv.Update[i]
+		jaeger.Logger().V(-1).Info(
+			"updating kafka topic",
+			"kafka", d.GetName(),
+			"namespace", d.GetNamespace(),
+		)
+		if err := r.serverSideApply(ctx, &d); err != nil {
+			return tracing.HandleError(err, span)
+		}
+	}
+
+	// now, wait for all KafkaTopics to estabilize
+	for _, d := range inv.Create {
+		if err := r.waitForKafkaTopicStability(ctx, d); err != nil {
+			return tracing.HandleError(err, span)
+		}
+	}
+	for _, d := range inv.Update {
+		if err := r.waitForKafkaTopicStability(ctx, d); err != nil {
+			return tracing.HandleError(err, span)
+		}
+	}
+
+	for i := range inv.Delete {
+		d := inv.Delete[i]
+		jaeger.Logger().V(-1).Info(
+			"deleting kafka topic",
+			"kafka", d.GetName(),
+			"namespace", d.GetNamespace(),
+		)
+		if err := r.client.Delete(ctx, &d); err != nil {
+			return tracing.HandleError(err, span)
+		}
+		r.recorder.Eventf(&jaeger, corev1.EventTypeNormal, EventReasonDeleted, "Deleted kafka topic %s", d.GetName())
+	}
+
+	return nil
+}
+
+func (r *ReconcileJaeger) waitForKafkaTopicStability(ctx context.Context, kafkaTopic kafkav1beta2.KafkaTopic) error {
+	tracer := otel.GetTracerProvider().Tracer(v1.ReconciliationTracer)
+	ctx, span := tracer.Start(ctx, "waitForKafkaTopicStability")
+	defer span.End()
+
+	seen := false
+	once := &sync.Once{}
+	return wait.PollUntilContextTimeout(
+		ctx,
+		time.Second,
+		5*time.Minute,
+		true,
+		wait.ConditionWithContextFunc(
+			func(context.Context) (done bool, err error) {
+				k := &kafkav1beta2.KafkaTopic{}
+				if err := r.client.Get(ctx, types.NamespacedName{Name: kafkaTopic.GetName(), Namespace: kafkaTopic.GetNamespace()}, k); err != nil {
+					if k8serrors.IsNotFound(err) {
+						if seen {
+							// we have seen this object before, but it doesn't exist anymore!
+							// we don't have anything else to do here, break the poll
+							log.Log.V(1).Info(
+								"kafka topic has been removed.",
+								"namespace", kafkaTopic.GetNamespace(),
+								"name", kafkaTopic.GetName(),
+							)
+							return true, ErrKafkaTopicRemoved
+						}
+
+						// the object might have not been created yet
+						log.Log.V(-1).Info(
+							"kafka topic doesn't exist yet.",
+							"namespace", kafkaTopic.GetNamespace(),
+							"name", kafkaTopic.GetName(),
+						)
+						return false, nil
+					}
+					return false, tracing.HandleError(err, span)
+				}
+
+				seen = true
+				readyCondition := getReadyCondition(k.Status.Conditions)
+				if !strings.EqualFold(readyCondition.Status, "true") {
+					once.Do(func() {
+						log.Log.V(-1).Info(
+							"Waiting for kafka topic to stabilize",
+							"namespace", k.GetNamespace(),
+							"name", k.GetName(),
+							"conditionStatus", readyCondition.Status,
+							"conditionType", readyCondition.Type,
+						)
+					})
+					return false, nil
+				}
+
+				log.Log.V(-1).Info(
+					"kafka topic has stabilized",
+					"namespace", k.GetNamespace(),
+					"name", k.GetName(),
+					"conditionStatus", readyCondition.Status,
+					"conditionType", readyCondition.Type,
+				)
+				return true, nil
+			}))
+}
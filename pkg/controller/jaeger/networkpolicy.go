@@ -0,0 +1,73 @@
+package jaeger
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/inventory"
+	"github.com/jaegertracing/jaeger-operator/pkg/tracing"
+)
+
+func (r *ReconcileJaeger) applyNetworkPolicies(ctx context.Context, jaeger v1.Jaeger, desired []networkingv1.NetworkPolicy) error {
+	tracer := otel.GetTracerProvider().Tracer(v1.ReconciliationTracer)
+	ctx, span := tracer.Start(ctx, "applyNetworkPolicies")
+	defer span.End()
+
+	opts := []client.ListOption{
+		client.InNamespace(jaeger.Namespace),
+		client.MatchingLabels(map[string]string{
+			"app.kubernetes.io/instance":   jaeger.Name,
+			"app.kubernetes.io/managed-by": "jaeger-operator",
+		}),
+	}
+	list := &networkingv1.NetworkPolicyList{}
+	if err := r.rClient.List(ctx, list, opts...); err != nil {
+		return tracing.HandleError(err, span)
+	}
+
+	inv := inventory.ForNetworkPolicies(list.Items, desired)
+	for i := range inv.Create {
+		d := inv.Create[i]
+		jaeger.Logger().V(-1).Info(
+			"creating network policy",
+			"networkpolicy", d.Name,
+			"namespace", d.Namespace,
+		)
+		if err := r.serverSideApply(ctx, &d); err != nil {
+			return tracing.HandleError(err, span)
+		}
+		r.recorder.Eventf(&jaeger, corev1.EventTypeNormal, EventReasonCreated, "Created network policy %s", d.GetName())
+	}
+
+	for i := range inv.Update {
+		d := inv.Update[i]
+		jaeger.Logger().V(-1).Info(
+			"updating network policy",
+			"networkpolicy", d.Name,
+			"namespace", d.Namespace,
+		)
+		if err := r.serverSideApply(ctx, &d); err != nil {
+			return tracing.HandleError(err, span)
+		}
+	}
+
+	for i := range inv.Delete {
+		d := inv.Delete[i]
+		jaeger.Logger().V(-1).Info(
+			"deleting network policy",
+			"networkpolicy", d.Name,
+			"namespace", d.Namespace,
+		)
+		if err := r.client.Delete(ctx, &d); err != nil {
+			return tracing.HandleError(err, span)
+		}
+		r.recorder.Eventf(&jaeger, corev1.EventTypeNormal, EventReasonDeleted, "Deleted network policy %s", d.GetName())
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package jaeger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	networkingv1 "k8s.io/api/networking/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/strategy"
+)
+
+func TestNetworkPolicyCreate(t *testing.T) {
+	// prepare
+	nsn := types.NamespacedName{
+		Name:      "TestNetworkPolicyCreate",
+		Namespace: "tenant1",
+	}
+
+	objs := []client.Object{
+		v1.NewJaeger(nsn),
+	}
+
+	r, cl := getReconciler(objs)
+	r.strategyChooser = func(ctx context.Context, jaeger *v1.Jaeger) strategy.S {
+		return strategy.New().WithNetworkPolicies([]networkingv1.NetworkPolicy{{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      nsn.Name,
+				Namespace: nsn.Namespace,
+			},
+		}})
+	}
+
+	// test
+	res, err := r.Reconcile(reconcile.Request{NamespacedName: nsn})
+
+	// verify
+	require.NoError(t, err)
+	assert.False(t, res.Requeue, "We don't requeue for now")
+
+	persisted := &networkingv1.NetworkPolicy{}
+	err = cl.Get(context.Background(), nsn, persisted)
+	require.NoError(t, err)
+	assert.Equal(t, nsn.Name, persisted.Name)
+}
+
+func TestNetworkPolicyUpdate(t *testing.T) {
+	// prepare
+	nsn := types.NamespacedName{
+		Name:      "TestNetworkPolicyUpdate",
+		Namespace: "tenant1",
+	}
+
+	orig := networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      nsn.Name,
+			Namespace: nsn.Namespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/instance":   nsn.Name,
+				"app.kubernetes.io/managed-by": "jaeger-operator",
+			},
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+		},
+	}
+
+	objs := []client.Object{
+		v1.NewJaeger(nsn),
+		&orig,
+	}
+
+	r, cl := getReconciler(objs)
+	r.strategyChooser = func(ctx context.Context, jaeger *v1.Jaeger) strategy.S {
+		updated := *orig.DeepCopy()
+		updated.Spec.PolicyTypes = []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress}
+		return strategy.New().WithNetworkPolicies([]networkingv1.NetworkPolicy{updated})
+	}
+
+	// test
+	_, err := r.Reconcile(reconcile.Request{NamespacedName: nsn})
+	require.NoError(t, err)
+
+	// verify
+	persisted := &networkingv1.NetworkPolicy{}
+	err = cl.Get(context.Background(), nsn, persisted)
+	require.NoError(t, err)
+	assert.Equal(t, []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress}, persisted.Spec.PolicyTypes)
+}
+
+func TestNetworkPolicyDelete(t *testing.T) {
+	// prepare
+	nsn := types.NamespacedName{
+		Name:      "TestNetworkPolicyDelete",
+		Namespace: "tenant1",
+	}
+
+	orig := networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      nsn.Name,
+			Namespace: nsn.Namespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/instance":   nsn.Name,
+				"app.kubernetes.io/managed-by": "jaeger-operator",
+			},
+		},
+	}
+
+	objs := []client.Object{
+		v1.NewJaeger(nsn),
+		&orig,
+	}
+
+	r, cl := getReconciler(objs)
+	r.strategyChooser = func(ctx context.Context, jaeger *v1.Jaeger) strategy.S {
+		return *strategy.New()
+	}
+
+	// test
+	_, err := r.Reconcile(reconcile.Request{NamespacedName: nsn})
+	require.NoError(t, err)
+
+	// verify
+	persisted := &networkingv1.NetworkPolicy{}
+	err = cl.Get(context.Background(), nsn, persisted)
+	assert.True(t, k8serrors.IsNotFound(err))
+}
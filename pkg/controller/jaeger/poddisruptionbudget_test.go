@@ -0,0 +1,138 @@
+package jaeger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	policyv1 "k8s.io/api/policy/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/strategy"
+)
+
+func TestPodDisruptionBudgetCreate(t *testing.T) {
+	// prepare
+	nsn := types.NamespacedName{
+		Name:      "TestPodDisruptionBudgetCreate",
+		Namespace: "tenant1",
+	}
+
+	objs := []client.Object{
+		v1.NewJaeger(nsn),
+	}
+
+	r, cl := getReconciler(objs)
+	r.strategyChooser = func(ctx context.Context, jaeger *v1.Jaeger) strategy.S {
+		return strategy.New().WithPodDisruptionBudgets([]policyv1.PodDisruptionBudget{{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      nsn.Name,
+				Namespace: nsn.Namespace,
+			},
+		}})
+	}
+
+	// test
+	res, err := r.Reconcile(reconcile.Request{NamespacedName: nsn})
+
+	// verify
+	require.NoError(t, err)
+	assert.False(t, res.Requeue, "We don't requeue for now")
+
+	persisted := &policyv1.PodDisruptionBudget{}
+	err = cl.Get(context.Background(), nsn, persisted)
+	require.NoError(t, err)
+	assert.Equal(t, nsn.Name, persisted.Name)
+}
+
+func TestPodDisruptionBudgetUpdate(t *testing.T) {
+	// prepare
+	nsn := types.NamespacedName{
+		Name:      "TestPodDisruptionBudgetUpdate",
+		Namespace: "tenant1",
+	}
+
+	one := intstr.FromInt32(1)
+	orig := policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      nsn.Name,
+			Namespace: nsn.Namespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/instance":   nsn.Name,
+				"app.kubernetes.io/managed-by": "jaeger-operator",
+			},
+		},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			MaxUnavailable: &one,
+		},
+	}
+
+	objs := []client.Object{
+		v1.NewJaeger(nsn),
+		&orig,
+	}
+
+	r, cl := getReconciler(objs)
+	r.strategyChooser = func(ctx context.Context, jaeger *v1.Jaeger) strategy.S {
+		half := intstr.FromString("50%")
+		updated := *orig.DeepCopy()
+		updated.Spec = policyv1.PodDisruptionBudgetSpec{MinAvailable: &half}
+		return strategy.New().WithPodDisruptionBudgets([]policyv1.PodDisruptionBudget{updated})
+	}
+
+	// test
+	_, err := r.Reconcile(reconcile.Request{NamespacedName: nsn})
+	require.NoError(t, err)
+
+	// verify
+	persisted := &policyv1.PodDisruptionBudget{}
+	err = cl.Get(context.Background(), nsn, persisted)
+	require.NoError(t, err)
+	assert.Equal(t, "50%", persisted.Spec.MinAvailable.String())
+	assert.Nil(t, persisted.Spec.MaxUnavailable)
+}
+
+func TestPodDisruptionBudgetDelete(t *testing.T) {
+	// prepare
+	nsn := types.NamespacedName{
+		Name:      "TestPodDisruptionBudgetDelete",
+		Namespace: "tenant1",
+	}
+
+	orig := policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      nsn.Name,
+			Namespace: nsn.Namespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/instance":   nsn.Name,
+				"app.kubernetes.io/managed-by": "jaeger-operator",
+			},
+		},
+	}
+
+	objs := []client.Object{
+		v1.NewJaeger(nsn),
+		&orig,
+	}
+
+	r, cl := getReconciler(objs)
+	r.strategyChooser = func(ctx context.Context, jaeger *v1.Jaeger) strategy.S {
+		return *strategy.New()
+	}
+
+	// test
+	_, err := r.Reconcile(reconcile.Request{NamespacedName: nsn})
+	require.NoError(t, err)
+
+	// verify
+	persisted := &policyv1.PodDisruptionBudget{}
+	err = cl.Get(context.Background(), nsn, persisted)
+	assert.True(t, k8serrors.IsNotFound(err))
+}
@@ -0,0 +1,143 @@
+package jaeger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/autodetect"
+	monitoringv1 "github.com/jaegertracing/jaeger-operator/pkg/monitoring/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/strategy"
+)
+
+func TestPodMonitorCreate(t *testing.T) {
+	// prepare
+	autodetect.OperatorConfiguration.SetPrometheusOperatorIntegration(autodetect.PrometheusOperatorIntegrationYes)
+	defer viper.Reset()
+
+	nsn := types.NamespacedName{
+		Name:      "TestPodMonitorCreate",
+		Namespace: "tenant1",
+	}
+
+	objs := []client.Object{
+		v1.NewJaeger(nsn),
+	}
+
+	r, cl := getReconciler(objs)
+	r.strategyChooser = func(ctx context.Context, jaeger *v1.Jaeger) strategy.S {
+		return strategy.New().WithPodMonitors([]monitoringv1.PodMonitor{{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      nsn.Name,
+				Namespace: nsn.Namespace,
+			},
+		}})
+	}
+
+	// test
+	res, err := r.Reconcile(reconcile.Request{NamespacedName: nsn})
+
+	// verify
+	require.NoError(t, err)
+	assert.False(t, res.Requeue, "We don't requeue for now")
+
+	persisted := &monitoringv1.PodMonitor{}
+	err = cl.Get(context.Background(), nsn, persisted)
+	require.NoError(t, err)
+	assert.Equal(t, nsn.Name, persisted.Name)
+}
+
+func TestPodMonitorUpdate(t *testing.T) {
+	// prepare
+	autodetect.OperatorConfiguration.SetPrometheusOperatorIntegration(autodetect.PrometheusOperatorIntegrationYes)
+	defer viper.Reset()
+
+	nsn := types.NamespacedName{
+		Name:      "TestPodMonitorUpdate",
+		Namespace: "tenant1",
+	}
+
+	orig := monitoringv1.PodMonitor{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        nsn.Name,
+			Namespace:   nsn.Namespace,
+			Annotations: map[string]string{"key": "value"},
+			Labels: map[string]string{
+				"app.kubernetes.io/instance":   nsn.Name,
+				"app.kubernetes.io/managed-by": "jaeger-operator",
+			},
+		},
+	}
+
+	objs := []client.Object{
+		v1.NewJaeger(nsn),
+		&orig,
+	}
+
+	r, cl := getReconciler(objs)
+	r.strategyChooser = func(ctx context.Context, jaeger *v1.Jaeger) strategy.S {
+		updated := *orig.DeepCopy()
+		updated.Annotations = map[string]string{"key": "new-value"}
+		return strategy.New().WithPodMonitors([]monitoringv1.PodMonitor{updated})
+	}
+
+	// test
+	_, err := r.Reconcile(reconcile.Request{NamespacedName: nsn})
+	require.NoError(t, err)
+
+	// verify
+	persisted := &monitoringv1.PodMonitor{}
+	err = cl.Get(context.Background(), nsn, persisted)
+	require.NoError(t, err)
+	assert.Equal(t, "new-value", persisted.Annotations["key"])
+}
+
+func TestPodMonitorDelete(t *testing.T) {
+	// prepare
+	autodetect.OperatorConfiguration.SetPrometheusOperatorIntegration(autodetect.PrometheusOperatorIntegrationYes)
+	defer viper.Reset()
+
+	nsn := types.NamespacedName{
+		Name:      "TestPodMonitorDelete",
+		Namespace: "tenant1",
+	}
+
+	orig := monitoringv1.PodMonitor{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      nsn.Name,
+			Namespace: nsn.Namespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/instance":   nsn.Name,
+				"app.kubernetes.io/managed-by": "jaeger-operator",
+			},
+		},
+	}
+
+	objs := []client.Object{
+		v1.NewJaeger(nsn),
+		&orig,
+	}
+
+	r, cl := getReconciler(objs)
+	r.strategyChooser = func(ctx context.Context, jaeger *v1.Jaeger) strategy.S {
+		return *strategy.New()
+	}
+
+	// test
+	_, err := r.Reconcile(reconcile.Request{NamespacedName: nsn})
+	require.NoError(t, err)
+
+	// verify
+	persisted := &monitoringv1.PodMonitor{}
+	err = cl.Get(context.Background(), nsn, persisted)
+	assert.True(t, k8serrors.IsNotFound(err))
+}
@@ -5,6 +5,7 @@ import (
 
 	osv1 "github.com/openshift/api/route/v1"
 	"go.opentelemetry.io/otel"
+	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
@@ -37,9 +38,10 @@ func (r *ReconcileJaeger) applyRoutes(ctx context.Context, jaeger v1.Jaeger, des
 			"route", d.Name,
 			"namespace", d.Namespace,
 		)
-		if err := r.client.Create(ctx, &d); err != nil {
+		if err := r.serverSideApply(ctx, &d); err != nil {
 			return tracing.HandleError(err, span)
 		}
+		r.recorder.Eventf(&jaeger, corev1.EventTypeNormal, EventReasonCreated, "Created route %s", d.GetName())
 	}
 
 	for i := range inv.Update {
@@ -49,7 +51,7 @@ func (r *ReconcileJaeger) applyRoutes(ctx context.Context, jaeger v1.Jaeger, des
 			"route", d.Name,
 			"namespace", d.Namespace,
 		)
-		if err := r.client.Update(ctx, &d); err != nil {
+		if err := r.serverSideApply(ctx, &d); err != nil {
 			return tracing.HandleError(err, span)
 		}
 	}
@@ -64,6 +66,7 @@ func (r *ReconcileJaeger) applyRoutes(ctx context.Context, jaeger v1.Jaeger, des
 		if err := r.client.Delete(ctx, &d); err != nil {
 			return tracing.HandleError(err, span)
 		}
+		r.recorder.Eventf(&jaeger, corev1.EventTypeNormal, EventReasonDeleted, "Deleted route %s", d.GetName())
 	}
 
 	return nil
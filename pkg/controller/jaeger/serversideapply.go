@@ -0,0 +1,108 @@
+package jaeger
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/csaupgrade"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+)
+
+// fieldOwner is the field manager the operator applies its objects with, so that the API server tracks which
+// fields are owned by the operator and which ones were set by other controllers, such as service meshes
+const fieldOwner = client.FieldOwner(v1.FieldManager)
+
+// updateManagers are the field managers the operator used to create and update its objects with, before applying
+// them: the operator's binary name, which the API server derives from the user agent
+var updateManagers = sets.New(v1.FieldManager)
+
+// serverSideApply creates or updates the given object with server-side apply. The operator owns only the fields set on the
+// given object: fields set by others are left alone, and the operator wins the conflicts over the fields it sets.
+func (r *ReconcileJaeger) serverSideApply(ctx context.Context, obj client.Object) error {
+	// apply requests need the type information, which isn't always set on the typed objects
+	gvk, err := apiutil.GVKForObject(obj, r.scheme)
+	if err != nil {
+		return err
+	}
+	obj.GetObjectKind().SetGroupVersionKind(gvk)
+
+	if err := r.upgradeManagedFields(ctx, obj); err != nil {
+		return err
+	}
+
+	// the server rejects apply requests carrying managed fields, and the resource version would turn into a
+	// precondition for the request
+	obj.SetManagedFields(nil)
+	obj.SetResourceVersion("")
+
+	return r.client.Patch(ctx, obj, client.Apply, fieldOwner, client.ForceOwnership)
+}
+
+// upgradeManagedFields hands the fields the operator owns through its former updates of the existing object over to
+// its apply requests. Otherwise, the fields the operator no longer sets wouldn't be removed by the next apply, as they
+// would still be owned by the update manager. This is done once per object, on its first apply.
+func (r *ReconcileJaeger) upgradeManagedFields(ctx context.Context, obj client.Object) error {
+	key := fmt.Sprintf("%s/%s", obj.GetObjectKind().GroupVersionKind().GroupKind(), client.ObjectKeyFromObject(obj))
+	if r.upgradedObjects.has(key) {
+		return nil
+	}
+
+	existing := obj.DeepCopyObject().(client.Object)
+	if err := r.rClient.Get(ctx, client.ObjectKeyFromObject(obj), existing); err != nil {
+		if k8serrors.IsNotFound(err) {
+			// the object is created by the apply request, with the fields owned by the operator's apply manager
+			r.upgradedObjects.add(key)
+			return nil
+		}
+		return err
+	}
+
+	patch, err := csaupgrade.UpgradeManagedFieldsPatch(existing, updateManagers, v1.FieldManager)
+	if err != nil {
+		return err
+	}
+	if patch != nil {
+		if err := r.client.Patch(ctx, existing, client.RawPatch(types.JSONPatchType, patch)); err != nil {
+			return err
+		}
+	}
+
+	r.upgradedObjects.add(key)
+	return nil
+}
+
+// objectSet is a set of object keys, safe for concurrent use. A nil set is always empty.
+type objectSet struct {
+	mu   sync.RWMutex
+	keys map[string]struct{}
+}
+
+func newObjectSet() *objectSet {
+	return &objectSet{keys: map[string]struct{}{}}
+}
+
+func (s *objectSet) has(key string) bool {
+	if s == nil {
+		return false
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	_, ok := s.keys[key]
+	return ok
+}
+
+func (s *objectSet) add(key string) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys[key] = struct{}{}
+}
@@ -0,0 +1,106 @@
+package jaeger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func TestServerSideApply(t *testing.T) {
+	// prepare
+	var patchType types.PatchType
+	patchOptions := &client.PatchOptions{}
+	var applied client.Object
+	cl := fake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+		Patch: func(ctx context.Context, cl client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+			patchType = patch.Type()
+			patchOptions.ApplyOptions(opts)
+			applied = obj
+			return nil
+		},
+	}).Build()
+	r := New(cl, cl, scheme.Scheme, &record.FakeRecorder{})
+
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "my-service",
+			Namespace:       "observability",
+			ResourceVersion: "42",
+			ManagedFields:   []metav1.ManagedFieldsEntry{{Manager: "someone-else"}},
+		},
+	}
+
+	// test
+	err := r.serverSideApply(context.Background(), svc)
+
+	// verify
+	require.NoError(t, err)
+	assert.Equal(t, types.ApplyPatchType, patchType)
+	assert.Equal(t, "jaeger-operator", patchOptions.FieldManager)
+	require.NotNil(t, patchOptions.Force)
+	assert.True(t, *patchOptions.Force)
+
+	assert.Equal(t, svc, applied)
+	assert.Equal(t, "v1", applied.GetObjectKind().GroupVersionKind().Version)
+	assert.Equal(t, "Service", applied.GetObjectKind().GroupVersionKind().Kind)
+	assert.Empty(t, applied.GetResourceVersion())
+	assert.Empty(t, applied.GetManagedFields())
+}
+
+func TestServerSideApplyUpgradesManagedFields(t *testing.T) {
+	// prepare
+	existing := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-service",
+			Namespace: "observability",
+			Labels:    map[string]string{"stale": "true"},
+			ManagedFields: []metav1.ManagedFieldsEntry{{
+				Manager:    "jaeger-operator",
+				Operation:  metav1.ManagedFieldsOperationUpdate,
+				APIVersion: "v1",
+				FieldsType: "FieldsV1",
+				FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:metadata":{"f:labels":{"f:stale":{}}}}`)},
+			}},
+		},
+	}
+	var patches []types.PatchType
+	gets := 0
+	cl := fake.NewClientBuilder().WithObjects(existing).WithInterceptorFuncs(interceptor.Funcs{
+		Get: func(ctx context.Context, cl client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+			gets++
+			return cl.Get(ctx, key, obj, opts...)
+		},
+		Patch: func(ctx context.Context, cl client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+			patches = append(patches, patch.Type())
+			if patch.Type() == types.ApplyPatchType {
+				return nil
+			}
+			return cl.Patch(ctx, obj, patch, opts...)
+		},
+	}).Build()
+	r := New(cl, cl, scheme.Scheme, &record.FakeRecorder{})
+
+	// test
+	require.NoError(t, r.serverSideApply(context.Background(), &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "my-service", Namespace: "observability"}}))
+	require.NoError(t, r.serverSideApply(context.Background(), &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: "my-service", Namespace: "observability"}}))
+
+	// verify
+	assert.Equal(t, []types.PatchType{types.JSONPatchType, types.ApplyPatchType, types.ApplyPatchType}, patches)
+	assert.Equal(t, 1, gets, "the managed fields are upgraded on the first apply only")
+
+	persisted := &corev1.Service{}
+	require.NoError(t, cl.Get(context.Background(), client.ObjectKeyFromObject(existing), persisted))
+	require.Len(t, persisted.ManagedFields, 1)
+	assert.Equal(t, "jaeger-operator", persisted.ManagedFields[0].Manager)
+	assert.Equal(t, metav1.ManagedFieldsOperationApply, persisted.ManagedFields[0].Operation)
+}
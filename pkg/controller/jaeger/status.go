@@ -0,0 +1,139 @@
+package jaeger
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"go.opentelemetry.io/otel"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/tracing"
+)
+
+// observeWorkloads records the state of the deployments and daemon sets backing the instance into its status,
+// setting the availability conditions for the collector and query
+func (r *ReconcileJaeger) observeWorkloads(ctx context.Context, jaeger *v1.Jaeger) error {
+	tracer := otel.GetTracerProvider().Tracer(v1.ReconciliationTracer)
+	ctx, span := tracer.Start(ctx, "observeWorkloads")
+	defer span.End()
+
+	opts := []client.ListOption{
+		client.InNamespace(jaeger.Namespace),
+		client.MatchingLabels(map[string]string{
+			"app.kubernetes.io/instance":   jaeger.Name,
+			"app.kubernetes.io/managed-by": "jaeger-operator",
+		}),
+	}
+	depList := &appsv1.DeploymentList{}
+	if err := r.rClient.List(ctx, depList, opts...); err != nil {
+		return tracing.HandleError(err, span)
+	}
+	dsList := &appsv1.DaemonSetList{}
+	if err := r.rClient.List(ctx, dsList, opts...); err != nil {
+		return tracing.HandleError(err, span)
+	}
+	svcList := &corev1.ServiceList{}
+	if err := r.rClient.List(ctx, svcList, opts...); err != nil {
+		return tracing.HandleError(err, span)
+	}
+
+	var components []v1.JaegerComponentStatus
+	for _, dep := range depList.Items {
+		desired := int32(1)
+		if dep.Spec.Replicas != nil {
+			desired = *dep.Spec.Replicas
+		}
+		components = append(components, v1.JaegerComponentStatus{
+			Name:            dep.Labels["app.kubernetes.io/component"],
+			Kind:            "Deployment",
+			Image:           mainImage(dep.Spec.Template.Spec),
+			DesiredReplicas: desired,
+			ReadyReplicas:   dep.Status.ReadyReplicas,
+			Endpoints:       endpointsFor(dep.Spec.Template.Labels, svcList.Items),
+		})
+	}
+	for _, ds := range dsList.Items {
+		components = append(components, v1.JaegerComponentStatus{
+			Name:            ds.Labels["app.kubernetes.io/component"],
+			Kind:            "DaemonSet",
+			Image:           mainImage(ds.Spec.Template.Spec),
+			DesiredReplicas: ds.Status.DesiredNumberScheduled,
+			ReadyReplicas:   ds.Status.NumberReady,
+			Endpoints:       endpointsFor(ds.Spec.Template.Labels, svcList.Items),
+		})
+	}
+	sort.Slice(components, func(i, j int) bool {
+		return components[i].Name < components[j].Name
+	})
+
+	ready := 0
+	for _, c := range components {
+		if c.Ready() {
+			ready++
+		}
+	}
+	jaeger.Status.Components = components
+	jaeger.Status.ComponentsReady = fmt.Sprintf("%d/%d", ready, len(components))
+
+	availability := map[string][]string{
+		v1.JaegerConditionCollectorAvailable: {"collector", "all-in-one", "otel-collector"},
+		v1.JaegerConditionQueryAvailable:     {"query", "all-in-one", "otel-collector"},
+	}
+	for conditionType, names := range availability {
+		for _, dep := range depList.Items {
+			if !slices.Contains(names, dep.Labels["app.kubernetes.io/component"]) {
+				continue
+			}
+
+			if available, message := deploymentAvailable(dep); available {
+				setCondition(jaeger, conditionType, metav1.ConditionTrue, v1.JaegerReasonMinimumReplicasAvailable, message)
+			} else {
+				setCondition(jaeger, conditionType, metav1.ConditionFalse, v1.JaegerReasonMinimumReplicasUnavailable, message)
+			}
+			break
+		}
+	}
+
+	return nil
+}
+
+// phaseFor returns the phase for an instance that has been successfully reconciled: it's only running once all
+// of its components are ready
+func phaseFor(status v1.JaegerStatus) v1.JaegerPhase {
+	for _, c := range status.Components {
+		if !c.Ready() {
+			return v1.JaegerPhasePending
+		}
+	}
+	return v1.JaegerPhaseRunning
+}
+
+// mainImage returns the image of the first container, which is the Jaeger one for all the workloads we create
+func mainImage(spec corev1.PodSpec) string {
+	if len(spec.Containers) == 0 {
+		return ""
+	}
+	return spec.Containers[0].Image
+}
+
+// endpointsFor returns the host:port pairs for the services selecting pods with the given labels
+func endpointsFor(podLabels map[string]string, services []corev1.Service) []string {
+	var endpoints []string
+	for _, svc := range services {
+		if len(svc.Spec.Selector) == 0 || !labels.SelectorFromSet(svc.Spec.Selector).Matches(labels.Set(podLabels)) {
+			continue
+		}
+		for _, port := range svc.Spec.Ports {
+			endpoints = append(endpoints, fmt.Sprintf("%s.%s.svc:%d", svc.Name, svc.Namespace, port.Port))
+		}
+	}
+	sort.Strings(endpoints)
+	return endpoints
+}
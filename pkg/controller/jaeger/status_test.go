@@ -0,0 +1,128 @@
+package jaeger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/strategy"
+)
+
+func TestObserveWorkloads(t *testing.T) {
+	// prepare
+	nsn := types.NamespacedName{Name: "TestObserveWorkloads", Namespace: "tenant1"}
+	jaeger := v1.NewJaeger(nsn)
+
+	labels := func(component string) map[string]string {
+		return map[string]string{
+			"app.kubernetes.io/instance":   nsn.Name,
+			"app.kubernetes.io/managed-by": "jaeger-operator",
+			"app.kubernetes.io/component":  component,
+		}
+	}
+	replicas := int32(2)
+	objs := []client.Object{
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "collector", Namespace: nsn.Namespace, Labels: labels("collector")},
+			Spec: appsv1.DeploymentSpec{
+				Replicas: &replicas,
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: labels("collector")},
+					Spec:       corev1.PodSpec{Containers: []corev1.Container{{Image: "jaegertracing/jaeger-collector:1.65.0"}}},
+				},
+			},
+			Status: appsv1.DeploymentStatus{ReadyReplicas: 2},
+		},
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "query", Namespace: nsn.Namespace, Labels: labels("query")},
+		},
+		&appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "agent", Namespace: nsn.Namespace, Labels: labels("agent")},
+			Status:     appsv1.DaemonSetStatus{DesiredNumberScheduled: 3, NumberReady: 3},
+		},
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "collector", Namespace: nsn.Namespace, Labels: labels("service-collector")},
+			Spec: corev1.ServiceSpec{
+				Selector: labels("collector"),
+				Ports:    []corev1.ServicePort{{Port: 14250}, {Port: 4317}},
+			},
+		},
+	}
+	r, _ := getReconciler(objs)
+
+	// test
+	err := r.observeWorkloads(context.Background(), jaeger)
+
+	// verify
+	require.NoError(t, err)
+	assert.Equal(t, []v1.JaegerComponentStatus{
+		{Name: "agent", Kind: "DaemonSet", DesiredReplicas: 3, ReadyReplicas: 3},
+		{
+			Name:            "collector",
+			Kind:            "Deployment",
+			Image:           "jaegertracing/jaeger-collector:1.65.0",
+			DesiredReplicas: 2,
+			ReadyReplicas:   2,
+			Endpoints:       []string{"collector.tenant1.svc:14250", "collector.tenant1.svc:4317"},
+		},
+		{Name: "query", Kind: "Deployment", DesiredReplicas: 1},
+	}, jaeger.Status.Components)
+	assert.Equal(t, "2/3", jaeger.Status.ComponentsReady)
+
+	assert.True(t, meta.IsStatusConditionTrue(jaeger.Status.Conditions, v1.JaegerConditionCollectorAvailable))
+	assert.True(t, meta.IsStatusConditionFalse(jaeger.Status.Conditions, v1.JaegerConditionQueryAvailable))
+	assert.Equal(t, v1.JaegerReasonMinimumReplicasUnavailable, meta.FindStatusCondition(jaeger.Status.Conditions, v1.JaegerConditionQueryAvailable).Reason)
+}
+
+func TestPhaseFor(t *testing.T) {
+	assert.Equal(t, v1.JaegerPhaseRunning, phaseFor(v1.JaegerStatus{}))
+	assert.Equal(t, v1.JaegerPhaseRunning, phaseFor(v1.JaegerStatus{Components: []v1.JaegerComponentStatus{
+		{Name: "collector", DesiredReplicas: 2, ReadyReplicas: 2},
+	}}))
+	assert.Equal(t, v1.JaegerPhasePending, phaseFor(v1.JaegerStatus{Components: []v1.JaegerComponentStatus{
+		{Name: "collector", DesiredReplicas: 2, ReadyReplicas: 2},
+		{Name: "query", DesiredReplicas: 1, ReadyReplicas: 0},
+	}}))
+}
+
+func TestPendingUntilWorkloadsAreReady(t *testing.T) {
+	// prepare
+	nsn := types.NamespacedName{Name: "TestPendingUntilWorkloadsAreReady", Namespace: "tenant1"}
+	r, cl := getReconciler([]client.Object{v1.NewJaeger(nsn)})
+	r.strategyChooser = func(ctx context.Context, jaeger *v1.Jaeger) strategy.S {
+		return strategy.New().WithDeployments([]appsv1.Deployment{{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      nsn.Name,
+				Namespace: nsn.Namespace,
+				Labels: map[string]string{
+					"app.kubernetes.io/instance":   nsn.Name,
+					"app.kubernetes.io/managed-by": "jaeger-operator",
+					"app.kubernetes.io/component":  "all-in-one",
+				},
+			},
+		}})
+	}
+
+	// test
+	res, err := r.Reconcile(reconcile.Request{NamespacedName: nsn})
+
+	// verify
+	require.NoError(t, err)
+	assert.Equal(t, pendingRequeueInterval, res.RequeueAfter)
+
+	persisted := &v1.Jaeger{}
+	require.NoError(t, cl.Get(context.Background(), nsn, persisted))
+	assert.Equal(t, v1.JaegerPhasePending, persisted.Status.Phase)
+	assert.Equal(t, "0/1", persisted.Status.ComponentsReady)
+	assert.True(t, meta.IsStatusConditionFalse(persisted.Status.Conditions, v1.JaegerConditionCollectorAvailable))
+}
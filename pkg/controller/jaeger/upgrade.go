@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"go.opentelemetry.io/otel"
+	corev1 "k8s.io/api/core/v1"
 
 	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
 	"github.com/jaegertracing/jaeger-operator/pkg/tracing"
@@ -16,22 +17,57 @@ func (r *ReconcileJaeger) applyUpgrades(ctx context.Context, jaeger v1.Jaeger) (
 	ctx, span := tracer.Start(ctx, "applyUpgrades")
 	defer span.End()
 
+	if _, ok := jaeger.Annotations[v1.AnnotationRollbackUpgrade]; ok {
+		jaeger = r.rollbackUpgrade(jaeger)
+	}
+
 	currentVersions := version.Get()
+	targetVersion := upgrade.TargetVersion(jaeger, currentVersions.Jaeger)
 
 	if len(jaeger.Status.Version) > 0 {
-		if jaeger.Status.Version != currentVersions.Jaeger {
-			// in theory, the version from the Status could be higher than currentVersions.Jaeger, but we let the upgrade routine
+		if jaeger.Status.Version != targetVersion {
+			// in theory, the version from the Status could be higher than the target version, but we let the upgrade routine
 			// check/handle it
-			upgraded, err := upgrade.ManagedInstance(ctx, r.client, jaeger, currentVersions.Jaeger)
+			upgraded, err := upgrade.ManagedInstance(ctx, r.client, jaeger, targetVersion)
 			if err != nil {
 				return jaeger, tracing.HandleError(err, span)
 			}
+			if upgraded.Status.Version != jaeger.Status.Version {
+				r.recorder.Eventf(&upgraded, corev1.EventTypeNormal, upgrade.EventReasonUpgraded, "Upgraded from version %s to %s", jaeger.Status.Version, upgraded.Status.Version)
+			}
 			jaeger = upgraded
 		}
 	}
 
-	// at this point, the Jaeger we are managing is in sync with the Operator's version
+	// at this point, the Jaeger we are managing is in sync with the version it should run, which is the Operator's
+	// version unless held back by the instance's pinned version or upgrade policy
 	// if this is a new object, no upgrade was made, so, we just set the version
-	jaeger.Status.Version = currentVersions.Jaeger
+	jaeger.Status.Version = targetVersion
+
+	availableUpgrade := upgrade.AvailableUpgrade(jaeger, currentVersions.Jaeger)
+	if availableUpgrade != "" && availableUpgrade != jaeger.Status.AvailableUpgrade {
+		r.recorder.Eventf(&jaeger, corev1.EventTypeNormal, EventReasonUpgradeAvailable, "Version %s is available, the instance is kept on version %s", availableUpgrade, jaeger.Status.Version)
+	}
+	jaeger.Status.AvailableUpgrade = availableUpgrade
 	return jaeger, nil
 }
+
+// rollbackUpgrade restores the instance as it was before its last upgrade, as requested by its rollback annotation
+func (r *ReconcileJaeger) rollbackUpgrade(jaeger v1.Jaeger) v1.Jaeger {
+	rolledBack, err := upgrade.Rollback(jaeger)
+	if err != nil {
+		// there's nothing to roll back to, the request is dropped so that we don't try again
+		jaeger.Logger().Error(err, "failed to roll back the upgrade")
+		r.recorder.Eventf(&jaeger, corev1.EventTypeWarning, EventReasonRollbackFailed, "Failed to roll back the upgrade: %v", err)
+		delete(jaeger.Annotations, v1.AnnotationRollbackUpgrade)
+		return jaeger
+	}
+
+	jaeger.Logger().Info(
+		"rolled back the upgrade",
+		"from", jaeger.Status.Version,
+		"to", rolledBack.Status.Version,
+	)
+	r.recorder.Eventf(&rolledBack, corev1.EventTypeNormal, EventReasonRolledBack, "Rolled back from version %s to %s, the upgrade policy is set to Manual", jaeger.Status.Version, rolledBack.Status.Version)
+	return rolledBack
+}
@@ -8,8 +8,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 
 	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/version"
 )
 
 func TestDirectNextMinor(t *testing.T) {
@@ -21,7 +23,7 @@ func TestDirectNextMinor(t *testing.T) {
 		Name: "my-instance",
 	}
 
-	r := &ReconcileJaeger{}
+	r := &ReconcileJaeger{recorder: &record.FakeRecorder{}}
 	j := *v1.NewJaeger(nsn)
 	j.Status.Version = "1.12.0"
 
@@ -51,3 +53,83 @@ func TestSetVersionOnNewInstance(t *testing.T) {
 	// version, so, at least the status field should have been updated
 	assert.NotEmpty(t, j.Status.Version)
 }
+
+func TestKeepVersionWithManualUpgradePolicy(t *testing.T) {
+	// prepare
+	r := &ReconcileJaeger{recorder: &record.FakeRecorder{}}
+	j := *v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	j.Spec.UpgradePolicy = v1.UpgradePolicyManual
+	j.Status.Version = "0.0.0-old"
+
+	// test
+	j, err := r.applyUpgrades(context.Background(), j)
+
+	// verify
+	require.NoError(t, err)
+	assert.Equal(t, "0.0.0-old", j.Status.Version)
+	assert.Equal(t, version.Get().Jaeger, j.Status.AvailableUpgrade)
+}
+
+func TestUpgradeToPinnedVersion(t *testing.T) {
+	// prepare
+	r := &ReconcileJaeger{recorder: &record.FakeRecorder{}}
+	j := *v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	j.Spec.Version = "0.0.0-new"
+	j.Spec.UpgradePolicy = v1.UpgradePolicyManual
+	j.Status.Version = "0.0.0-old"
+	j.Status.AvailableUpgrade = version.Get().Jaeger
+
+	// test
+	j, err := r.applyUpgrades(context.Background(), j)
+
+	// verify
+	require.NoError(t, err)
+	assert.Equal(t, "0.0.0-new", j.Status.Version)
+	assert.Equal(t, version.Get().Jaeger, j.Status.AvailableUpgrade)
+}
+
+func TestRollbackUpgrade(t *testing.T) {
+	// prepare
+	recorder := record.NewFakeRecorder(1)
+	r := &ReconcileJaeger{recorder: recorder}
+	j := *v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	j.Spec.Strategy = v1.DeploymentStrategyProduction
+	j.Status.Version = "1.22.0"
+	j.Annotations = map[string]string{
+		v1.AnnotationPreUpgradeSnapshot: `{"version":"1.20.0","spec":{"strategy":"allinone"}}`,
+		v1.AnnotationRollbackUpgrade:    "true",
+	}
+
+	// test
+	j, err := r.applyUpgrades(context.Background(), j)
+
+	// verify
+	require.NoError(t, err)
+	assert.Equal(t, v1.DeploymentStrategyAllInOne, j.Spec.Strategy)
+	assert.Equal(t, v1.UpgradePolicyManual, j.Spec.UpgradePolicy)
+	assert.Equal(t, "1.20.0", j.Status.Version)
+	assert.NotContains(t, j.Annotations, v1.AnnotationPreUpgradeSnapshot)
+	assert.NotContains(t, j.Annotations, v1.AnnotationRollbackUpgrade)
+	require.Len(t, recorder.Events, 1)
+	assert.Equal(t, "Normal RolledBack Rolled back from version 1.22.0 to 1.20.0, the upgrade policy is set to Manual", <-recorder.Events)
+}
+
+func TestRollbackUpgradeWithoutSnapshot(t *testing.T) {
+	// prepare
+	recorder := record.NewFakeRecorder(1)
+	r := &ReconcileJaeger{recorder: recorder}
+	j := *v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	j.Spec.UpgradePolicy = v1.UpgradePolicyManual
+	j.Status.Version = "1.22.0"
+	j.Annotations = map[string]string{v1.AnnotationRollbackUpgrade: "true"}
+
+	// test
+	j, err := r.applyUpgrades(context.Background(), j)
+
+	// verify
+	require.NoError(t, err)
+	assert.Equal(t, "1.22.0", j.Status.Version)
+	assert.NotContains(t, j.Annotations, v1.AnnotationRollbackUpgrade)
+	require.Len(t, recorder.Events, 1)
+	assert.Contains(t, <-recorder.Events, "Warning RollbackFailed")
+}
@@ -7,7 +7,6 @@ import (
 	"go.opentelemetry.io/otel"
 	otelattribute "go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
-	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -79,9 +78,8 @@ func (r *ReconcileNamespace) Reconcile(request reconcile.Request) (reconcile.Res
 		client.InNamespace(request.Name),
 	}
 
-	// Fetch the Deployment instance
-	deps := &appsv1.DeploymentList{}
-	err = r.rClient.List(ctx, deps, opts...)
+	// Fetch the workloads the sidecar can be injected into
+	workloads, err := inject.ListWorkloads(ctx, r.rClient, opts...)
 	if err != nil {
 		if errors.IsNotFound(err) {
 			// Request object not found, could have been deleted after reconcile request.
@@ -93,23 +91,25 @@ func (r *ReconcileNamespace) Reconcile(request reconcile.Request) (reconcile.Res
 		return reconcile.Result{}, tracing.HandleError(err, span)
 	}
 
-	for i := 0; i < len(deps.Items); i++ {
-		dep := &deps.Items[i]
-		if dep.Labels["app"] == "jaeger" {
+	for _, w := range workloads {
+		if w.Labels["app"] == "jaeger" {
 			// Don't touch jaeger deployments
 			continue
 		}
 
-		// NOTE: If a deployment does not provide an "inject" annotation and
-		// has an agent, we need to verify if this is caused by a annotated
+		// NOTE: If a workload does not provide an "inject" annotation and
+		// has a sidecar, we need to verify if this is caused by a annotated
 		// namespace.
-		hasAgent, _ := inject.HasJaegerAgent(dep)
-		_, hasDepAnnotation := dep.Annotations[inject.Annotation]
-		verificationNeeded := hasAgent && !hasDepAnnotation
-
-		if inject.Needed(dep, ns) || verificationNeeded {
-			inject.IncreaseRevision(dep.Annotations)
-			if err := r.client.Update(context.Background(), dep); err != nil {
+		_, hasAnnotation := w.Annotations[inject.Annotation]
+		verificationNeeded := inject.HasSidecarForWorkload(w.Workload) && !hasAnnotation
+
+		if inject.NeededForWorkload(w.Workload, ns) || verificationNeeded {
+			inject.IncreaseRevision(w.Annotations)
+			obj, err := w.Object()
+			if err == nil {
+				err = r.client.Update(context.Background(), obj)
+			}
+			if err != nil {
 				logger.V(5).Info(fmt.Sprintf("%s", err))
 				return reconcile.Result{}, tracing.HandleError(err, span)
 			}
@@ -233,3 +233,31 @@ func TestReconcilieDeployment(t *testing.T) {
 		})
 	}
 }
+
+func TestReconcileStatefulSet(t *testing.T) {
+	// prepare
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "my-ns"}}
+	sts := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "my-sts",
+			Namespace:   ns.Name,
+			Annotations: map[string]string{inject.Annotation: "true"},
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "only_container"}}},
+			},
+		},
+	}
+	cl := fake.NewClientBuilder().WithRuntimeObjects(ns, sts).Build()
+	r := &ReconcileNamespace{client: cl, rClient: cl, scheme: scheme.Scheme}
+
+	// test
+	_, err := r.Reconcile(reconcile.Request{NamespacedName: types.NamespacedName{Name: ns.Name}})
+
+	// verify
+	require.NoError(t, err)
+	persisted := &appsv1.StatefulSet{}
+	require.NoError(t, cl.Get(context.Background(), types.NamespacedName{Name: sts.Name, Namespace: ns.Name}, persisted))
+	assert.Equal(t, "0", persisted.Annotations[inject.AnnotationRev])
+}
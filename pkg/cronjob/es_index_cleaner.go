@@ -78,7 +78,7 @@ func CreateEsIndexCleaner(jaeger *v1.Jaeger) runtime.Object {
 				Containers: []corev1.Container{
 					{
 						Name:            util.Truncate(name, 63),
-						Image:           util.ImageName(jaeger.Spec.Storage.EsIndexCleaner.Image, "jaeger-es-index-cleaner-image"),
+						Image:           util.InstanceImageName(*jaeger, jaeger.Spec.Storage.EsIndexCleaner.Image, "jaeger-es-index-cleaner-image"),
 						ImagePullPolicy: jaeger.Spec.Storage.EsIndexCleaner.ImagePullPolicy,
 						Args:            []string{strconv.Itoa(*jaeger.Spec.Storage.EsIndexCleaner.NumberOfDays), esUrls},
 						Env:             util.RemoveEmptyVars(envs),
@@ -146,6 +146,7 @@ func (a *AllInOne) Get() *appsv1.Deployment {
 		})
 	}
 
+	envVars = append(envVars, sampling.EnvVars(a.jaeger)...)
 	envVars = append(envVars, proxy.ReadProxyVarsFromEnv()...)
 
 	ports := []corev1.ContainerPort{
@@ -229,7 +230,7 @@ func (a *AllInOne) Get() *appsv1.Deployment {
 				Spec: corev1.PodSpec{
 					ImagePullSecrets: commonSpec.ImagePullSecrets,
 					Containers: []corev1.Container{{
-						Image:         util.ImageName(a.jaeger.Spec.AllInOne.Image, "jaeger-all-in-one-image"),
+						Image:         util.InstanceImageName(*a.jaeger, a.jaeger.Spec.AllInOne.Image, "jaeger-all-in-one-image"),
 						Name:          "jaeger",
 						Args:          options,
 						Env:           append(envVars, getOTLPEnvVars(options)...),
@@ -1,6 +1,8 @@
 package deployment
 
 import (
+	"encoding/json"
+
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
 	corev1 "k8s.io/api/core/v1"
@@ -57,17 +59,30 @@ func autoscalers(component component) []runtime.Object {
 		Labels: component.hpaLabels(),
 	}
 
-	avgUtilization := defaultAvgUtilization
 	trueVar := true
 	jaeger := component.jaegerInstance()
 	commonSpec := util.Merge([]v1.JaegerCommonSpec{component.commonSpec(), jaeger.Spec.JaegerCommonSpec, baseCommonSpec})
 
-	// scale up when either CPU or memory is above 90%
+	metrics := autoScaleSpec.Metrics
+	if len(metrics) == 0 {
+		metrics = defaultMetrics()
+	}
+
 	var result []runtime.Object
 
 	autoscalingVersion := viper.GetString(v1.FlagAutoscalingVersion)
 
 	if autoscalingVersion == v1.FlagAutoscalingVersionV2Beta2 {
+		// both versions share the same structure, only the package differs
+		var metricsV2Beta2 []autoscalingv2beta2.MetricSpec
+		var behaviorV2Beta2 *autoscalingv2beta2.HorizontalPodAutoscalerBehavior
+		if err := convertAutoscaling(metrics, &metricsV2Beta2); err != nil {
+			jaeger.Logger().Error(err, "failed to convert the autoscaling metrics to autoscaling/v2beta2")
+		}
+		if err := convertAutoscaling(autoScaleSpec.Behavior, &behaviorV2Beta2); err != nil {
+			jaeger.Logger().Error(err, "failed to convert the autoscaling behavior to autoscaling/v2beta2")
+		}
+
 		autoscaler := autoscalingv2beta2.HorizontalPodAutoscaler{
 			TypeMeta: metav1.TypeMeta{
 				Kind:       "HorizontalPodAutoscaler",
@@ -96,28 +111,8 @@ func autoscalers(component component) []runtime.Object {
 				},
 				MinReplicas: autoScaleSpec.MinReplicas,
 				MaxReplicas: maxReplicas,
-				Metrics: []autoscalingv2beta2.MetricSpec{
-					{
-						Type: autoscalingv2beta2.ResourceMetricSourceType,
-						Resource: &autoscalingv2beta2.ResourceMetricSource{
-							Name: corev1.ResourceCPU,
-							Target: autoscalingv2beta2.MetricTarget{
-								Type:               autoscalingv2beta2.UtilizationMetricType,
-								AverageUtilization: &avgUtilization,
-							},
-						},
-					},
-					{
-						Type: autoscalingv2beta2.ResourceMetricSourceType,
-						Resource: &autoscalingv2beta2.ResourceMetricSource{
-							Name: corev1.ResourceMemory,
-							Target: autoscalingv2beta2.MetricTarget{
-								Type:               autoscalingv2beta2.UtilizationMetricType,
-								AverageUtilization: &avgUtilization,
-							},
-						},
-					},
-				},
+				Metrics:     metricsV2Beta2,
+				Behavior:    behaviorV2Beta2,
 			},
 		}
 		result = append(result, &autoscaler)
@@ -150,31 +145,46 @@ func autoscalers(component component) []runtime.Object {
 				},
 				MinReplicas: autoScaleSpec.MinReplicas,
 				MaxReplicas: maxReplicas,
-				Metrics: []autoscalingv2.MetricSpec{
-					{
-						Type: autoscalingv2.ResourceMetricSourceType,
-						Resource: &autoscalingv2.ResourceMetricSource{
-							Name: corev1.ResourceCPU,
-							Target: autoscalingv2.MetricTarget{
-								Type:               autoscalingv2.UtilizationMetricType,
-								AverageUtilization: &avgUtilization,
-							},
-						},
-					},
-					{
-						Type: autoscalingv2.ResourceMetricSourceType,
-						Resource: &autoscalingv2.ResourceMetricSource{
-							Name: corev1.ResourceMemory,
-							Target: autoscalingv2.MetricTarget{
-								Type:               autoscalingv2.UtilizationMetricType,
-								AverageUtilization: &avgUtilization,
-							},
-						},
-					},
-				},
+				Metrics:     metrics,
+				Behavior:    autoScaleSpec.Behavior,
 			},
 		}
 		result = append(result, &autoscaler)
 	}
 	return result
 }
+
+// defaultMetrics scales up when either CPU or memory is above 90%
+func defaultMetrics() []autoscalingv2.MetricSpec {
+	avgUtilization := defaultAvgUtilization
+	return []autoscalingv2.MetricSpec{
+		{
+			Type: autoscalingv2.ResourceMetricSourceType,
+			Resource: &autoscalingv2.ResourceMetricSource{
+				Name: corev1.ResourceCPU,
+				Target: autoscalingv2.MetricTarget{
+					Type:               autoscalingv2.UtilizationMetricType,
+					AverageUtilization: &avgUtilization,
+				},
+			},
+		},
+		{
+			Type: autoscalingv2.ResourceMetricSourceType,
+			Resource: &autoscalingv2.ResourceMetricSource{
+				Name: corev1.ResourceMemory,
+				Target: autoscalingv2.MetricTarget{
+					Type:               autoscalingv2.UtilizationMetricType,
+					AverageUtilization: &avgUtilization,
+				},
+			},
+		},
+	}
+}
+
+func convertAutoscaling(in interface{}, out interface{}) error {
+	b, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, out)
+}
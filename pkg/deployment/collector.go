@@ -8,6 +8,7 @@ import (
 	"github.com/operator-framework/operator-lib/proxy"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -17,6 +18,7 @@ import (
 	"github.com/jaegertracing/jaeger-operator/pkg/config/ca"
 	"github.com/jaegertracing/jaeger-operator/pkg/config/sampling"
 	"github.com/jaegertracing/jaeger-operator/pkg/config/tls"
+	"github.com/jaegertracing/jaeger-operator/pkg/kafka"
 	"github.com/jaegertracing/jaeger-operator/pkg/service"
 	"github.com/jaegertracing/jaeger-operator/pkg/storage"
 	"github.com/jaegertracing/jaeger-operator/pkg/util"
@@ -95,6 +97,11 @@ func (c *Collector) Get() *appsv1.Deployment {
 	ca.Update(c.jaeger, commonSpec)
 	storage.UpdateGRPCPlugin(c.jaeger, commonSpec)
 
+	kafkaEnvVars := []corev1.EnvVar{}
+	if c.jaeger.Spec.Strategy == v1.DeploymentStrategyStreaming {
+		kafka.Update(c.jaeger, kafka.Producer, commonSpec, &kafkaEnvVars, &options)
+	}
+
 	// ensure we have a consistent order of the arguments
 	// see https://github.com/jaegertracing/jaeger-operator/issues/334
 	sort.Strings(options)
@@ -138,6 +145,8 @@ func (c *Collector) Get() *appsv1.Deployment {
 			Value: ":9411",
 		},
 	}
+	envVars = append(envVars, sampling.EnvVars(c.jaeger)...)
+	envVars = append(envVars, kafkaEnvVars...)
 	envVars = append(envVars, proxy.ReadProxyVarsFromEnv()...)
 
 	ports := []corev1.ContainerPort{
@@ -200,7 +209,7 @@ func (c *Collector) Get() *appsv1.Deployment {
 				Spec: corev1.PodSpec{
 					ImagePullSecrets: c.jaeger.Spec.ImagePullSecrets,
 					Containers: []corev1.Container{{
-						Image:         util.ImageName(c.jaeger.Spec.Collector.Image, "jaeger-collector-image"),
+						Image:         util.InstanceImageName(*c.jaeger, c.jaeger.Spec.Collector.Image, "jaeger-collector-image"),
 						Name:          "jaeger-collector",
 						Args:          options,
 						Env:           append(envVars, getOTLPEnvVars(options)...),
@@ -251,6 +260,11 @@ func (c *Collector) Autoscalers() []runtime.Object {
 	return autoscalers(c)
 }
 
+// PodDisruptionBudget returns the PDB protecting the collector replicas, if any
+func (c *Collector) PodDisruptionBudget() *policyv1.PodDisruptionBudget {
+	return podDisruptionBudget(c)
+}
+
 func (c *Collector) labels() map[string]string {
 	return util.Labels(c.name(), "collector", *c.jaeger)
 }
@@ -280,3 +294,7 @@ func (c *Collector) jaegerInstance() *v1.Jaeger {
 func (c *Collector) replicas() *int32 {
 	return c.jaeger.Spec.Collector.Replicas
 }
+
+func (c *Collector) podDisruptionBudgetSpec() *v1.JaegerPodDisruptionBudgetSpec {
+	return c.jaeger.Spec.Collector.PodDisruptionBudget
+}
@@ -626,6 +626,65 @@ func TestCollectorAutoscalersSetMaxReplicas(t *testing.T) {
 	assert.Equal(t, maxReplicas, hpa.Spec.MaxReplicas)
 }
 
+func TestCollectorAutoscalersCustomMetrics(t *testing.T) {
+	spansReceived := resource.MustParse("1k")
+	stabilization := int32(300)
+	behavior := &autoscalingv2.HorizontalPodAutoscalerBehavior{
+		ScaleDown: &autoscalingv2.HPAScalingRules{
+			StabilizationWindowSeconds: &stabilization,
+			Policies: []autoscalingv2.HPAScalingPolicy{{
+				Type:          autoscalingv2.PodsScalingPolicy,
+				Value:         1,
+				PeriodSeconds: 60,
+			}},
+		},
+	}
+	metrics := []autoscalingv2.MetricSpec{{
+		Type: autoscalingv2.PodsMetricSourceType,
+		Pods: &autoscalingv2.PodsMetricSource{
+			Metric: autoscalingv2.MetricIdentifier{Name: "jaeger_collector_spans_received_total"},
+			Target: autoscalingv2.MetricTarget{
+				Type:         autoscalingv2.AverageValueMetricType,
+				AverageValue: &spansReceived,
+			},
+		},
+	}}
+
+	for _, version := range []string{v1.FlagAutoscalingVersionV2, v1.FlagAutoscalingVersionV2Beta2} {
+		t.Run(version, func(t *testing.T) {
+			// prepare
+			viper.Set(v1.FlagAutoscalingVersion, version)
+			defer viper.Reset()
+			jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+			jaeger.Spec.Collector.Metrics = metrics
+			jaeger.Spec.Collector.Behavior = behavior
+			c := NewCollector(jaeger)
+
+			// test
+			a := c.Autoscalers()
+
+			// verify
+			assert.Len(t, a, 1)
+			if version == v1.FlagAutoscalingVersionV2 {
+				hpa := a[0].(*autoscalingv2.HorizontalPodAutoscaler)
+				assert.Equal(t, metrics, hpa.Spec.Metrics)
+				assert.Equal(t, behavior, hpa.Spec.Behavior)
+				return
+			}
+
+			hpa := a[0].(*autoscalingv2beta2.HorizontalPodAutoscaler)
+			require.Len(t, hpa.Spec.Metrics, 1)
+			assert.Equal(t, autoscalingv2beta2.PodsMetricSourceType, hpa.Spec.Metrics[0].Type)
+			assert.Equal(t, "jaeger_collector_spans_received_total", hpa.Spec.Metrics[0].Pods.Metric.Name)
+			assert.True(t, spansReceived.Equal(*hpa.Spec.Metrics[0].Pods.Target.AverageValue))
+			require.NotNil(t, hpa.Spec.Behavior)
+			assert.Equal(t, stabilization, *hpa.Spec.Behavior.ScaleDown.StabilizationWindowSeconds)
+			assert.Equal(t, autoscalingv2beta2.PodsScalingPolicy, hpa.Spec.Behavior.ScaleDown.Policies[0].Type)
+			assert.Nil(t, hpa.Spec.Behavior.ScaleUp)
+		})
+	}
+}
+
 func TestCollectoArgumentsOpenshiftTLS(t *testing.T) {
 	autodetect.OperatorConfiguration.SetPlatform(autodetect.OpenShiftPlatform)
 	defer viper.Reset()
@@ -891,3 +950,40 @@ func TestCollectorTerminationGracePeriodSeconds(t *testing.T) {
 
 	assert.Equal(t, terminationGracePeriodSeconds, *dep.Spec.Template.Spec.TerminationGracePeriodSeconds)
 }
+
+func TestCollectorKafkaAuthentication(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Strategy = v1.DeploymentStrategyStreaming
+	jaeger.Spec.Kafka = v1.JaegerKafkaSpec{
+		Brokers: "kafka:9094",
+		TLS:     &v1.JaegerKafkaTLSSpec{CASecretName: "kafka-ca"},
+		Authentication: &v1.JaegerKafkaAuthenticationSpec{
+			Type:       v1.KafkaAuthenticationSCRAMSHA512,
+			SecretName: "kafka-user",
+		},
+	}
+
+	dep := NewCollector(jaeger).Get()
+
+	args := dep.Spec.Template.Spec.Containers[0].Args
+	assert.Contains(t, args, "--kafka.producer.brokers=kafka:9094")
+	assert.Contains(t, args, "--kafka.producer.tls.ca=/var/run/secrets/kafka/ca/ca.crt")
+	assert.Contains(t, args, "--kafka.producer.plaintext.mechanism=SCRAM-SHA-512")
+
+	envNames := []string{}
+	for _, env := range dep.Spec.Template.Spec.Containers[0].Env {
+		envNames = append(envNames, env.Name)
+	}
+	assert.Contains(t, envNames, "KAFKA_PRODUCER_PLAINTEXT_PASSWORD")
+}
+
+func TestCollectorKafkaAuthenticationNotStreaming(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Kafka.Brokers = "kafka:9092"
+
+	dep := NewCollector(jaeger).Get()
+
+	for _, arg := range dep.Spec.Template.Spec.Containers[0].Args {
+		assert.NotContains(t, arg, "--kafka.producer")
+	}
+}
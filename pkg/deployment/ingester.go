@@ -8,6 +8,7 @@ import (
 	"github.com/operator-framework/operator-lib/proxy"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -15,6 +16,7 @@ import (
 	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
 	"github.com/jaegertracing/jaeger-operator/pkg/account"
 	"github.com/jaegertracing/jaeger-operator/pkg/config/ca"
+	"github.com/jaegertracing/jaeger-operator/pkg/kafka"
 	"github.com/jaegertracing/jaeger-operator/pkg/storage"
 	"github.com/jaegertracing/jaeger-operator/pkg/util"
 )
@@ -92,6 +94,9 @@ func (i *Ingester) Get() *appsv1.Deployment {
 	ca.Update(i.jaeger, commonSpec)
 	storage.UpdateGRPCPlugin(i.jaeger, commonSpec)
 
+	kafkaEnvVars := []corev1.EnvVar{}
+	kafka.Update(i.jaeger, kafka.Consumer, commonSpec, &kafkaEnvVars, &options)
+
 	// ensure we have a consistent order of the arguments
 	// see https://github.com/jaegertracing/jaeger-operator/issues/334
 	sort.Strings(options)
@@ -131,6 +136,7 @@ func (i *Ingester) Get() *appsv1.Deployment {
 			Value: string(i.jaeger.Spec.Storage.Type),
 		},
 	}
+	envVars = append(envVars, kafkaEnvVars...)
 	envVars = append(envVars, proxy.ReadProxyVarsFromEnv()...)
 	deployment := &appsv1.Deployment{
 		TypeMeta: metav1.TypeMeta{
@@ -163,7 +169,7 @@ func (i *Ingester) Get() *appsv1.Deployment {
 				Spec: corev1.PodSpec{
 					ImagePullSecrets: i.jaeger.Spec.ImagePullSecrets,
 					Containers: []corev1.Container{{
-						Image:        util.ImageName(i.jaeger.Spec.Ingester.Image, "jaeger-ingester-image"),
+						Image:        util.InstanceImageName(*i.jaeger, i.jaeger.Spec.Ingester.Image, "jaeger-ingester-image"),
 						Name:         "jaeger-ingester",
 						Args:         options,
 						Env:          envVars,
@@ -206,6 +212,11 @@ func (i *Ingester) Get() *appsv1.Deployment {
 	return deployment
 }
 
+// PodDisruptionBudget returns the PDB protecting the ingester replicas, if any
+func (i *Ingester) PodDisruptionBudget() *policyv1.PodDisruptionBudget {
+	return podDisruptionBudget(i)
+}
+
 func (i *Ingester) labels() map[string]string {
 	return util.Labels(i.name(), "ingester", *i.jaeger)
 }
@@ -225,7 +236,12 @@ func (i *Ingester) commonSpec() v1.JaegerCommonSpec {
 }
 
 func (i *Ingester) autoscalingSpec() v1.AutoScaleSpec {
-	return i.jaeger.Spec.Ingester.AutoScaleSpec
+	spec := i.jaeger.Spec.Ingester.AutoScaleSpec
+	if spec.MaxReplicas == nil && i.jaeger.Spec.Kafka.Topic != nil && i.jaeger.Spec.Kafka.Topic.Partitions != nil {
+		// the ingesters beyond the number of partitions would have nothing to consume
+		spec.MaxReplicas = i.jaeger.Spec.Kafka.Topic.Partitions
+	}
+	return spec
 }
 
 func (i *Ingester) jaegerInstance() *v1.Jaeger {
@@ -235,3 +251,7 @@ func (i *Ingester) jaegerInstance() *v1.Jaeger {
 func (i *Ingester) replicas() *int32 {
 	return i.jaeger.Spec.Ingester.Replicas
 }
+
+func (i *Ingester) podDisruptionBudgetSpec() *v1.JaegerPodDisruptionBudgetSpec {
+	return i.jaeger.Spec.Ingester.PodDisruptionBudget
+}
@@ -425,6 +425,35 @@ func TestIngesterAutoscalersOnByDefault(t *testing.T) {
 	assert.Equal(t, int32(90), *autoscaler.Spec.Metrics[1].Resource.Target.AverageUtilization)
 }
 
+func TestIngesterAutoscalersKafkaLag(t *testing.T) {
+	// prepare
+	lag := resource.MustParse("10000")
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Ingester.Metrics = []autoscalingv2.MetricSpec{{
+		Type: autoscalingv2.ExternalMetricSourceType,
+		External: &autoscalingv2.ExternalMetricSource{
+			Metric: autoscalingv2.MetricIdentifier{
+				Name:     "kafka_consumergroup_lag",
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"consumergroup": "jaeger-ingester"}},
+			},
+			Target: autoscalingv2.MetricTarget{
+				Type:         autoscalingv2.AverageValueMetricType,
+				AverageValue: &lag,
+			},
+		},
+	}}
+	c := NewIngester(jaeger)
+
+	// test
+	a := c.Autoscalers()
+
+	// verify
+	assert.Len(t, a, 1)
+	autoscaler := a[0].(*autoscalingv2.HorizontalPodAutoscaler)
+	assert.Equal(t, jaeger.Spec.Ingester.Metrics, autoscaler.Spec.Metrics)
+	assert.Nil(t, autoscaler.Spec.Behavior)
+}
+
 func TestIngesterAutoscalersDisabledByExplicitReplicaSize(t *testing.T) {
 	// prepare
 	tests := []int32{int32(0), int32(1)}
@@ -472,6 +501,116 @@ func TestIngesterAutoscalersSetMaxReplicas(t *testing.T) {
 	assert.Equal(t, maxReplicas, hpa.Spec.MaxReplicas)
 }
 
+func TestIngesterAutoscalersMaxReplicasFromPartitions(t *testing.T) {
+	// prepare
+	partitions := int32(6)
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Kafka.Topic = &v1.JaegerKafkaTopicSpec{Partitions: &partitions}
+	c := NewIngester(jaeger)
+
+	// test
+	a := c.Autoscalers()
+
+	// verify
+	require.Len(t, a, 1)
+	hpa := a[0].(*autoscalingv2.HorizontalPodAutoscaler)
+	assert.Equal(t, partitions, hpa.Spec.MaxReplicas)
+}
+
+func TestIngesterScaledObjects(t *testing.T) {
+	// prepare
+	minReplicas := int32(0)
+	lagThreshold := int64(500)
+	jaeger := newIngesterJaeger("my-instance")
+	jaeger.Namespace = "observability"
+	jaeger.Spec.Ingester.Options = v1.NewOptions(map[string]interface{}{
+		"kafka.consumer.brokers":  "my-cluster-kafka-brokers.kafka:9092",
+		"kafka.consumer.topic":    "my-spans",
+		"kafka.consumer.group-id": "my-group",
+	})
+	jaeger.Spec.Ingester.KEDA = &v1.JaegerIngesterKEDASpec{
+		MinReplicas:       &minReplicas,
+		LagThreshold:      &lagThreshold,
+		AuthenticationRef: "kafka-credentials",
+	}
+	c := NewIngester(jaeger)
+
+	// test
+	objs := c.ScaledObjects()
+
+	// verify
+	require.Len(t, objs, 1)
+	so := objs[0]
+	assert.Equal(t, "my-instance-ingester", so.Name)
+	assert.Equal(t, "observability", so.Namespace)
+	assert.Equal(t, "jaeger-operator", so.Labels["app.kubernetes.io/managed-by"])
+	assert.Equal(t, "my-instance-ingester", so.Spec.ScaleTargetRef.Name)
+	assert.Equal(t, "Deployment", so.Spec.ScaleTargetRef.Kind)
+	assert.Equal(t, int32(0), *so.Spec.MinReplicaCount)
+	assert.Equal(t, int32(100), *so.Spec.MaxReplicaCount)
+
+	require.Len(t, so.Spec.Triggers, 1)
+	assert.Equal(t, "kafka", so.Spec.Triggers[0].Type)
+	assert.Equal(t, map[string]string{
+		"bootstrapServers": "my-cluster-kafka-brokers.kafka:9092",
+		"topic":            "my-spans",
+		"consumerGroup":    "my-group",
+		"lagThreshold":     "500",
+	}, so.Spec.Triggers[0].Metadata)
+	assert.Equal(t, "kafka-credentials", so.Spec.Triggers[0].AuthenticationRef.Name)
+}
+
+func TestIngesterScaledObjectsProvisionedKafka(t *testing.T) {
+	// prepare
+	minReplicas := int32(2)
+	maxReplicas := int32(5)
+	jaeger := newIngesterJaeger("my-instance")
+	jaeger.Namespace = "observability"
+	jaeger.Annotations = map[string]string{v1.AnnotationProvisionedKafkaKey: v1.AnnotationProvisionedKafkaValue}
+	jaeger.Spec.Ingester.MinReplicas = &minReplicas
+	jaeger.Spec.Ingester.MaxReplicas = &maxReplicas
+	jaeger.Spec.Ingester.KEDA = &v1.JaegerIngesterKEDASpec{}
+	c := NewIngester(jaeger)
+
+	// test
+	objs := c.ScaledObjects()
+
+	// verify
+	require.Len(t, objs, 1)
+	so := objs[0]
+	assert.Equal(t, minReplicas, *so.Spec.MinReplicaCount)
+	assert.Equal(t, maxReplicas, *so.Spec.MaxReplicaCount)
+	assert.Equal(t, map[string]string{
+		"bootstrapServers": "my-instance-kafka-bootstrap.observability.svc.cluster.local:9092",
+		"topic":            "jaeger-spans",
+		"consumerGroup":    "jaeger-ingester",
+		"lagThreshold":     "10000",
+	}, so.Spec.Triggers[0].Metadata)
+	assert.Nil(t, so.Spec.Triggers[0].AuthenticationRef)
+}
+
+func TestIngesterScaledObjectsDisabled(t *testing.T) {
+	replicas := int32(1)
+	disabled := false
+
+	for _, tt := range []struct {
+		name   string
+		mutate func(j *v1.Jaeger)
+	}{
+		{name: "not requested", mutate: func(j *v1.Jaeger) { j.Spec.Ingester.KEDA = nil }},
+		{name: "explicit replicas", mutate: func(j *v1.Jaeger) { j.Spec.Ingester.Replicas = &replicas }},
+		{name: "autoscale disabled", mutate: func(j *v1.Jaeger) { j.Spec.Ingester.Autoscale = &disabled }},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			jaeger := newIngesterJaeger("my-instance")
+			jaeger.Spec.Ingester.KEDA = &v1.JaegerIngesterKEDASpec{}
+			tt.mutate(jaeger)
+
+			assert.Empty(t, NewIngester(jaeger).ScaledObjects())
+		})
+	}
+}
+
 func newIngesterJaeger(name string) *v1.Jaeger {
 	return &v1.Jaeger{
 		ObjectMeta: metav1.ObjectMeta{
@@ -634,3 +773,36 @@ func TestIngesterNodeSelector(t *testing.T) {
 
 	assert.Equal(t, nodeSelector, dep.Spec.Template.Spec.NodeSelector)
 }
+
+func TestIngesterKafkaAuthentication(t *testing.T) {
+	jaeger := newIngesterJaeger("my-instance")
+	jaeger.Spec.Kafka = v1.JaegerKafkaSpec{
+		Brokers: "kafka:9094",
+		TLS:     &v1.JaegerKafkaTLSSpec{CASecretName: "kafka-ca"},
+		Authentication: &v1.JaegerKafkaAuthenticationSpec{
+			Type:       v1.KafkaAuthenticationSCRAMSHA512,
+			SecretName: "kafka-user",
+		},
+	}
+
+	i := NewIngester(jaeger)
+	dep := i.Get()
+
+	args := dep.Spec.Template.Spec.Containers[0].Args
+	assert.Contains(t, args, "--kafka.consumer.brokers=kafka:9094")
+	assert.Contains(t, args, "--kafka.consumer.authentication=plaintext")
+	assert.Contains(t, args, "--kafka.consumer.plaintext.mechanism=SCRAM-SHA-512")
+
+	envNames := []string{}
+	for _, env := range dep.Spec.Template.Spec.Containers[0].Env {
+		envNames = append(envNames, env.Name)
+	}
+	assert.Contains(t, envNames, "KAFKA_CONSUMER_PLAINTEXT_USERNAME")
+	assert.Contains(t, envNames, "KAFKA_CONSUMER_PLAINTEXT_PASSWORD")
+
+	volumes := []string{}
+	for _, volume := range dep.Spec.Template.Spec.Volumes {
+		volumes = append(volumes, volume.Name)
+	}
+	assert.Contains(t, volumes, "my-instance-kafka-ca")
+}
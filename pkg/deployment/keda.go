@@ -0,0 +1,113 @@
+package deployment
+
+import (
+	"fmt"
+	"strconv"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+	kedav1alpha1 "github.com/jaegertracing/jaeger-operator/pkg/keda/v1alpha1"
+	"github.com/jaegertracing/jaeger-operator/pkg/util"
+)
+
+const (
+	defaultKafkaTopic         = "jaeger-spans"
+	defaultKafkaConsumerGroup = "jaeger-ingester"
+	defaultKEDALagThreshold   = int64(10000)
+)
+
+// ScaledObjects returns the KEDA ScaledObjects scaling this ingester on the Kafka consumer lag, used in place
+// of the autoscalers when requested
+func (i *Ingester) ScaledObjects() []kedav1alpha1.ScaledObject {
+	spec := i.jaeger.Spec.Ingester.KEDA
+	if spec == nil {
+		return []kedav1alpha1.ScaledObject{}
+	}
+
+	// fixed number of replicas is explicitly set, do not auto scale
+	if i.replicas() != nil {
+		return []kedav1alpha1.ScaledObject{}
+	}
+
+	// explicitly disabled, do not auto scale
+	if i.autoscalingSpec().Autoscale != nil && !*i.autoscalingSpec().Autoscale {
+		return []kedav1alpha1.ScaledObject{}
+	}
+
+	options := i.jaeger.Spec.Ingester.Options.StringMap()
+	brokers := options["kafka.consumer.brokers"]
+	if i.jaeger.Spec.Kafka.Brokers != "" {
+		brokers = i.jaeger.Spec.Kafka.Brokers
+	} else if i.jaeger.Annotations[v1.AnnotationProvisionedKafkaKey] == v1.AnnotationProvisionedKafkaValue {
+		// the provisioned cluster has a plain internal listener, so that KEDA doesn't need the client certificates
+		brokers = fmt.Sprintf("%s-kafka-bootstrap.%s.svc.cluster.local:9092", i.jaeger.Name, i.jaeger.Namespace)
+	}
+	topic := options["kafka.consumer.topic"]
+	if topic == "" {
+		topic = defaultKafkaTopic
+	}
+	group := options["kafka.consumer.group-id"]
+	if group == "" {
+		group = defaultKafkaConsumerGroup
+	}
+	lagThreshold := defaultKEDALagThreshold
+	if spec.LagThreshold != nil {
+		lagThreshold = *spec.LagThreshold
+	}
+
+	trigger := kedav1alpha1.ScaleTriggers{
+		Type: "kafka",
+		Metadata: map[string]string{
+			"bootstrapServers": brokers,
+			"topic":            topic,
+			"consumerGroup":    group,
+			"lagThreshold":     strconv.FormatInt(lagThreshold, 10),
+		},
+	}
+	if spec.AuthenticationRef != "" {
+		trigger.AuthenticationRef = &kedav1alpha1.AuthenticationRef{Name: spec.AuthenticationRef}
+	}
+
+	minReplicas := spec.MinReplicas
+	if minReplicas == nil {
+		minReplicas = i.jaeger.Spec.Ingester.MinReplicas
+	}
+	maxReplicas := defaultMaxReplicas
+	if max := i.autoscalingSpec().MaxReplicas; max != nil && *max >= 0 {
+		maxReplicas = *max
+	}
+
+	labels := i.labels()
+	labels["app.kubernetes.io/component"] = "scaledobject-ingester"
+	baseCommonSpec := v1.JaegerCommonSpec{
+		Labels: labels,
+	}
+	commonSpec := util.Merge([]v1.JaegerCommonSpec{i.commonSpec(), i.jaeger.Spec.JaegerCommonSpec, baseCommonSpec})
+
+	return []kedav1alpha1.ScaledObject{{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ScaledObject",
+			APIVersion: kedav1alpha1.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            i.name(),
+			Namespace:       i.jaeger.Namespace,
+			Labels:          commonSpec.Labels,
+			Annotations:     commonSpec.Annotations,
+			OwnerReferences: []metav1.OwnerReference{util.AsOwner(i.jaeger)},
+		},
+		Spec: kedav1alpha1.ScaledObjectSpec{
+			ScaleTargetRef: &kedav1alpha1.ScaleTarget{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       i.name(),
+			},
+			PollingInterval: spec.PollingInterval,
+			CooldownPeriod:  spec.CooldownPeriod,
+			MinReplicaCount: minReplicas,
+			MaxReplicaCount: &maxReplicas,
+			Triggers:        []kedav1alpha1.ScaleTriggers{trigger},
+		},
+	}}
+}
@@ -0,0 +1,266 @@
+package deployment
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/operator-framework/operator-lib/proxy"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/account"
+	"github.com/jaegertracing/jaeger-operator/pkg/config/ca"
+	"github.com/jaegertracing/jaeger-operator/pkg/config/otelcol"
+	configmap "github.com/jaegertracing/jaeger-operator/pkg/config/ui"
+	"github.com/jaegertracing/jaeger-operator/pkg/service"
+	"github.com/jaegertracing/jaeger-operator/pkg/util"
+)
+
+// OTelCollector builds pods for the OpenTelemetry Collector based Jaeger v2 distribution
+type OTelCollector struct {
+	jaeger *v1.Jaeger
+}
+
+// NewOTelCollector builds a new OTelCollector struct based on the given spec
+func NewOTelCollector(jaeger *v1.Jaeger) *OTelCollector {
+	return &OTelCollector{jaeger: jaeger}
+}
+
+// Get returns a deployment running the Jaeger v2 binary, which receives, stores and queries the spans
+func (c *OTelCollector) Get() *appsv1.Deployment {
+	c.jaeger.Logger().V(-1).Info("assembling an OpenTelemetry Collector deployment")
+
+	trueVar := true
+	falseVar := false
+
+	baseCommonSpec := v1.JaegerCommonSpec{
+		Annotations: map[string]string{
+			"prometheus.io/scrape": "true",
+			"prometheus.io/port":   strconv.Itoa(otelcol.MetricsPort),
+			"linkerd.io/inject":    "disabled",
+		},
+		Labels: c.labels(),
+	}
+
+	commonSpec := util.Merge([]v1.JaegerCommonSpec{c.jaeger.Spec.Collector.JaegerCommonSpec, c.jaeger.Spec.JaegerCommonSpec, baseCommonSpec})
+	_, ok := commonSpec.Annotations["sidecar.istio.io/inject"]
+	if !ok {
+		commonSpec.Annotations["sidecar.istio.io/inject"] = "false"
+	}
+
+	options := []string{}
+	otelcol.Update(c.jaeger, commonSpec, &options)
+	// the UI configuration is referenced from within the collector configuration, so we only need the volume
+	configmap.Update(c.jaeger, commonSpec, &[]string{})
+	ca.Update(c.jaeger, commonSpec)
+	ca.AddServiceCA(c.jaeger, commonSpec)
+
+	// ensure we have a consistent order of the arguments
+	// see https://github.com/jaegertracing/jaeger-operator/issues/334
+	sort.Strings(options)
+
+	var envFromSource []corev1.EnvFromSource
+	if len(c.jaeger.Spec.Storage.SecretName) > 0 {
+		envFromSource = append(envFromSource, corev1.EnvFromSource{
+			SecretRef: &corev1.SecretEnvSource{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: c.jaeger.Spec.Storage.SecretName,
+				},
+			},
+		})
+	}
+
+	priorityClassName := ""
+	if c.jaeger.Spec.Collector.PriorityClassName != "" {
+		priorityClassName = c.jaeger.Spec.Collector.PriorityClassName
+	}
+
+	strategy := appsv1.DeploymentStrategy{
+		Type: appsv1.RecreateDeploymentStrategyType,
+	}
+
+	if c.jaeger.Spec.Collector.Strategy != nil {
+		strategy = *c.jaeger.Spec.Collector.Strategy
+	}
+
+	livenessProbe := &corev1.Probe{
+		ProbeHandler: corev1.ProbeHandler{
+			HTTPGet: &corev1.HTTPGetAction{
+				Path: "/status",
+				Port: intstr.FromInt(otelcol.HealthCheckPort),
+			},
+		},
+		InitialDelaySeconds: 5,
+		PeriodSeconds:       15,
+		FailureThreshold:    5,
+	}
+
+	if c.jaeger.Spec.Collector.LivenessProbe != nil {
+		livenessProbe = c.jaeger.Spec.Collector.LivenessProbe
+	}
+
+	ports := []corev1.ContainerPort{
+		{
+			ContainerPort: 4317,
+			Name:          "grpc-otlp",
+		},
+		{
+			ContainerPort: 4318,
+			Name:          "http-otlp",
+		},
+		{
+			ContainerPort: 9411,
+			Name:          "zipkin",
+		},
+		{
+			ContainerPort: 14250,
+			Name:          "grpc",
+		},
+		{
+			ContainerPort: 14268,
+			Name:          "c-binary-trft",
+		},
+		{
+			ContainerPort: 16685,
+			Name:          "grpc-query",
+		},
+		{
+			ContainerPort: 16686,
+			Name:          "query",
+		},
+		{
+			ContainerPort: otelcol.HealthCheckPort,
+			Name:          "healthcheck",
+		},
+		{
+			ContainerPort: otelcol.MetricsPort,
+			Name:          "admin-http",
+		},
+	}
+
+	var nodeSelector map[string]string
+	if c.jaeger.Spec.Collector.NodeSelector != nil {
+		nodeSelector = c.jaeger.Spec.Collector.NodeSelector
+	}
+
+	deployment := &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "apps/v1",
+			Kind:       "Deployment",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        c.name(),
+			Namespace:   c.jaeger.Namespace,
+			Labels:      commonSpec.Labels,
+			Annotations: baseCommonSpec.Annotations,
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: c.jaeger.APIVersion,
+				Kind:       c.jaeger.Kind,
+				Name:       c.jaeger.Name,
+				UID:        c.jaeger.UID,
+				Controller: &trueVar,
+			}},
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: c.jaeger.Spec.Collector.Replicas,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: commonSpec.Labels,
+			},
+			Strategy: strategy,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      commonSpec.Labels,
+					Annotations: commonSpec.Annotations,
+				},
+				Spec: corev1.PodSpec{
+					ImagePullSecrets: commonSpec.ImagePullSecrets,
+					Containers: []corev1.Container{{
+						Image:         util.JaegerV2ImageName(c.jaeger.Spec.Collector.Image, "jaeger-v2-image"),
+						Name:          "jaeger",
+						Args:          options,
+						Env:           append(proxy.ReadProxyVarsFromEnv(), otelcol.Env(c.jaeger)...),
+						VolumeMounts:  commonSpec.VolumeMounts,
+						EnvFrom:       envFromSource,
+						Ports:         ports,
+						LivenessProbe: livenessProbe,
+						ReadinessProbe: &corev1.Probe{
+							ProbeHandler: corev1.ProbeHandler{
+								HTTPGet: &corev1.HTTPGetAction{
+									Path: "/status",
+									Port: intstr.FromInt(otelcol.HealthCheckPort),
+								},
+							},
+							InitialDelaySeconds: 1,
+						},
+						Lifecycle:       c.jaeger.Spec.Collector.Lifecycle,
+						Resources:       commonSpec.Resources,
+						ImagePullPolicy: commonSpec.ImagePullPolicy,
+						SecurityContext: commonSpec.ContainerSecurityContext,
+					}},
+					PriorityClassName:             priorityClassName,
+					Volumes:                       commonSpec.Volumes,
+					ServiceAccountName:            account.JaegerServiceAccountFor(c.jaeger, account.CollectorComponent),
+					Affinity:                      commonSpec.Affinity,
+					Tolerations:                   commonSpec.Tolerations,
+					SecurityContext:               commonSpec.SecurityContext,
+					EnableServiceLinks:            &falseVar,
+					TerminationGracePeriodSeconds: c.jaeger.Spec.Collector.TerminationGracePeriodSeconds,
+				},
+			},
+		},
+	}
+	if nodeSelector != nil {
+		deployment.Spec.Template.Spec.NodeSelector = nodeSelector
+	}
+	return deployment
+}
+
+// Services returns the collector and query services, both backed by the OpenTelemetry Collector pods
+func (c *OTelCollector) Services() []*corev1.Service {
+	labels := c.labels()
+	return append(service.NewCollectorServices(c.jaeger, labels),
+		service.NewQueryService(c.jaeger, labels),
+	)
+}
+
+// Autoscalers returns a list of HPAs based on this collector
+func (c *OTelCollector) Autoscalers() []runtime.Object {
+	return autoscalers(c)
+}
+
+func (c *OTelCollector) labels() map[string]string {
+	return util.Labels(c.name(), "otel-collector", *c.jaeger)
+}
+
+func (c *OTelCollector) hpaLabels() map[string]string {
+	labels := c.labels()
+	labels["app.kubernetes.io/component"] = "hpa-otel-collector"
+	return labels
+}
+
+// the name differs from the one used by the collector, as the label selector of an existing
+// collector deployment can't be changed when migrating from the production strategy
+func (c *OTelCollector) name() string {
+	return fmt.Sprintf("%s-otel-collector", c.jaeger.Name)
+}
+
+func (c *OTelCollector) commonSpec() v1.JaegerCommonSpec {
+	return c.jaeger.Spec.Collector.JaegerCommonSpec
+}
+
+func (c *OTelCollector) autoscalingSpec() v1.AutoScaleSpec {
+	return c.jaeger.Spec.Collector.AutoScaleSpec
+}
+
+func (c *OTelCollector) jaegerInstance() *v1.Jaeger {
+	return c.jaeger
+}
+
+func (c *OTelCollector) replicas() *int32 {
+	return c.jaeger.Spec.Collector.Replicas
+}
@@ -0,0 +1,108 @@
+package deployment
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/version"
+)
+
+func TestOTelCollectorDefaults(t *testing.T) {
+	viper.Set("jaeger-v2-image", "jaegertracing/jaeger")
+	defer viper.Reset()
+
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+
+	dep := NewOTelCollector(jaeger).Get()
+
+	assert.Equal(t, "my-instance-otel-collector", dep.Name)
+	assert.Nil(t, dep.Spec.Replicas)
+	assert.Equal(t, "otel-collector", dep.Spec.Template.Labels["app.kubernetes.io/component"])
+
+	require.Len(t, dep.Spec.Template.Spec.Containers, 1)
+	container := dep.Spec.Template.Spec.Containers[0]
+	assert.Equal(t, fmt.Sprintf("jaegertracing/jaeger:%s", version.Get().JaegerV2), container.Image)
+	assert.Equal(t, []string{"--config=/etc/jaeger/config.yaml"}, container.Args)
+	assert.Equal(t, "/status", container.ReadinessProbe.HTTPGet.Path)
+	assert.Equal(t, int32(13133), container.ReadinessProbe.HTTPGet.Port.IntVal)
+
+	require.Len(t, dep.Spec.Template.Spec.Volumes, 1)
+	assert.Equal(t, "my-instance-otel-collector-configuration", dep.Spec.Template.Spec.Volumes[0].ConfigMap.Name)
+	assert.Equal(t, "/etc/jaeger", container.VolumeMounts[0].MountPath)
+}
+
+func TestOTelCollectorWithCollectorSpec(t *testing.T) {
+	replicas := int32(3)
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Collector.Image = "org/custom-jaeger:2.0.0"
+	jaeger.Spec.Collector.Replicas = &replicas
+	jaeger.Spec.Collector.NodeSelector = map[string]string{"disktype": "ssd"}
+	jaeger.Spec.Storage.SecretName = "es-secret"
+
+	dep := NewOTelCollector(jaeger).Get()
+
+	assert.Equal(t, &replicas, dep.Spec.Replicas)
+	assert.Equal(t, "org/custom-jaeger:2.0.0", dep.Spec.Template.Spec.Containers[0].Image)
+	assert.Equal(t, map[string]string{"disktype": "ssd"}, dep.Spec.Template.Spec.NodeSelector)
+	assert.Equal(t, "es-secret", dep.Spec.Template.Spec.Containers[0].EnvFrom[0].SecretRef.Name)
+}
+
+func TestOTelCollectorMountsUIConfig(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.UI.Options = v1.NewFreeForm(map[string]interface{}{
+		"tracking": map[string]interface{}{
+			"gaID": "UA-000000-2",
+		},
+	})
+
+	dep := NewOTelCollector(jaeger).Get()
+
+	// the UI config is referenced from the collector configuration, not from a flag
+	assert.Equal(t, []string{"--config=/etc/jaeger/config.yaml"}, dep.Spec.Template.Spec.Containers[0].Args)
+	volumes := []string{}
+	for _, vol := range dep.Spec.Template.Spec.Volumes {
+		volumes = append(volumes, vol.Name)
+	}
+	assert.Contains(t, volumes, "my-instance-ui-configuration-volume")
+}
+
+func TestOTelCollectorServices(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+
+	svcs := NewOTelCollector(jaeger).Services()
+
+	require.Len(t, svcs, 3) // headless collector, collector and query
+	for _, svc := range svcs {
+		assert.Equal(t, "otel-collector", svc.Spec.Selector["app.kubernetes.io/component"])
+	}
+	assert.Equal(t, "my-instance-query", svcs[2].Name)
+}
+
+func TestOTelCollectorAutoscalers(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	assert.Len(t, NewOTelCollector(jaeger).Autoscalers(), 1)
+
+	replicas := int32(2)
+	jaeger.Spec.Collector.Replicas = &replicas
+	assert.Empty(t, NewOTelCollector(jaeger).Autoscalers())
+}
+
+func TestOTelCollectorHealthCheckPortExposed(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+
+	ports := map[string]int32{}
+	for _, port := range NewOTelCollector(jaeger).Get().Spec.Template.Spec.Containers[0].Ports {
+		ports[port.Name] = port.ContainerPort
+	}
+
+	assert.Equal(t, int32(13133), ports["healthcheck"])
+	assert.Equal(t, int32(14269), ports["admin-http"])
+	assert.Equal(t, int32(4317), ports["grpc-otlp"])
+	assert.Equal(t, int32(16686), ports["query"])
+}
@@ -0,0 +1,87 @@
+package deployment
+
+import (
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/util"
+)
+
+// disruptable is a component whose replicas can be protected by a pod disruption budget
+type disruptable interface {
+	name() string
+	labels() map[string]string
+	replicas() *int32
+	commonSpec() v1.JaegerCommonSpec
+	podDisruptionBudgetSpec() *v1.JaegerPodDisruptionBudgetSpec
+	jaegerInstance() *v1.Jaeger
+}
+
+// podDisruptionBudget returns the PDB for the given component, or nil when it isn't needed
+func podDisruptionBudget(component disruptable) *policyv1.PodDisruptionBudget {
+	spec := component.podDisruptionBudgetSpec()
+	if spec == nil {
+		spec = &v1.JaegerPodDisruptionBudgetSpec{}
+	}
+
+	if spec.Enabled != nil && !*spec.Enabled {
+		return nil
+	}
+
+	// by default, a budget only makes sense when there's more than one replica to keep around
+	configured := spec.MinAvailable != nil || spec.MaxUnavailable != nil
+	if spec.Enabled == nil && !configured && expectedReplicas(component) <= 1 {
+		return nil
+	}
+
+	pdbSpec := policyv1.PodDisruptionBudgetSpec{
+		Selector: &metav1.LabelSelector{
+			MatchLabels: component.labels(),
+		},
+		MinAvailable:   spec.MinAvailable,
+		MaxUnavailable: spec.MaxUnavailable,
+	}
+	if !configured {
+		maxUnavailable := intstr.FromInt32(1)
+		pdbSpec.MaxUnavailable = &maxUnavailable
+	}
+
+	jaeger := component.jaegerInstance()
+	baseCommonSpec := v1.JaegerCommonSpec{
+		Labels: component.labels(),
+	}
+	commonSpec := util.Merge([]v1.JaegerCommonSpec{component.commonSpec(), jaeger.Spec.JaegerCommonSpec, baseCommonSpec})
+
+	return &policyv1.PodDisruptionBudget{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "PodDisruptionBudget",
+			APIVersion: "policy/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            component.name(),
+			Namespace:       jaeger.Namespace,
+			Labels:          commonSpec.Labels,
+			Annotations:     commonSpec.Annotations,
+			OwnerReferences: []metav1.OwnerReference{util.AsOwner(jaeger)},
+		},
+		Spec: pdbSpec,
+	}
+}
+
+// expectedReplicas returns the number of replicas the component runs with at the least
+func expectedReplicas(component disruptable) int32 {
+	if r := component.replicas(); r != nil {
+		return *r
+	}
+
+	if autoscaled, ok := component.(interface{ autoscalingSpec() v1.AutoScaleSpec }); ok {
+		spec := autoscaled.autoscalingSpec()
+		if (spec.Autoscale == nil || *spec.Autoscale) && spec.MinReplicas != nil {
+			return *spec.MinReplicas
+		}
+	}
+
+	return 1
+}
@@ -0,0 +1,94 @@
+package deployment
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+)
+
+func TestPodDisruptionBudgetNotNeededForSingleReplica(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+
+	assert.Nil(t, NewCollector(jaeger).PodDisruptionBudget())
+	assert.Nil(t, NewQuery(jaeger).PodDisruptionBudget())
+	assert.Nil(t, NewIngester(jaeger).PodDisruptionBudget())
+}
+
+func TestPodDisruptionBudgetDefaultForReplicas(t *testing.T) {
+	// prepare
+	replicas := int32(3)
+	minReplicas := int32(2)
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance", Namespace: "observability"})
+	jaeger.Spec.Query.Replicas = &replicas
+	jaeger.Spec.Collector.MinReplicas = &minReplicas
+
+	// test
+	pdbs := map[string]*policyv1.PodDisruptionBudget{
+		"query":     NewQuery(jaeger).PodDisruptionBudget(),
+		"collector": NewCollector(jaeger).PodDisruptionBudget(),
+	}
+
+	// verify
+	for component, p := range pdbs {
+		require.NotNil(t, p, component)
+		assert.Equal(t, "my-instance-"+component, p.Name)
+		assert.Equal(t, "observability", p.Namespace)
+		assert.Equal(t, component, p.Spec.Selector.MatchLabels["app.kubernetes.io/component"])
+		assert.Equal(t, "my-instance", p.Spec.Selector.MatchLabels["app.kubernetes.io/instance"])
+		assert.Equal(t, intstr.FromInt32(1), *p.Spec.MaxUnavailable)
+		assert.Nil(t, p.Spec.MinAvailable)
+		assert.Len(t, p.OwnerReferences, 1)
+	}
+}
+
+func TestPodDisruptionBudgetConfigured(t *testing.T) {
+	// prepare
+	minAvailable := intstr.FromString("50%")
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Strategy = v1.DeploymentStrategyStreaming
+	jaeger.Spec.Ingester.PodDisruptionBudget = &v1.JaegerPodDisruptionBudgetSpec{
+		MinAvailable: &minAvailable,
+	}
+
+	// test
+	p := NewIngester(jaeger).PodDisruptionBudget()
+
+	// verify
+	require.NotNil(t, p)
+	assert.Equal(t, "my-instance-ingester", p.Name)
+	assert.Equal(t, &minAvailable, p.Spec.MinAvailable)
+	assert.Nil(t, p.Spec.MaxUnavailable)
+}
+
+func TestPodDisruptionBudgetEnabled(t *testing.T) {
+	// prepare
+	replicas := int32(3)
+	trueVar := true
+	falseVar := false
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Query.PodDisruptionBudget = &v1.JaegerPodDisruptionBudgetSpec{Enabled: &trueVar}
+	jaeger.Spec.Collector.Replicas = &replicas
+	jaeger.Spec.Collector.PodDisruptionBudget = &v1.JaegerPodDisruptionBudgetSpec{Enabled: &falseVar}
+
+	// test and verify
+	assert.NotNil(t, NewQuery(jaeger).PodDisruptionBudget(), "explicitly enabled with a single replica")
+	assert.Nil(t, NewCollector(jaeger).PodDisruptionBudget(), "explicitly disabled with many replicas")
+}
+
+func TestPodDisruptionBudgetIgnoresMinReplicasWithoutAutoscaling(t *testing.T) {
+	// prepare
+	minReplicas := int32(2)
+	falseVar := false
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Collector.MinReplicas = &minReplicas
+	jaeger.Spec.Collector.Autoscale = &falseVar
+
+	// test and verify
+	assert.Nil(t, NewCollector(jaeger).PodDisruptionBudget())
+}
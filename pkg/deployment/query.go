@@ -8,6 +8,7 @@ import (
 	"github.com/operator-framework/operator-lib/proxy"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 
@@ -176,7 +177,7 @@ func (q *Query) Get() *appsv1.Deployment {
 				Spec: corev1.PodSpec{
 					ImagePullSecrets: q.jaeger.Spec.ImagePullSecrets,
 					Containers: []corev1.Container{{
-						Image:        util.ImageName(q.jaeger.Spec.Query.Image, "jaeger-query-image"),
+						Image:        util.InstanceImageName(*q.jaeger, q.jaeger.Spec.Query.Image, "jaeger-query-image"),
 						Name:         "jaeger-query",
 						Args:         options,
 						Env:          envVars,
@@ -236,6 +237,11 @@ func (q *Query) Services() []*corev1.Service {
 	}
 }
 
+// PodDisruptionBudget returns the PDB protecting the query replicas, if any
+func (q *Query) PodDisruptionBudget() *policyv1.PodDisruptionBudget {
+	return podDisruptionBudget(q)
+}
+
 func (q *Query) labels() map[string]string {
 	return util.Labels(q.name(), "query", *q.jaeger)
 }
@@ -243,3 +249,19 @@ func (q *Query) labels() map[string]string {
 func (q *Query) name() string {
 	return fmt.Sprintf("%s-query", q.jaeger.Name)
 }
+
+func (q *Query) replicas() *int32 {
+	return q.jaeger.Spec.Query.Replicas
+}
+
+func (q *Query) commonSpec() v1.JaegerCommonSpec {
+	return q.jaeger.Spec.Query.JaegerCommonSpec
+}
+
+func (q *Query) jaegerInstance() *v1.Jaeger {
+	return q.jaeger
+}
+
+func (q *Query) podDisruptionBudgetSpec() *v1.JaegerPodDisruptionBudgetSpec {
+	return q.jaeger.Spec.Query.PodDisruptionBudget
+}
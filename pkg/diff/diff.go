@@ -0,0 +1,217 @@
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Fields is a set of fields of an object, in the format of the managed fields: every field is a key of the map,
+// prefixed with "f:" for the fields of a map, "k:", "v:" or "i:" for the items of a list, holding the set of its own fields
+type Fields map[string]interface{}
+
+// Changes returns a field-level diff between the before and after representations of an object, as produced by
+// its JSON or unstructured form, one line per added, removed or changed field. The paths of the fields are prefixed
+// with the given path, when not empty.
+func Changes(path string, before interface{}, after interface{}) []string {
+	w := &walker{all: true}
+	w.walk(path, before, after, nil)
+	return w.lines
+}
+
+// Updates returns a field-level diff between the current and desired representations of an object, like Changes,
+// but ignoring the fields that are set only in the current one, unless they are part of the given owned fields: the
+// other ones are typically defaults or fields set by other controllers, which are left alone when the object is applied,
+// while the owned ones are removed.
+func Updates(path string, current interface{}, desired interface{}, owned Fields) []string {
+	w := &walker{}
+	w.walk(path, current, desired, owned)
+	return w.lines
+}
+
+// OwnedFields returns the fields the given field manager applied to the object, which server-side apply removes
+// from the object when they aren't part of the next apply request
+func OwnedFields(obj client.Object, manager string) Fields {
+	owned := Fields{}
+	for _, entry := range obj.GetManagedFields() {
+		if entry.Manager != manager || entry.Operation != metav1.ManagedFieldsOperationApply || entry.FieldsV1 == nil {
+			continue
+		}
+		fields := Fields{}
+		if err := json.Unmarshal(entry.FieldsV1.Raw, &fields); err != nil {
+			continue
+		}
+		owned.merge(fields)
+	}
+	return owned
+}
+
+// Kind returns the kind of the given object, also for typed objects whose type meta isn't set
+func Kind(obj client.Object) string {
+	if kind := obj.GetObjectKind().GroupVersionKind().Kind; len(kind) > 0 {
+		return kind
+	}
+	return reflect.Indirect(reflect.ValueOf(obj)).Type().Name()
+}
+
+type walker struct {
+	// all reports the fields removed from the before object, even when they aren't owned
+	all   bool
+	lines []string
+}
+
+func (w *walker) walk(path string, before interface{}, after interface{}, owned Fields) {
+	switch a := after.(type) {
+	case map[string]interface{}:
+		b, ok := before.(map[string]interface{})
+		if !ok {
+			break
+		}
+		for _, k := range keys(b, a) {
+			p := k
+			if len(path) > 0 {
+				p = fmt.Sprintf("%s.%s", path, k)
+			}
+			bv, bfound := b[k]
+			av, afound := a[k]
+			field, isOwned := owned.child("f:" + k)
+			switch {
+			case !bfound:
+				if !isEmpty(av) {
+					w.lines = append(w.lines, fmt.Sprintf("  + %s: %s", p, formatValue(av)))
+				}
+			case !afound:
+				if w.all || isOwned {
+					w.lines = append(w.lines, fmt.Sprintf("  - %s: %s", p, formatValue(bv)))
+				}
+			default:
+				w.walk(p, bv, av, field)
+			}
+		}
+		return
+	case []interface{}:
+		b, ok := before.([]interface{})
+		if !ok {
+			break
+		}
+		for i := 0; i < len(b) || i < len(a); i++ {
+			p := fmt.Sprintf("%s[%d]", path, i)
+			switch {
+			case i >= len(b):
+				w.lines = append(w.lines, fmt.Sprintf("  + %s: %s", p, formatValue(a[i])))
+			case i >= len(a):
+				w.lines = append(w.lines, fmt.Sprintf("  - %s: %s", p, formatValue(b[i])))
+			default:
+				w.walk(p, b[i], a[i], owned.item(i, b[i]))
+			}
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(before, after) {
+		w.lines = append(w.lines, fmt.Sprintf("  ~ %s: %s -> %s", path, formatValue(before), formatValue(after)))
+	}
+}
+
+// child returns the owned fields of the given field, and whether the field is owned at all
+func (f Fields) child(key string) (Fields, bool) {
+	c, ok := f[key]
+	if !ok {
+		return nil, false
+	}
+	m, _ := c.(map[string]interface{})
+	return m, true
+}
+
+// item returns the owned fields of the given item of a list, identified by its keys, its value or its index
+func (f Fields) item(index int, item interface{}) Fields {
+	if c, ok := f.child("i:" + strconv.Itoa(index)); ok {
+		return c
+	}
+	if c, ok := f.child("v:" + formatValue(item)); ok {
+		return c
+	}
+
+	fields, ok := item.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	for key := range f {
+		if !strings.HasPrefix(key, "k:") {
+			continue
+		}
+		itemKeys := map[string]interface{}{}
+		if err := json.Unmarshal([]byte(strings.TrimPrefix(key, "k:")), &itemKeys); err != nil {
+			continue
+		}
+		matches := true
+		for name, value := range itemKeys {
+			if formatValue(fields[name]) != formatValue(value) {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			c, _ := f.child(key)
+			return c
+		}
+	}
+	return nil
+}
+
+// merge adds the given fields to the set
+func (f Fields) merge(other Fields) {
+	for key, value := range other {
+		existing, ok := f.child(key)
+		nested, isMap := value.(map[string]interface{})
+		if !ok || existing == nil || !isMap {
+			f[key] = value
+			continue
+		}
+		existing.merge(nested)
+	}
+}
+
+// keys returns the sorted keys of both maps
+func keys(before map[string]interface{}, after map[string]interface{}) []string {
+	set := map[string]struct{}{}
+	for k := range after {
+		set[k] = struct{}{}
+	}
+	for k := range before {
+		set[k] = struct{}{}
+	}
+
+	sorted := make([]string, 0, len(set))
+	for k := range set {
+		sorted = append(sorted, k)
+	}
+	sort.Strings(sorted)
+	return sorted
+}
+
+func isEmpty(v interface{}) bool {
+	switch t := v.(type) {
+	case nil:
+		return true
+	case map[string]interface{}:
+		return len(t) == 0
+	case []interface{}:
+		return len(t) == 0
+	}
+	return false
+}
+
+func formatValue(v interface{}) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(b)
+}
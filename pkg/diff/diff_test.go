@@ -0,0 +1,103 @@
+package diff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestChanges(t *testing.T) {
+	// prepare
+	before := map[string]interface{}{
+		"strategy": "allinone",
+		"removed":  "value",
+		"args":     []interface{}{"--a", "--b"},
+	}
+	after := map[string]interface{}{
+		"strategy": "production",
+		"added":    true,
+		"args":     []interface{}{"--a"},
+	}
+
+	// test
+	lines := Changes("spec", before, after)
+
+	// verify
+	assert.Equal(t, []string{
+		`  + spec.added: true`,
+		`  - spec.args[1]: "--b"`,
+		`  - spec.removed: "value"`,
+		`  ~ spec.strategy: "allinone" -> "production"`,
+	}, lines)
+}
+
+func TestUpdatesIgnoresCurrentOnlyFields(t *testing.T) {
+	// prepare
+	current := map[string]interface{}{
+		"replicas":         int64(1),
+		"progressDeadline": int64(600),
+	}
+	desired := map[string]interface{}{
+		"replicas": int64(2),
+		"empty":    map[string]interface{}{},
+	}
+
+	// test
+	lines := Updates("", current, desired, nil)
+
+	// verify
+	assert.Equal(t, []string{`  ~ replicas: 1 -> 2`}, lines)
+}
+
+func TestUpdatesListsOwnedFieldsRemoved(t *testing.T) {
+	// prepare
+	current := map[string]interface{}{
+		"labels": map[string]interface{}{"owned": "true", "other": "true"},
+		"ports":  []interface{}{map[string]interface{}{"name": "http", "port": int64(80), "nodePort": int64(30080)}},
+	}
+	desired := map[string]interface{}{
+		"labels": map[string]interface{}{},
+		"ports":  []interface{}{map[string]interface{}{"name": "http"}},
+	}
+	owned := Fields{
+		"f:labels": map[string]interface{}{"f:owned": map[string]interface{}{}},
+		"f:ports": map[string]interface{}{
+			`k:{"name":"http"}`: map[string]interface{}{"f:port": map[string]interface{}{}},
+		},
+	}
+
+	// test
+	lines := Updates("", current, desired, owned)
+
+	// verify
+	assert.Equal(t, []string{
+		`  - labels.owned: "true"`,
+		`  - ports[0].port: 80`,
+	}, lines)
+}
+
+func TestOwnedFields(t *testing.T) {
+	// prepare
+	dep := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{ManagedFields: []metav1.ManagedFieldsEntry{
+		{Manager: "jaeger-operator", Operation: metav1.ManagedFieldsOperationApply, FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:metadata":{"f:labels":{"f:a":{}}}}`)}},
+		{Manager: "jaeger-operator", Operation: metav1.ManagedFieldsOperationApply, FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:metadata":{"f:labels":{"f:b":{}}}}`)}},
+		{Manager: "jaeger-operator", Operation: metav1.ManagedFieldsOperationUpdate, FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:metadata":{"f:labels":{"f:c":{}}}}`)}},
+		{Manager: "kubectl", Operation: metav1.ManagedFieldsOperationApply, FieldsV1: &metav1.FieldsV1{Raw: []byte(`{"f:metadata":{"f:labels":{"f:d":{}}}}`)}},
+	}}}
+
+	// test
+	owned := OwnedFields(dep, "jaeger-operator")
+
+	// verify
+	assert.Equal(t, Fields{"f:metadata": map[string]interface{}{"f:labels": map[string]interface{}{
+		"f:a": map[string]interface{}{},
+		"f:b": map[string]interface{}{},
+	}}}, owned)
+}
+
+func TestKind(t *testing.T) {
+	assert.Equal(t, "Deployment", Kind(&appsv1.Deployment{}))
+	assert.Equal(t, "MyKind", Kind(&appsv1.Deployment{TypeMeta: metav1.TypeMeta{Kind: "MyKind"}}))
+}
@@ -0,0 +1,55 @@
+package inject
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+)
+
+// Allowed checks whether the Jaeger instance accepts sidecars from the given workload, based on the
+// namespaces and workloads allowed by spec.agent.injection. When the workload isn't allowed, the
+// returned string holds the reason.
+func Allowed(jaeger *v1.Jaeger, w *Workload, ns *corev1.Namespace) (bool, string) {
+	if w.Namespace == jaeger.Namespace {
+		return true, ""
+	}
+
+	spec := jaeger.Spec.Agent.Injection
+	if !namespaceAllowed(spec, w.Namespace, ns) {
+		return false, fmt.Sprintf("the Jaeger instance %s/%s doesn't accept sidecars from the namespace %s", jaeger.Namespace, jaeger.Name, w.Namespace)
+	}
+
+	if spec.WorkloadSelector != nil && !selectorMatches(spec.WorkloadSelector, w.Labels) {
+		return false, fmt.Sprintf("the Jaeger instance %s/%s doesn't accept sidecars from the workload %s/%s", jaeger.Namespace, jaeger.Name, w.Namespace, w.Name)
+	}
+
+	return true, ""
+}
+
+func namespaceAllowed(spec v1.JaegerAgentInjectionSpec, name string, ns *corev1.Namespace) bool {
+	if len(spec.Namespaces) == 0 && spec.NamespaceSelector == nil {
+		return true
+	}
+
+	for _, allowed := range spec.Namespaces {
+		if allowed == name {
+			return true
+		}
+	}
+
+	// the namespace labels are only known when the namespace object could be obtained
+	return spec.NamespaceSelector != nil && ns.Name == name && selectorMatches(spec.NamespaceSelector, ns.Labels)
+}
+
+func selectorMatches(selector *metav1.LabelSelector, set map[string]string) bool {
+	s, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		// invalid selectors are refused by the validating webhook, so we just play safe here
+		return false
+	}
+	return s.Matches(labels.Set(set))
+}
@@ -0,0 +1,92 @@
+package inject
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+)
+
+func TestAllowed(t *testing.T) {
+	tenantSelector := &metav1.LabelSelector{MatchLabels: map[string]string{"tenant": "a"}}
+
+	for _, tt := range []struct {
+		name      string
+		injection v1.JaegerAgentInjectionSpec
+		workload  string
+		labels    map[string]string
+		nsLabels  map[string]string
+		allowed   bool
+		reason    string
+	}{
+		{
+			name:     "no restrictions",
+			workload: "team-b",
+			allowed:  true,
+		},
+		{
+			name:      "same namespace is always allowed",
+			injection: v1.JaegerAgentInjectionSpec{Namespaces: []string{"team-a"}, WorkloadSelector: tenantSelector},
+			workload:  "observability",
+			allowed:   true,
+		},
+		{
+			name:      "namespace listed",
+			injection: v1.JaegerAgentInjectionSpec{Namespaces: []string{"team-a", "team-b"}},
+			workload:  "team-b",
+			allowed:   true,
+		},
+		{
+			name:      "namespace not listed",
+			injection: v1.JaegerAgentInjectionSpec{Namespaces: []string{"team-a"}},
+			workload:  "team-b",
+			reason:    "the Jaeger instance observability/my-instance doesn't accept sidecars from the namespace team-b",
+		},
+		{
+			name:      "namespace selected",
+			injection: v1.JaegerAgentInjectionSpec{NamespaceSelector: tenantSelector},
+			workload:  "team-b",
+			nsLabels:  map[string]string{"tenant": "a"},
+			allowed:   true,
+		},
+		{
+			name:      "namespace not selected",
+			injection: v1.JaegerAgentInjectionSpec{NamespaceSelector: tenantSelector},
+			workload:  "team-b",
+			nsLabels:  map[string]string{"tenant": "b"},
+			reason:    "the Jaeger instance observability/my-instance doesn't accept sidecars from the namespace team-b",
+		},
+		{
+			name:      "workload not selected",
+			injection: v1.JaegerAgentInjectionSpec{WorkloadSelector: tenantSelector},
+			workload:  "team-b",
+			labels:    map[string]string{"tenant": "b"},
+			reason:    "the Jaeger instance observability/my-instance doesn't accept sidecars from the workload team-b/my-dep",
+		},
+		{
+			name:      "workload selected",
+			injection: v1.JaegerAgentInjectionSpec{WorkloadSelector: tenantSelector},
+			workload:  "team-b",
+			labels:    map[string]string{"tenant": "a"},
+			allowed:   true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance", Namespace: "observability"})
+			jaeger.Spec.Agent.Injection = tt.injection
+
+			dep := dep(map[string]string{}, tt.labels)
+			dep.Name = "my-dep"
+			dep.Namespace = tt.workload
+			ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: tt.workload, Labels: tt.nsLabels}}
+
+			allowed, reason := Allowed(jaeger, FromDeployment(dep), ns)
+			assert.Equal(t, tt.allowed, allowed)
+			assert.Equal(t, tt.reason, reason)
+		})
+	}
+}
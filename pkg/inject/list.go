@@ -0,0 +1,151 @@
+package inject
+
+import (
+	"context"
+
+	"github.com/spf13/viper"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/autodetect"
+)
+
+// RolloutGroupVersionKind is the kind of the Argo Rollouts, for which we don't have the Go types
+var RolloutGroupVersionKind = schema.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "Rollout"}
+
+// ObjectWorkload is a workload backed by an object listed from the cluster
+type ObjectWorkload struct {
+	*Workload
+
+	// Kind is the lower case kind of the object, as used in logs and events
+	Kind string
+
+	object client.Object
+	sync   func() error
+}
+
+// NewObjectWorkload returns the given workload, backed by the given object of the given kind
+func NewObjectWorkload(kind string, object client.Object, w *Workload) *ObjectWorkload {
+	return &ObjectWorkload{Workload: w, Kind: kind, object: object}
+}
+
+// Object returns the object backing the workload, including the changes made to the workload
+func (w *ObjectWorkload) Object() (client.Object, error) {
+	if w.sync != nil {
+		if err := w.sync(); err != nil {
+			return nil, err
+		}
+	}
+	return w.object, nil
+}
+
+// ListWorkloads lists the workloads matching the given options of every kind whose sidecar is reconciled by the webhook
+// when the workload is updated: Deployments, StatefulSets, DaemonSets, CronJobs and Argo Rollouts, when available.
+// Jobs are left out, as their pod template can't be updated.
+func ListWorkloads(ctx context.Context, c client.Reader, opts ...client.ListOption) ([]*ObjectWorkload, error) {
+	var workloads []*ObjectWorkload
+
+	deployments := &appsv1.DeploymentList{}
+	if err := c.List(ctx, deployments, opts...); err != nil {
+		return nil, err
+	}
+	for i := range deployments.Items {
+		dep := &deployments.Items[i]
+		workloads = append(workloads, NewObjectWorkload("deployment", dep, FromDeployment(dep)))
+	}
+
+	statefulSets := &appsv1.StatefulSetList{}
+	if err := c.List(ctx, statefulSets, opts...); err != nil {
+		return nil, err
+	}
+	for i := range statefulSets.Items {
+		ss := &statefulSets.Items[i]
+		workloads = append(workloads, NewObjectWorkload("statefulset", ss, NewWorkload(&ss.ObjectMeta, &ss.Spec.Template)))
+	}
+
+	daemonSets := &appsv1.DaemonSetList{}
+	if err := c.List(ctx, daemonSets, opts...); err != nil {
+		return nil, err
+	}
+	for i := range daemonSets.Items {
+		ds := &daemonSets.Items[i]
+		workloads = append(workloads, NewObjectWorkload("daemonset", ds, NewWorkload(&ds.ObjectMeta, &ds.Spec.Template)))
+	}
+
+	if viper.GetString(v1.FlagCronJobsVersion) != v1.FlagCronJobsVersionBatchV1Beta1 {
+		// the sidecar is injected only into batch/v1 cron jobs
+		cronJobs := &batchv1.CronJobList{}
+		if err := c.List(ctx, cronJobs, opts...); err != nil {
+			return nil, err
+		}
+		for i := range cronJobs.Items {
+			cj := &cronJobs.Items[i]
+			workloads = append(workloads, NewObjectWorkload("cronjob", cj, NewBatchWorkload(&cj.ObjectMeta, &cj.Spec.JobTemplate.Spec.Template)))
+		}
+	}
+
+	if autodetect.OperatorConfiguration.IsArgoRolloutsAvailable() {
+		rollouts := &unstructured.UnstructuredList{}
+		rollouts.SetGroupVersionKind(RolloutGroupVersionKind.GroupVersion().WithKind(RolloutGroupVersionKind.Kind + "List"))
+		if err := c.List(ctx, rollouts, opts...); err != nil {
+			return nil, err
+		}
+		for i := range rollouts.Items {
+			w, err := fromRollout(&rollouts.Items[i])
+			if err != nil {
+				return nil, err
+			}
+			if w != nil {
+				workloads = append(workloads, w)
+			}
+		}
+	}
+
+	return workloads, nil
+}
+
+// fromRollout returns the workload backed by the given Argo Rollout, whose pod template is converted from and back into
+// the unstructured object, or nil for rollouts using a workloadRef, which have no template of their own
+func fromRollout(rollout *unstructured.Unstructured) (*ObjectWorkload, error) {
+	raw, found, err := unstructured.NestedMap(rollout.Object, "spec", "template")
+	if err != nil || !found {
+		return nil, err
+	}
+
+	template := &corev1.PodTemplateSpec{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw, template); err != nil {
+		return nil, err
+	}
+
+	meta := &metav1.ObjectMeta{
+		Name:        rollout.GetName(),
+		Namespace:   rollout.GetNamespace(),
+		Labels:      rollout.GetLabels(),
+		Annotations: rollout.GetAnnotations(),
+	}
+
+	return &ObjectWorkload{
+		Workload: NewWorkload(meta, template),
+		Kind:     "rollout",
+		object:   rollout,
+		sync: func() error {
+			updated, err := runtime.DefaultUnstructuredConverter.ToUnstructured(template)
+			if err != nil {
+				return err
+			}
+			if err := unstructured.SetNestedMap(rollout.Object, updated, "spec", "template"); err != nil {
+				return err
+			}
+			rollout.SetLabels(meta.Labels)
+			rollout.SetAnnotations(meta.Annotations)
+			return nil
+		},
+	}, nil
+}
@@ -0,0 +1,131 @@
+package inject
+
+import (
+	"context"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/jaegertracing/jaeger-operator/pkg/autodetect"
+)
+
+func TestListWorkloads(t *testing.T) {
+	// prepare
+	autodetect.OperatorConfiguration.SetArgoRolloutsAvailability(autodetect.ArgoRolloutsAvailabilityYes)
+	defer viper.Reset()
+
+	s := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(s))
+	s.AddKnownTypeWithName(RolloutGroupVersionKind, &unstructured.Unstructured{})
+	s.AddKnownTypeWithName(RolloutGroupVersionKind.GroupVersion().WithKind("RolloutList"), &unstructured.UnstructuredList{})
+
+	meta := func(name string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{Name: name, Namespace: "my-ns", Annotations: map[string]string{Annotation: "true"}}
+	}
+	rollout := &unstructured.Unstructured{}
+	rollout.SetGroupVersionKind(RolloutGroupVersionKind)
+	rollout.SetName("my-rollout")
+	rollout.SetNamespace("my-ns")
+	rollout.SetAnnotations(map[string]string{Annotation: "true"})
+	require.NoError(t, unstructured.SetNestedMap(rollout.Object, map[string]interface{}{
+		"spec": map[string]interface{}{"containers": []interface{}{map[string]interface{}{"name": "app"}}},
+	}, "spec", "template"))
+
+	cl := fake.NewClientBuilder().WithScheme(s).WithObjects(
+		&appsv1.Deployment{ObjectMeta: meta("my-dep")},
+		&appsv1.StatefulSet{ObjectMeta: meta("my-sts")},
+		&appsv1.DaemonSet{ObjectMeta: meta("my-ds")},
+		&batchv1.CronJob{ObjectMeta: meta("my-cj")},
+		&batchv1.Job{ObjectMeta: meta("my-job")},
+		rollout,
+	).Build()
+
+	// test
+	workloads, err := ListWorkloads(context.Background(), cl, client.InNamespace("my-ns"))
+
+	// verify
+	require.NoError(t, err)
+	kinds := map[string]string{}
+	for _, w := range workloads {
+		kinds[w.Kind] = w.Name
+	}
+	assert.Equal(t, map[string]string{
+		"deployment":  "my-dep",
+		"statefulset": "my-sts",
+		"daemonset":   "my-ds",
+		"cronjob":     "my-cj",
+		"rollout":     "my-rollout",
+	}, kinds)
+}
+
+func TestListedRolloutWritesChangesBack(t *testing.T) {
+	// prepare
+	rollout := &unstructured.Unstructured{}
+	rollout.SetGroupVersionKind(RolloutGroupVersionKind)
+	rollout.SetName("my-rollout")
+	rollout.SetAnnotations(map[string]string{Annotation: "true"})
+	require.NoError(t, unstructured.SetNestedMap(rollout.Object, map[string]interface{}{
+		"spec": map[string]interface{}{"containers": []interface{}{map[string]interface{}{"name": "app"}}},
+	}, "spec", "template"))
+
+	w, err := fromRollout(rollout)
+	require.NoError(t, err)
+
+	// test
+	IncreaseRevision(w.Annotations)
+	w.PodSpec.Containers = append(w.PodSpec.Containers, corev1.Container{Name: "jaeger-agent"})
+	obj, err := w.Object()
+
+	// verify
+	require.NoError(t, err)
+	assert.Equal(t, "0", obj.GetAnnotations()[AnnotationRev])
+	containers, _, _ := unstructured.NestedSlice(obj.(*unstructured.Unstructured).Object, "spec", "template", "spec", "containers")
+	assert.Len(t, containers, 2)
+}
+
+func TestListWorkloadsSkipsRolloutsWithoutTemplate(t *testing.T) {
+	// prepare
+	rollout := &unstructured.Unstructured{}
+	rollout.SetGroupVersionKind(RolloutGroupVersionKind)
+	rollout.SetName("my-rollout")
+	require.NoError(t, unstructured.SetNestedMap(rollout.Object, map[string]interface{}{
+		"kind": "Deployment",
+		"name": "my-dep",
+	}, "spec", "workloadRef"))
+
+	// test
+	w, err := fromRollout(rollout)
+
+	// verify
+	require.NoError(t, err)
+	assert.Nil(t, w)
+}
+
+func TestListWorkloadsSkipsRolloutsWhenUnavailable(t *testing.T) {
+	// prepare
+	autodetect.OperatorConfiguration.SetArgoRolloutsAvailability(autodetect.ArgoRolloutsAvailabilityNo)
+	defer viper.Reset()
+
+	cl := fake.NewClientBuilder().WithObjects(
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "my-dep", Namespace: "my-ns"}},
+	).Build()
+
+	// test
+	workloads, err := ListWorkloads(context.Background(), cl, client.InNamespace("my-ns"))
+
+	// verify
+	require.NoError(t, err)
+	require.Len(t, workloads, 1)
+	assert.Equal(t, "deployment", workloads[0].Kind)
+}
@@ -0,0 +1,267 @@
+package inject
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/operator-framework/operator-lib/proxy"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/config/otelcol"
+	"github.com/jaegertracing/jaeger-operator/pkg/service"
+	"github.com/jaegertracing/jaeger-operator/pkg/util"
+)
+
+const (
+	agentContainerName         = "jaeger-agent"
+	otelCollectorContainerName = "jaeger-otel-collector"
+
+	envVarOTLPEndpoint    = "OTEL_EXPORTER_OTLP_ENDPOINT"
+	envVarOTLPProtocol    = "OTEL_EXPORTER_OTLP_PROTOCOL"
+	envVarOTelServiceName = "OTEL_SERVICE_NAME"
+)
+
+// Mode returns the sidecar mode for the given deployment: the annotation on the deployment has
+// precedence over the mode set on the Jaeger instance, which defaults to the jaeger-agent sidecar
+func Mode(jaeger *v1.Jaeger, dep *appsv1.Deployment) v1.AgentSidecarMode {
+	return ModeForWorkload(jaeger, FromDeployment(dep))
+}
+
+// ModeForWorkload returns the sidecar mode for the given workload, following the same precedence as Mode
+func ModeForWorkload(jaeger *v1.Jaeger, w *Workload) v1.AgentSidecarMode {
+	for _, mode := range []v1.AgentSidecarMode{
+		v1.AgentSidecarMode(strings.ToLower(w.Annotations[AnnotationMode])),
+		jaeger.Spec.Agent.SidecarMode,
+	} {
+		switch mode {
+		case v1.AgentSidecarModeAgent, v1.AgentSidecarModeOTLP, v1.AgentSidecarModeCollector:
+			return mode
+		}
+	}
+	return v1.AgentSidecarModeAgent
+}
+
+// otlpEndpoint returns the OTLP endpoint the workloads should export to: the collector sidecar
+// when there's one, or the collector service from the Jaeger instance otherwise
+func otlpEndpoint(jaeger *v1.Jaeger, mode v1.AgentSidecarMode) string {
+	if mode == v1.AgentSidecarModeCollector {
+		return "http://localhost:4317"
+	}
+	return fmt.Sprintf("http://%s.%s.svc:4317", service.GetNameForCollectorService(jaeger), jaeger.Namespace)
+}
+
+// injectOTLPEnv adds the environment variables used by the OpenTelemetry SDKs to the workload's containers,
+// respecting the values provided by the user. The names of the variables added to each container are recorded in an
+// annotation, so that they can be removed when the mode changes or when the injection isn't desired anymore.
+func injectOTLPEnv(jaeger *v1.Jaeger, w *Workload, mode v1.AgentSidecarMode, opts ...Options) {
+	sideCarOpt := &SidecarOptions{}
+	for _, opt := range opts {
+		opt(sideCarOpt)
+	}
+
+	envs := []corev1.EnvVar{
+		{
+			Name:  envVarOTLPEndpoint,
+			Value: otlpEndpoint(jaeger, mode),
+		},
+		{
+			Name:  envVarOTLPProtocol,
+			Value: "grpc",
+		},
+	}
+	if app, found := serviceName(w); found {
+		envs = append(envs, corev1.EnvVar{
+			Name:  envVarOTelServiceName,
+			Value: app,
+		})
+	}
+
+	managed := map[string][]string{}
+	for i := range w.PodSpec.Containers {
+		container := &w.PodSpec.Containers[i]
+		if isSidecarContainer(container.Name) {
+			continue
+		}
+		for _, env := range envs {
+			if hasEnv(env.Name, container.Env) || haskeyInEnvFromConfigMaps(env.Name, sideCarOpt.EnvConfigMaps) {
+				continue
+			}
+			container.Env = append(container.Env, env)
+			managed[container.Name] = append(managed[container.Name], env.Name)
+		}
+	}
+
+	if len(managed) == 0 {
+		return
+	}
+	if w.Annotations == nil {
+		w.Annotations = map[string]string{}
+	}
+	w.Annotations[annotationManagedEnv] = formatManagedEnv(managed)
+}
+
+// removeOTLPEnv removes the environment variables that have been added by injectOTLPEnv, leaving alone the ones
+// with the same names set by the user in other containers
+func removeOTLPEnv(w *Workload) {
+	value, ok := w.Annotations[annotationManagedEnv]
+	if !ok {
+		return
+	}
+	managed := parseManagedEnv(value)
+
+	for i := range w.PodSpec.Containers {
+		container := &w.PodSpec.Containers[i]
+		names, found := managed[container.Name]
+		if !found {
+			continue
+		}
+		var envs []corev1.EnvVar
+		for _, env := range container.Env {
+			if !slices.Contains(names, env.Name) {
+				envs = append(envs, env)
+			}
+		}
+		container.Env = envs
+	}
+	delete(w.Annotations, annotationManagedEnv)
+}
+
+// formatManagedEnv returns the value of the annotation recording the variables added to each container, like
+// "app=OTEL_EXPORTER_OTLP_ENDPOINT,OTEL_EXPORTER_OTLP_PROTOCOL;worker=OTEL_EXPORTER_OTLP_PROTOCOL"
+func formatManagedEnv(managed map[string][]string) string {
+	entries := make([]string, 0, len(managed))
+	for container, names := range managed {
+		sorted := append([]string{}, names...)
+		sort.Strings(sorted)
+		entries = append(entries, fmt.Sprintf("%s=%s", container, strings.Join(sorted, ",")))
+	}
+	sort.Strings(entries)
+	return strings.Join(entries, ";")
+}
+
+// parseManagedEnv returns the variables added to each container from the value of the annotation
+func parseManagedEnv(value string) map[string][]string {
+	managed := map[string][]string{}
+	for _, entry := range strings.Split(value, ";") {
+		container, names, found := strings.Cut(entry, "=")
+		if !found {
+			continue
+		}
+		managed[container] = strings.Split(names, ",")
+	}
+	return managed
+}
+
+// otelCollectorContainer returns an OpenTelemetry Collector container receiving OTLP data on localhost
+// and forwarding it to the collector from the given Jaeger instance
+func otelCollectorContainer(jaeger *v1.Jaeger, w *Workload) corev1.Container {
+	config := map[string]interface{}{
+		"receivers": map[string]interface{}{
+			"otlp": map[string]interface{}{
+				"protocols": map[string]interface{}{
+					"grpc": map[string]interface{}{"endpoint": "localhost:4317"},
+					"http": map[string]interface{}{"endpoint": "localhost:4318"},
+				},
+			},
+		},
+		"processors": map[string]interface{}{
+			"batch": map[string]interface{}{},
+		},
+		"exporters": map[string]interface{}{
+			"otlp": map[string]interface{}{
+				"endpoint":      fmt.Sprintf("dns:///%s.%s.svc:4317", service.GetNameForHeadlessCollectorService(jaeger), jaeger.Namespace),
+				"balancer_name": "round_robin",
+				"tls": map[string]interface{}{
+					"insecure": true,
+				},
+			},
+		},
+		"extensions": map[string]interface{}{
+			"healthcheckv2": map[string]interface{}{
+				"use_v2": true,
+				"http":   map[string]interface{}{"endpoint": fmt.Sprintf("0.0.0.0:%d", otelcol.HealthCheckPort)},
+			},
+		},
+		"service": map[string]interface{}{
+			"extensions": []string{"healthcheckv2"},
+			"pipelines": map[string]interface{}{
+				"traces": map[string]interface{}{
+					"receivers":  []string{"otlp"},
+					"processors": []string{"batch"},
+					"exporters":  []string{"otlp"},
+				},
+			},
+			"telemetry": map[string]interface{}{
+				"metrics": map[string]interface{}{"level": "none"},
+			},
+		},
+	}
+	// the configuration is passed inline to the collector's YAML provider, JSON being valid YAML
+	inline, _ := json.Marshal(config)
+
+	commonSpec := util.Merge([]v1.JaegerCommonSpec{jaeger.Spec.Agent.JaegerCommonSpec, jaeger.Spec.JaegerCommonSpec})
+	w.PodSpec.ImagePullSecrets = util.RemoveDuplicatedImagePullSecrets(append(w.PodSpec.ImagePullSecrets, jaeger.Spec.Agent.ImagePullSecrets...))
+
+	containerDefinition := corev1.Container{
+		Image:           util.JaegerV2ImageName("", "jaeger-v2-image"),
+		Name:            otelCollectorContainerName,
+		Args:            []string{fmt.Sprintf("--config=yaml:%s", inline)},
+		Env:             proxy.ReadProxyVarsFromEnv(),
+		Resources:       commonSpec.Resources,
+		SecurityContext: jaeger.Spec.Agent.SidecarSecurityContext,
+	}
+
+	if isContainerPortAvailable(otelcol.HealthCheckPort, w) {
+		containerDefinition.Ports = []corev1.ContainerPort{{
+			ContainerPort: otelcol.HealthCheckPort,
+			Name:          "healthcheck",
+		}}
+		containerDefinition.LivenessProbe = &corev1.Probe{
+			ProbeHandler: corev1.ProbeHandler{
+				HTTPGet: &corev1.HTTPGetAction{
+					Path: "/status",
+					Port: intstr.FromInt(otelcol.HealthCheckPort),
+				},
+			},
+			InitialDelaySeconds: 5,
+			PeriodSeconds:       15,
+			FailureThreshold:    5,
+		}
+		containerDefinition.ReadinessProbe = &corev1.Probe{
+			ProbeHandler: corev1.ProbeHandler{
+				HTTPGet: &corev1.HTTPGetAction{
+					Path: "/status",
+					Port: intstr.FromInt(otelcol.HealthCheckPort),
+				},
+			},
+			InitialDelaySeconds: 1,
+		}
+	}
+
+	return containerDefinition
+}
+
+func isSidecarContainer(name string) bool {
+	return name == agentContainerName || name == otelCollectorContainerName
+}
+
+func containerIndex(w *Workload, name string) int {
+	for i, container := range w.PodSpec.Containers {
+		if container.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func removeContainer(w *Workload, name string) {
+	if i := containerIndex(w, name); i > -1 {
+		w.PodSpec.Containers = append(w.PodSpec.Containers[:i], w.PodSpec.Containers[i+1:]...)
+	}
+}
@@ -0,0 +1,158 @@
+package inject
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+)
+
+func envMap(envs []corev1.EnvVar) map[string]string {
+	m := map[string]string{}
+	for _, env := range envs {
+		m[env.Name] = env.Value
+	}
+	return m
+}
+
+func TestMode(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	assert.Equal(t, v1.AgentSidecarModeAgent, Mode(jaeger, dep(map[string]string{}, map[string]string{})))
+
+	jaeger.Spec.Agent.SidecarMode = v1.AgentSidecarModeOTLP
+	assert.Equal(t, v1.AgentSidecarModeOTLP, Mode(jaeger, dep(map[string]string{}, map[string]string{})))
+
+	// the annotation has precedence
+	assert.Equal(t, v1.AgentSidecarModeCollector, Mode(jaeger, dep(map[string]string{AnnotationMode: "Collector"}, map[string]string{})))
+
+	// unknown values are ignored
+	assert.Equal(t, v1.AgentSidecarModeOTLP, Mode(jaeger, dep(map[string]string{AnnotationMode: "unknown"}, map[string]string{})))
+}
+
+func TestInjectOTLPMode(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance", Namespace: "observability"})
+	jaeger.Spec.Agent.SidecarMode = v1.AgentSidecarModeOTLP
+
+	dep := dep(map[string]string{}, map[string]string{"app": "testapp"})
+	dep.Namespace = "my-ns"
+	dep = Sidecar(jaeger, dep)
+
+	assert.Equal(t, jaeger.Name, dep.Labels[Label])
+	require.Len(t, dep.Spec.Template.Spec.Containers, 1)
+	envs := envMap(dep.Spec.Template.Spec.Containers[0].Env)
+	assert.Equal(t, "http://my-instance-collector.observability.svc:4317", envs["OTEL_EXPORTER_OTLP_ENDPOINT"])
+	assert.Equal(t, "grpc", envs["OTEL_EXPORTER_OTLP_PROTOCOL"])
+	assert.Equal(t, "testapp.my-ns", envs["OTEL_SERVICE_NAME"])
+	assert.True(t, HasSidecar(dep))
+
+	// no agent, so nothing to scrape
+	assert.NotContains(t, dep.Annotations, "prometheus.io/scrape")
+}
+
+func TestInjectOTLPModeRespectsUserEnv(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+
+	dep := dep(map[string]string{AnnotationMode: "otlp"}, map[string]string{})
+	dep.Spec.Template.Spec.Containers[0].Env = []corev1.EnvVar{{
+		Name:  "OTEL_EXPORTER_OTLP_ENDPOINT",
+		Value: "http://my-collector:4317",
+	}}
+	dep = Sidecar(jaeger, dep)
+
+	envs := envMap(dep.Spec.Template.Spec.Containers[0].Env)
+	assert.Equal(t, "http://my-collector:4317", envs["OTEL_EXPORTER_OTLP_ENDPOINT"])
+	assert.Equal(t, "only_container=OTEL_EXPORTER_OTLP_PROTOCOL", dep.Annotations[annotationManagedEnv])
+
+	// cleaning up keeps what the user provided
+	CleanSidecar(jaeger.Name, dep)
+	assert.Equal(t, []corev1.EnvVar{{
+		Name:  "OTEL_EXPORTER_OTLP_ENDPOINT",
+		Value: "http://my-collector:4317",
+	}}, dep.Spec.Template.Spec.Containers[0].Env)
+	assert.False(t, HasSidecar(dep))
+}
+
+func TestCleanOTLPModeKeepsUserEnvInOtherContainers(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+
+	dep := dep(map[string]string{AnnotationMode: "otlp"}, map[string]string{})
+	dep.Spec.Template.Spec.Containers = append(dep.Spec.Template.Spec.Containers, corev1.Container{
+		Name: "worker",
+		Env: []corev1.EnvVar{
+			{Name: "OTEL_EXPORTER_OTLP_ENDPOINT", Value: "http://my-collector:4317"},
+			{Name: "OTEL_EXPORTER_OTLP_PROTOCOL", Value: "http/protobuf"},
+		},
+	})
+	dep = Sidecar(jaeger, dep)
+	assert.Equal(t, "only_container=OTEL_EXPORTER_OTLP_ENDPOINT,OTEL_EXPORTER_OTLP_PROTOCOL", dep.Annotations[annotationManagedEnv])
+
+	// test
+	CleanSidecar(jaeger.Name, dep)
+
+	// verify
+	assert.Empty(t, dep.Spec.Template.Spec.Containers[0].Env)
+	assert.Equal(t, []corev1.EnvVar{
+		{Name: "OTEL_EXPORTER_OTLP_ENDPOINT", Value: "http://my-collector:4317"},
+		{Name: "OTEL_EXPORTER_OTLP_PROTOCOL", Value: "http/protobuf"},
+	}, dep.Spec.Template.Spec.Containers[1].Env)
+}
+
+func TestInjectCollectorMode(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance", Namespace: "observability"})
+
+	dep := dep(map[string]string{AnnotationMode: "collector"}, map[string]string{})
+	dep = Sidecar(jaeger, dep)
+
+	require.Len(t, dep.Spec.Template.Spec.Containers, 2)
+	assert.Equal(t, "http://localhost:4317", envMap(dep.Spec.Template.Spec.Containers[0].Env)["OTEL_EXPORTER_OTLP_ENDPOINT"])
+
+	sidecar := dep.Spec.Template.Spec.Containers[1]
+	assert.Equal(t, "jaeger-otel-collector", sidecar.Name)
+	assert.Empty(t, envMap(sidecar.Env)["OTEL_EXPORTER_OTLP_ENDPOINT"])
+	require.Len(t, sidecar.Args, 1)
+	assert.True(t, strings.HasPrefix(sidecar.Args[0], "--config=yaml:{"))
+	assert.Contains(t, sidecar.Args[0], `"endpoint":"dns:///my-instance-collector-headless.observability.svc:4317"`)
+	for _, port := range sidecar.Ports {
+		assert.NotEqual(t, corev1.ProtocolUDP, port.Protocol)
+	}
+	assert.NotNil(t, sidecar.ReadinessProbe)
+
+	// a second injection updates the existing sidecar
+	dep = Sidecar(jaeger, dep)
+	assert.Len(t, dep.Spec.Template.Spec.Containers, 2)
+	assert.NotNil(t, dep.Spec.Template.Spec.Containers[1].ReadinessProbe)
+}
+
+func TestSwitchSidecarModes(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance", Namespace: "observability"})
+
+	// starts with the agent
+	dep := Sidecar(jaeger, dep(map[string]string{}, map[string]string{}))
+	require.Len(t, dep.Spec.Template.Spec.Containers, 2)
+	assert.Equal(t, "jaeger-agent", dep.Spec.Template.Spec.Containers[1].Name)
+
+	// switches to the collector sidecar
+	dep.Annotations[AnnotationMode] = "collector"
+	dep = Sidecar(jaeger, dep)
+	require.Len(t, dep.Spec.Template.Spec.Containers, 2)
+	assert.Equal(t, "jaeger-otel-collector", dep.Spec.Template.Spec.Containers[1].Name)
+
+	// then to the OTLP environment variables only, replacing the endpoint pointing to the sidecar
+	dep.Annotations[AnnotationMode] = "otlp"
+	dep = Sidecar(jaeger, dep)
+	require.Len(t, dep.Spec.Template.Spec.Containers, 1)
+	assert.Equal(t, "http://my-instance-collector.observability.svc:4317", envMap(dep.Spec.Template.Spec.Containers[0].Env)["OTEL_EXPORTER_OTLP_ENDPOINT"])
+
+	// and back to the agent, without the OTLP variables
+	delete(dep.Annotations, AnnotationMode)
+	dep = Sidecar(jaeger, dep)
+	require.Len(t, dep.Spec.Template.Spec.Containers, 2)
+	assert.Equal(t, "jaeger-agent", dep.Spec.Template.Spec.Containers[1].Name)
+	assert.Empty(t, dep.Spec.Template.Spec.Containers[0].Env)
+	assert.NotContains(t, dep.Annotations, annotationManagedEnv)
+}
@@ -3,6 +3,7 @@ package inject
 import (
 	"fmt"
 	"reflect"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -26,10 +27,14 @@ var (
 	AnnotationRev = "sidecar.jaegertracing.io/revision"
 	// Annotation is the annotation name to look for when deciding whether or not to inject
 	Annotation = "sidecar.jaegertracing.io/inject"
-	// Label is the label name the operator put on injected deployments.
+	// Label is the label name the operator put on injected workloads.
 	Label = "sidecar.jaegertracing.io/injected"
 	// AnnotationLegacy holds the annotation name we had in the past, which we keep for backwards compatibility
 	AnnotationLegacy = "inject-jaeger-agent"
+	// AnnotationMode is the annotation name to look for when deciding what to inject, overriding the mode from the Jaeger instance
+	AnnotationMode = "sidecar.jaegertracing.io/mode"
+	// annotationManagedEnv holds the names of the environment variables the operator added to each of the workload's containers
+	annotationManagedEnv = "sidecar.jaegertracing.io/managed-env"
 	// PrometheusDefaultAnnotations is a map containing annotations for prometheus to be inserted at sidecar in case it doesn't have any
 	PrometheusDefaultAnnotations = map[string]string{
 		"prometheus.io/scrape": "true",
@@ -58,49 +63,80 @@ func WithEnvFromConfigMaps(configMaps []corev1.ConfigMap) Options {
 
 // Sidecar adds a new container to the deployment, connecting to the given jaeger instance
 func Sidecar(jaeger *v1.Jaeger, dep *appsv1.Deployment, opts ...Options) *appsv1.Deployment {
+	SidecarForWorkload(jaeger, FromDeployment(dep), opts...)
+	return dep
+}
+
+// SidecarForWorkload injects the sidecar into the workload's pod spec, connecting to the given jaeger instance
+func SidecarForWorkload(jaeger *v1.Jaeger, w *Workload, opts ...Options) {
 	deployment.NewAgent(jaeger) // we need some initialization from that, but we don't actually need the agent's instance here
-	logFields := jaeger.Logger().WithValues("deployment", dep.Name)
+	logFields := jaeger.Logger().WithValues("workload", w.Name)
 
 	if jaeger == nil {
 		logFields.V(-2).Info("no Jaeger instance found, skipping sidecar injection")
-		return dep
+		return
 	}
 
-	if val, ok := dep.Labels[Label]; ok && val != jaeger.Name {
-		logFields.V(-2).Info("deployment is assigned to a different Jaeger instance, skipping sidecar injection")
-		return dep
+	if val, ok := w.Labels[Label]; ok && val != jaeger.Name {
+		logFields.V(-2).Info("workload is assigned to a different Jaeger instance, skipping sidecar injection")
+		return
 	}
-	decorate(dep, opts...)
-	hasAgent, agentContainerIndex := HasJaegerAgent(dep)
-	logFields.V(-1).Info("injecting sidecar")
-	if hasAgent { // This is an update
-		dep.Spec.Template.Spec.Containers[agentContainerIndex] = container(jaeger, dep, agentContainerIndex)
-	} else {
-		dep.Spec.Template.Spec.Containers = append(dep.Spec.Template.Spec.Containers, container(jaeger, dep, -1))
+	// native sidecars are updated like regular containers, and moved back once injected
+	fromNativeSidecars(w)
+
+	mode := ModeForWorkload(jaeger, w)
+	decorate(w, mode, opts...)
+
+	// clean up what a previous mode might have injected
+	removeOTLPEnv(w)
+
+	logFields.V(-1).Info("injecting sidecar", "mode", mode)
+	switch mode {
+	case v1.AgentSidecarModeOTLP:
+		removeContainer(w, agentContainerName)
+		removeContainer(w, otelCollectorContainerName)
+		injectOTLPEnv(jaeger, w, mode, opts...)
+	case v1.AgentSidecarModeCollector:
+		removeContainer(w, agentContainerName)
+		injectOTLPEnv(jaeger, w, mode, opts...)
+		if idx := containerIndex(w, otelCollectorContainerName); idx > -1 { // This is an update
+			w.PodSpec.Containers[idx] = otelCollectorContainer(jaeger, w)
+		} else {
+			w.PodSpec.Containers = append(w.PodSpec.Containers, otelCollectorContainer(jaeger, w))
+		}
+	default:
+		removeContainer(w, otelCollectorContainerName)
+		if agentContainerIndex := containerIndex(w, agentContainerName); agentContainerIndex > -1 { // This is an update
+			w.PodSpec.Containers[agentContainerIndex] = container(jaeger, w, agentContainerIndex)
+		} else {
+			w.PodSpec.Containers = append(w.PodSpec.Containers, container(jaeger, w, -1))
+		}
+	}
+
+	if w.Batch {
+		toNativeSidecars(w)
 	}
 
 	jaegerName := util.Truncate(jaeger.Name, 63)
 
-	if dep.Labels == nil {
-		dep.Labels = map[string]string{Label: jaegerName}
+	if w.Labels == nil {
+		w.Labels = map[string]string{Label: jaegerName}
 	} else {
-		dep.Labels[Label] = jaegerName
+		w.Labels[Label] = jaegerName
 	}
-
-	return dep
 }
 
-// Desired determines whether a sidecar is desired, based on the annotation from both the deployment and the namespace
-func desired(dep *appsv1.Deployment, ns *corev1.Namespace) bool {
+// Desired determines whether a sidecar is desired, based on the annotation from both the workload and the namespace
+func desired(w *Workload, ns *corev1.Namespace) bool {
 	logger := log.Log.WithValues(
-		"namespace", dep.Namespace,
-		"deployment", dep.Name,
+		"namespace", w.Namespace,
+		"workload", w.Name,
 	)
-	depAnnotationValue, depExist := dep.Annotations[Annotation]
+	depAnnotationValue, depExist := w.Annotations[Annotation]
 	nsAnnotationValue, nsExist := ns.Annotations[Annotation]
 
 	if depExist && !strings.EqualFold(depAnnotationValue, "false") {
-		logger.V(-1).Info("annotation present on deployment")
+		logger.V(-1).Info("annotation present on workload")
 		return true
 	}
 
@@ -127,22 +163,25 @@ func IncreaseRevision(annotations map[string]string) {
 
 // Needed determines whether a pod needs to get a sidecar injected or not
 func Needed(dep *appsv1.Deployment, ns *corev1.Namespace) bool {
-	if !desired(dep, ns) {
+	return NeededForWorkload(FromDeployment(dep), ns)
+}
+
+// NeededForWorkload determines whether the pods from the given workload need to get a sidecar injected or not
+func NeededForWorkload(w *Workload, ns *corev1.Namespace) bool {
+	if !desired(w, ns) {
 		return false
 	}
 
 	// do not inject jaeger due to port collision
-	// do not inject if deployment's Annotation value is false
-	if dep.Labels["app"] == "jaeger" && dep.Labels["app.kubernetes.io/component"] != "query" {
+	// do not inject if workload's Annotation value is false
+	if w.Labels["app"] == "jaeger" && w.Labels["app.kubernetes.io/component"] != "query" {
 		return false
 	}
 
-	hasAgent, _ := HasJaegerAgent(dep)
-
-	if hasAgent {
+	if HasSidecarForWorkload(w) {
 		// has already a sidecar injected and managed by the operator
 		// return true because could require an update.
-		_, hasLabel := dep.Labels[Label]
+		_, hasLabel := w.Labels[Label]
 		return hasLabel
 	}
 	// If no agent but has annotations
@@ -151,11 +190,16 @@ func Needed(dep *appsv1.Deployment, ns *corev1.Namespace) bool {
 
 // Select a suitable Jaeger from the JaegerList for the given Pod, or nil of none is suitable
 func Select(target *appsv1.Deployment, ns *corev1.Namespace, availableJaegerPods *v1.JaegerList) *v1.Jaeger {
+	return SelectForWorkload(FromDeployment(target), ns, availableJaegerPods)
+}
+
+// SelectForWorkload selects a suitable Jaeger from the JaegerList for the given workload, or nil of none is suitable
+func SelectForWorkload(target *Workload, ns *corev1.Namespace, availableJaegerPods *v1.JaegerList) *v1.Jaeger {
 	jaegerNameDep := target.Annotations[Annotation]
 	jaegerNameNs := ns.Annotations[Annotation]
 
 	if jaegerNameDep != "" && !strings.EqualFold(jaegerNameDep, "true") {
-		// name on the deployment has precedence
+		// name on the workload has precedence
 		if jaeger := getJaeger(target.Namespace, jaegerNameDep, availableJaegerPods); jaeger != nil {
 			return jaeger
 		}
@@ -217,7 +261,7 @@ func getJaeger(deploymentNamespace string, name string, jaegers *v1.JaegerList)
 	return bestCaseCandidate
 }
 
-func container(jaeger *v1.Jaeger, dep *appsv1.Deployment, agentIdx int) corev1.Container {
+func container(jaeger *v1.Jaeger, w *Workload, agentIdx int) corev1.Container {
 	args := jaeger.Spec.Agent.Options.ToArgs()
 	envs := []corev1.EnvVar{
 		{
@@ -261,12 +305,12 @@ func container(jaeger *v1.Jaeger, dep *appsv1.Deployment, agentIdx int) corev1.C
 	if len(util.FindItem("--agent.tags=", args)) == 0 {
 		defaultAgentTagsMap := make(map[string]string)
 		defaultAgentTagsMap["cluster"] = "undefined" // this value isn't currently available
-		defaultAgentTagsMap["deployment.name"] = dep.Name
-		defaultAgentTagsMap["pod.namespace"] = dep.Namespace
+		defaultAgentTagsMap["deployment.name"] = w.Name
+		defaultAgentTagsMap["pod.namespace"] = w.Namespace
 		defaultAgentTagsMap["pod.name"] = fmt.Sprintf("${%s:}", envVarPodName)
 		defaultAgentTagsMap["host.ip"] = fmt.Sprintf("${%s:}", envVarHostIP)
 
-		defaultContainerName := getContainerName(dep.Spec.Template.Spec.Containers, agentIdx)
+		defaultContainerName := getContainerName(w.PodSpec.Containers, agentIdx)
 
 		// if we can deduce the container name from the PodSpec
 		if defaultContainerName != "" {
@@ -274,7 +318,7 @@ func container(jaeger *v1.Jaeger, dep *appsv1.Deployment, agentIdx int) corev1.C
 		}
 
 		if agentIdx > -1 {
-			existingAgentTags := parseAgentTags(dep.Spec.Template.Spec.Containers[agentIdx].Args)
+			existingAgentTags := parseAgentTags(w.PodSpec.Containers[agentIdx].Args)
 			// merge two maps
 			for key, value := range defaultAgentTagsMap {
 				existingAgentTags[key] = value
@@ -298,10 +342,10 @@ func container(jaeger *v1.Jaeger, dep *appsv1.Deployment, agentIdx int) corev1.C
 	// see https://github.com/jaegertracing/jaeger-operator/issues/334
 	sort.Strings(args)
 
-	dep.Spec.Template.Spec.ImagePullSecrets = util.RemoveDuplicatedImagePullSecrets(append(dep.Spec.Template.Spec.ImagePullSecrets, jaeger.Spec.Agent.ImagePullSecrets...))
-	dep.Spec.Template.Spec.Volumes = util.RemoveDuplicatedVolumes(append(dep.Spec.Template.Spec.Volumes, volumesAndMountsSpec.Volumes...))
+	w.PodSpec.ImagePullSecrets = util.RemoveDuplicatedImagePullSecrets(append(w.PodSpec.ImagePullSecrets, jaeger.Spec.Agent.ImagePullSecrets...))
+	w.PodSpec.Volumes = util.RemoveDuplicatedVolumes(append(w.PodSpec.Volumes, volumesAndMountsSpec.Volumes...))
 	containerDefinition := corev1.Container{
-		Image: util.AgentImageName(jaeger.Spec.Agent.Image, "jaeger-agent-image"),
+		Image: util.InstanceAgentImageName(*jaeger, jaeger.Spec.Agent.Image, "jaeger-agent-image"),
 		Name:  "jaeger-agent",
 		Args:  args,
 		Env:   envs,
@@ -335,7 +379,7 @@ func container(jaeger *v1.Jaeger, dep *appsv1.Deployment, agentIdx int) corev1.C
 		VolumeMounts:    volumesAndMountsSpec.VolumeMounts,
 	}
 
-	if isContainerPortAvailable(adminPort, dep) {
+	if isContainerPortAvailable(adminPort, w) {
 		containerDefinition.LivenessProbe = &corev1.Probe{
 			ProbeHandler: corev1.ProbeHandler{
 				HTTPGet: &corev1.HTTPGetAction{
@@ -361,47 +405,62 @@ func container(jaeger *v1.Jaeger, dep *appsv1.Deployment, agentIdx int) corev1.C
 	return containerDefinition
 }
 
-func decorate(dep *appsv1.Deployment, opts ...Options) {
-	app, found := dep.Spec.Template.Labels["app.kubernetes.io/instance"]
+// serviceName returns the service name to be used by the workload's tracers, based on the pod template labels
+func serviceName(w *Workload) (string, bool) {
+	app, found := w.PodLabels["app.kubernetes.io/instance"]
 	if !found {
-		app, found = dep.Spec.Template.Labels["app.kubernetes.io/name"]
+		app, found = w.PodLabels["app.kubernetes.io/name"]
 	}
 	if !found {
-		app, found = dep.Spec.Template.Labels["app"]
+		app, found = w.PodLabels["app"]
 	}
-	if found {
-		// Append the namespace to the app name. Using the DNS style "<app>.<namespace>""
-		// which also matches with the style used in Istio.
-		if len(dep.Namespace) > 0 {
-			app += "." + dep.Namespace
-		} else {
-			app += ".default"
-		}
+	if !found {
+		return "", false
+	}
+
+	// Append the namespace to the app name. Using the DNS style "<app>.<namespace>""
+	// which also matches with the style used in Istio.
+	if len(w.Namespace) > 0 {
+		app += "." + w.Namespace
+	} else {
+		app += ".default"
+	}
+	return app, true
+}
 
+func decorate(w *Workload, mode v1.AgentSidecarMode, opts ...Options) {
+	if app, found := serviceName(w); found {
 		sideCarOpt := &SidecarOptions{}
 		for _, opt := range opts {
 			opt(sideCarOpt)
 		}
 
-		for i := 0; i < len(dep.Spec.Template.Spec.Containers); i++ {
-			if !hasEnv(envVarServiceName, dep.Spec.Template.Spec.Containers[i].Env) && !haskeyInEnvFromConfigMaps(envVarServiceName, sideCarOpt.EnvConfigMaps) {
-				dep.Spec.Template.Spec.Containers[i].Env = append(dep.Spec.Template.Spec.Containers[i].Env, corev1.EnvVar{
+		for i := 0; i < len(w.PodSpec.Containers); i++ {
+			if !hasEnv(envVarServiceName, w.PodSpec.Containers[i].Env) && !haskeyInEnvFromConfigMaps(envVarServiceName, sideCarOpt.EnvConfigMaps) {
+				w.PodSpec.Containers[i].Env = append(w.PodSpec.Containers[i].Env, corev1.EnvVar{
 					Name:  envVarServiceName,
 					Value: app,
 				})
 			}
-			if !hasEnv(envVarPropagation, dep.Spec.Template.Spec.Containers[i].Env) && !haskeyInEnvFromConfigMaps(envVarPropagation, sideCarOpt.EnvConfigMaps) {
-				dep.Spec.Template.Spec.Containers[i].Env = append(dep.Spec.Template.Spec.Containers[i].Env, corev1.EnvVar{
+			if !hasEnv(envVarPropagation, w.PodSpec.Containers[i].Env) && !haskeyInEnvFromConfigMaps(envVarPropagation, sideCarOpt.EnvConfigMaps) {
+				w.PodSpec.Containers[i].Env = append(w.PodSpec.Containers[i].Env, corev1.EnvVar{
 					Name:  envVarPropagation,
 					Value: "jaeger,b3,w3c",
 				})
 			}
 		}
 	}
+	if mode != v1.AgentSidecarModeAgent {
+		// the agent's admin port is the only one we know how to scrape
+		return
+	}
+	if w.Annotations == nil {
+		w.Annotations = map[string]string{}
+	}
 	for key, value := range PrometheusDefaultAnnotations {
-		_, ok := dep.Annotations[key]
+		_, ok := w.Annotations[key]
 		if !ok {
-			dep.Annotations[key] = value
+			w.Annotations[key] = value
 		}
 	}
 }
@@ -427,24 +486,26 @@ func hasEnv(name string, vars []corev1.EnvVar) bool {
 
 // CleanSidecar of  deployments  associated with the jaeger instance.
 func CleanSidecar(instanceName string, deployment *appsv1.Deployment) {
-	delete(deployment.Labels, Label)
-	for c := 0; c < len(deployment.Spec.Template.Spec.Containers); c++ {
-		if deployment.Spec.Template.Spec.Containers[c].Name == "jaeger-agent" {
-			// delete jaeger-agent container
-			deployment.Spec.Template.Spec.Containers = append(deployment.Spec.Template.Spec.Containers[:c], deployment.Spec.Template.Spec.Containers[c+1:]...)
-			break
-		}
-	}
+	CleanSidecarForWorkload(instanceName, FromDeployment(deployment))
+}
+
+// CleanSidecarForWorkload removes the sidecar associated with the jaeger instance from the workload
+func CleanSidecarForWorkload(instanceName string, w *Workload) {
+	delete(w.Labels, Label)
+	fromNativeSidecars(w)
+	removeContainer(w, agentContainerName)
+	removeContainer(w, otelCollectorContainerName)
+	removeOTLPEnv(w)
 	if autodetect.OperatorConfiguration.GetPlatform() == autodetect.OpenShiftPlatform {
 		names := map[string]bool{
 			ca.TrustedCANameFromString(instanceName): true,
 			ca.ServiceCANameFromString(instanceName): true,
 		}
 		// Remove the managed volumes, if present
-		for v := 0; v < len(deployment.Spec.Template.Spec.Volumes); v++ {
-			if _, ok := names[deployment.Spec.Template.Spec.Volumes[v].Name]; ok {
+		for v := 0; v < len(w.PodSpec.Volumes); v++ {
+			if _, ok := names[w.PodSpec.Volumes[v].Name]; ok {
 				// delete managed volume
-				deployment.Spec.Template.Spec.Volumes = append(deployment.Spec.Template.Spec.Volumes[:v], deployment.Spec.Template.Spec.Volumes[v+1:]...)
+				w.PodSpec.Volumes = append(w.PodSpec.Volumes[:v], w.PodSpec.Volumes[v+1:]...)
 				v--
 			}
 		}
@@ -455,24 +516,99 @@ func CleanSidecar(instanceName string, deployment *appsv1.Deployment) {
 func HasJaegerAgent(dep *appsv1.Deployment) (bool, int) {
 	// this pod is annotated, it should have a sidecar
 	// but does it already have one?
-	for i, container := range dep.Spec.Template.Spec.Containers {
-		if container.Name == "jaeger-agent" { // we don't labels/annotations on containers, so, we rely on its name
-			return true, i
+	// we don't labels/annotations on containers, so, we rely on its name
+	i := containerIndex(FromDeployment(dep), agentContainerName)
+	return i > -1, i
+}
+
+// HasSidecar checks whether deployment has anything injected, in any of the sidecar modes:
+// the Jaeger Agent container, the OpenTelemetry Collector container or the OTLP environment variables
+func HasSidecar(dep *appsv1.Deployment) bool {
+	return HasSidecarForWorkload(FromDeployment(dep))
+}
+
+// HasSidecarForWorkload checks whether the workload has anything injected, in any of the sidecar modes
+func HasSidecarForWorkload(w *Workload) bool {
+	if containerIndex(w, agentContainerName) > -1 {
+		return true
+	}
+	if _, ok := w.Annotations[annotationManagedEnv]; ok {
+		return true
+	}
+	if containerIndex(w, otelCollectorContainerName) > -1 {
+		return true
+	}
+	for _, c := range w.PodSpec.InitContainers {
+		if isSidecarContainer(c.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+// toNativeSidecars moves the injected containers to the init containers, restarted until the main containers
+// are done: unlike regular containers, native sidecars don't prevent the pods of batch workloads from completing
+func toNativeSidecars(w *Workload) {
+	always := corev1.ContainerRestartPolicyAlways
+	for _, name := range []string{agentContainerName, otelCollectorContainerName} {
+		if idx := containerIndex(w, name); idx > -1 {
+			c := w.PodSpec.Containers[idx]
+			c.RestartPolicy = &always
+			removeContainer(w, name)
+			w.PodSpec.InitContainers = append(w.PodSpec.InitContainers, c)
 		}
 	}
-	return false, -1
 }
 
-// EqualSidecar check if two deployments sidecar are equal
+// fromNativeSidecars moves the containers injected as native sidecars back to the regular containers
+func fromNativeSidecars(w *Workload) {
+	initContainers := w.PodSpec.InitContainers[:0]
+	for _, c := range w.PodSpec.InitContainers {
+		if isSidecarContainer(c.Name) {
+			c.RestartPolicy = nil
+			w.PodSpec.Containers = append(w.PodSpec.Containers, c)
+			continue
+		}
+		initContainers = append(initContainers, c)
+	}
+	if len(initContainers) == 0 {
+		initContainers = nil
+	}
+	w.PodSpec.InitContainers = initContainers
+}
+
+// EqualSidecar checks whether the sidecars injected into two deployments are equal, in any of the sidecar modes:
+// switching between the agent, the OTLP environment variables and the OpenTelemetry Collector is a change as well
 func EqualSidecar(dep, oldDep *appsv1.Deployment) bool {
-	depHasAgent, depAgentIndex := HasJaegerAgent(dep)
-	oldDepHasAgent, oldDepIndex := HasJaegerAgent(oldDep)
-	if depHasAgent != oldDepHasAgent {
-		return false
+	w, oldW := FromDeployment(dep), FromDeployment(oldDep)
+	for _, name := range []string{agentContainerName, otelCollectorContainerName} {
+		i, oldI := containerIndex(w, name), containerIndex(oldW, name)
+		if (i > -1) != (oldI > -1) {
+			return false
+		}
+		if i > -1 && !reflect.DeepEqual(w.PodSpec.Containers[i], oldW.PodSpec.Containers[oldI]) {
+			return false
+		}
 	}
-	depContainer := dep.Spec.Template.Spec.Containers[depAgentIndex]
-	oldDepContainer := oldDep.Spec.Template.Spec.Containers[oldDepIndex]
-	return reflect.DeepEqual(depContainer, oldDepContainer)
+	return reflect.DeepEqual(managedEnvValues(w), managedEnvValues(oldW))
+}
+
+// managedEnvValues returns the values of the environment variables added by injectOTLPEnv, by container
+func managedEnvValues(w *Workload) map[string]map[string]string {
+	values := map[string]map[string]string{}
+	for container, names := range parseManagedEnv(w.Annotations[annotationManagedEnv]) {
+		idx := containerIndex(w, container)
+		if idx < 0 {
+			continue
+		}
+		values[container] = map[string]string{}
+		for _, env := range w.PodSpec.Containers[idx].Env {
+			if slices.Contains(names, env.Name) {
+				values[container][env.Name] = env.Value
+			}
+		}
+	}
+	return values
 }
 
 func parseAgentTags(args []string) map[string]string {
@@ -512,10 +648,10 @@ func getContainerName(containers []corev1.Container, agentIdx int) string {
 	}
 }
 
-// isContainerPortAvailable checks whether deployment is already using some port
-func isContainerPortAvailable(port int32, dep *appsv1.Deployment) bool {
-	for _, container := range dep.Spec.Template.Spec.Containers {
-		if container.Name != "jaeger-agent" {
+// isContainerPortAvailable checks whether the workload is already using some port
+func isContainerPortAvailable(port int32, w *Workload) bool {
+	for _, container := range w.PodSpec.Containers {
+		if !isSidecarContainer(container.Name) {
 			for _, containerPort := range container.Ports {
 				if port == containerPort.ContainerPort {
 					return false
@@ -528,13 +664,18 @@ func isContainerPortAvailable(port int32, dep *appsv1.Deployment) bool {
 
 // GetConfigMapsMatchedEnvFromInDeployment returns configMap which matches with configMapRef
 func GetConfigMapsMatchedEnvFromInDeployment(dep appsv1.Deployment, configMaps []corev1.ConfigMap) []corev1.ConfigMap {
+	return GetConfigMapsMatchedEnvFrom(&dep.Spec.Template.Spec, configMaps)
+}
+
+// GetConfigMapsMatchedEnvFrom returns the config maps referenced by the containers from the given pod spec
+func GetConfigMapsMatchedEnvFrom(podSpec *corev1.PodSpec, configMaps []corev1.ConfigMap) []corev1.ConfigMap {
 	configMapSearchMap := make(map[string]corev1.ConfigMap)
 	for _, cm := range configMaps {
 		configMapSearchMap[cm.Name] = cm
 	}
 
 	matchedConfigMaps := []corev1.ConfigMap{}
-	for _, container := range dep.Spec.Template.Spec.Containers {
+	for _, container := range podSpec.Containers {
 		for _, envConfigMap := range container.EnvFrom {
 			if envConfigMap.ConfigMapRef == nil {
 				continue
@@ -984,6 +984,26 @@ func TestEqualSidecar(t *testing.T) {
 	assert.False(t, EqualSidecar(dep1, dep3))
 }
 
+func TestEqualSidecarModes(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{
+		Name:      "my-instance",
+		Namespace: "test",
+	})
+
+	agent := Sidecar(jaeger, dep(map[string]string{Annotation: jaeger.Name}, map[string]string{}))
+	otlp := Sidecar(jaeger, dep(map[string]string{Annotation: jaeger.Name, AnnotationMode: "otlp"}, map[string]string{}))
+	collector := Sidecar(jaeger, dep(map[string]string{Annotation: jaeger.Name, AnnotationMode: "collector"}, map[string]string{}))
+
+	assert.False(t, EqualSidecar(agent, otlp))
+	assert.False(t, EqualSidecar(otlp, collector))
+	assert.False(t, EqualSidecar(collector, agent))
+	assert.True(t, EqualSidecar(otlp, Sidecar(jaeger, dep(map[string]string{Annotation: jaeger.Name, AnnotationMode: "otlp"}, map[string]string{}))))
+
+	// the endpoint changes along with the collector of the instance
+	other := v1.NewJaeger(types.NamespacedName{Name: "other-instance", Namespace: "test"})
+	assert.False(t, EqualSidecar(otlp, Sidecar(other, dep(map[string]string{Annotation: other.Name, AnnotationMode: "otlp"}, map[string]string{}))))
+}
+
 func TestInjectSidecarOnOpenShift(t *testing.T) {
 	autodetect.OperatorConfiguration.SetPlatform(autodetect.OpenShiftPlatform)
 	defer viper.Reset()
@@ -0,0 +1,54 @@
+package inject
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Workload is anything running pods the sidecar can be injected into, like a Deployment, a StatefulSet,
+// a Job or a bare Pod. The annotations driving the injection are read from the workload's own metadata,
+// which also receives the label marking the workload as injected.
+type Workload struct {
+	*metav1.ObjectMeta
+
+	// PodLabels holds the labels of the pods, used to determine the service name
+	PodLabels map[string]string
+
+	// PodSpec is the pod spec the sidecar is injected into
+	PodSpec *corev1.PodSpec
+
+	// Batch marks workloads whose pods run to completion, like Jobs and CronJobs: the sidecar is injected into
+	// them as a native sidecar, so that it doesn't keep their pods running once the main containers are done
+	Batch bool
+}
+
+// NewWorkload returns a workload for an object owning the given pod template
+func NewWorkload(meta *metav1.ObjectMeta, template *corev1.PodTemplateSpec) *Workload {
+	return &Workload{
+		ObjectMeta: meta,
+		PodLabels:  template.Labels,
+		PodSpec:    &template.Spec,
+	}
+}
+
+// NewBatchWorkload returns a workload for an object owning the given pod template, whose pods run to completion
+func NewBatchWorkload(meta *metav1.ObjectMeta, template *corev1.PodTemplateSpec) *Workload {
+	w := NewWorkload(meta, template)
+	w.Batch = true
+	return w
+}
+
+// FromDeployment returns a workload backed by the given deployment
+func FromDeployment(dep *appsv1.Deployment) *Workload {
+	return NewWorkload(&dep.ObjectMeta, &dep.Spec.Template)
+}
+
+// FromPod returns a workload backed by the given pod, for pods which aren't managed by a controller
+func FromPod(pod *corev1.Pod) *Workload {
+	return &Workload{
+		ObjectMeta: &pod.ObjectMeta,
+		PodLabels:  pod.Labels,
+		PodSpec:    &pod.Spec,
+	}
+}
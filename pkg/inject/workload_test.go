@@ -0,0 +1,132 @@
+package inject
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+)
+
+func TestSidecarForStatefulSet(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance", Namespace: "observability"})
+	sts := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "my-sts",
+			Namespace:   "my-ns",
+			Annotations: map[string]string{Annotation: "true"},
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"app": "testapp"},
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "only_container"}},
+				},
+			},
+		},
+	}
+
+	w := NewWorkload(&sts.ObjectMeta, &sts.Spec.Template)
+	assert.True(t, NeededForWorkload(w, &corev1.Namespace{}))
+
+	SidecarForWorkload(jaeger, w)
+
+	assert.Equal(t, jaeger.Name, sts.Labels[Label])
+	require.Len(t, sts.Spec.Template.Spec.Containers, 2)
+	assert.Equal(t, "jaeger-agent", sts.Spec.Template.Spec.Containers[1].Name)
+	assert.Contains(t, sts.Spec.Template.Spec.Containers[1].Args, "--agent.tags=cluster=undefined,container.name=only_container,deployment.name=my-sts,host.ip=${HOST_IP:},pod.name=${POD_NAME:},pod.namespace=my-ns")
+	assert.Equal(t, "testapp.my-ns", envMap(sts.Spec.Template.Spec.Containers[0].Env)[envVarServiceName])
+	assert.True(t, HasSidecarForWorkload(w))
+
+	CleanSidecarForWorkload(jaeger.Name, w)
+	assert.NotContains(t, sts.Labels, Label)
+	assert.Len(t, sts.Spec.Template.Spec.Containers, 1)
+	assert.False(t, HasSidecarForWorkload(w))
+}
+
+func TestSidecarForCronJob(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance", Namespace: "observability"})
+	cronJob := &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "my-cronjob",
+			Namespace:   "my-ns",
+			Annotations: map[string]string{Annotation: "true"},
+		},
+		Spec: batchv1.CronJobSpec{
+			JobTemplate: batchv1.JobTemplateSpec{
+				Spec: batchv1.JobSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							InitContainers: []corev1.Container{{Name: "init"}},
+							Containers:     []corev1.Container{{Name: "only_container"}},
+						},
+					},
+				},
+			},
+		},
+	}
+	spec := &cronJob.Spec.JobTemplate.Spec.Template.Spec
+
+	w := NewBatchWorkload(&cronJob.ObjectMeta, &cronJob.Spec.JobTemplate.Spec.Template)
+	SidecarForWorkload(jaeger, w)
+
+	// the agent runs as a native sidecar, which doesn't keep the pods running
+	require.Len(t, spec.Containers, 1)
+	require.Len(t, spec.InitContainers, 2)
+	assert.Equal(t, "init", spec.InitContainers[0].Name)
+	assert.Equal(t, "jaeger-agent", spec.InitContainers[1].Name)
+	require.NotNil(t, spec.InitContainers[1].RestartPolicy)
+	assert.Equal(t, corev1.ContainerRestartPolicyAlways, *spec.InitContainers[1].RestartPolicy)
+	assert.True(t, HasSidecarForWorkload(w))
+
+	// updating the cronjob doesn't add another sidecar, and switching modes replaces it
+	jaeger.Spec.Agent.SidecarMode = v1.AgentSidecarModeCollector
+	SidecarForWorkload(jaeger, w)
+	require.Len(t, spec.Containers, 1)
+	require.Len(t, spec.InitContainers, 2)
+	assert.Equal(t, "jaeger-otel-collector", spec.InitContainers[1].Name)
+	assert.Equal(t, corev1.ContainerRestartPolicyAlways, *spec.InitContainers[1].RestartPolicy)
+
+	CleanSidecarForWorkload(jaeger.Name, w)
+	assert.Len(t, spec.Containers, 1)
+	require.Len(t, spec.InitContainers, 1)
+	assert.Equal(t, "init", spec.InitContainers[0].Name)
+	assert.False(t, HasSidecarForWorkload(w))
+}
+
+func TestSidecarForPod(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Agent.SidecarMode = v1.AgentSidecarModeOTLP
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-pod",
+			Namespace: "my-ns",
+			Labels:    map[string]string{"app": "testapp"},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "only_container"}},
+		},
+	}
+
+	// the namespace annotation is enough, and the pod has no annotations of its own yet
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{Annotation: "true"}}}
+	w := FromPod(pod)
+	assert.True(t, NeededForWorkload(w, ns))
+	assert.Equal(t, jaeger, SelectForWorkload(w, ns, &v1.JaegerList{Items: []v1.Jaeger{*jaeger}}))
+
+	SidecarForWorkload(jaeger, w)
+
+	assert.Equal(t, jaeger.Name, pod.Labels[Label])
+	require.Len(t, pod.Spec.Containers, 1)
+	assert.Equal(t, "testapp.my-ns", envMap(pod.Spec.Containers[0].Env)[envVarOTelServiceName])
+	assert.Contains(t, pod.Annotations, annotationManagedEnv)
+	assert.Equal(t, v1.AgentSidecarModeOTLP, ModeForWorkload(jaeger, w))
+}
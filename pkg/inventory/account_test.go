@@ -9,7 +9,7 @@ import (
 )
 
 func TestAccountInventory(t *testing.T) {
-	trueVar, falseVar := true, false
+	trueVar := true
 
 	toCreate := v1.ServiceAccount{
 		ObjectMeta: metav1.ObjectMeta{
@@ -28,9 +28,6 @@ func TestAccountInventory(t *testing.T) {
 			Annotations: map[string]string{"gopher": "jaeger"},
 			Labels:      map[string]string{"gopher": "jaeger"},
 		},
-		AutomountServiceAccountToken: &falseVar,
-		Secrets:                      []v1.ObjectReference{{Kind: "Secret"}},
-		ImagePullSecrets:             []v1.LocalObjectReference{{Name: "ImagePullSecret"}},
 	}
 	toDelete := v1.ServiceAccount{
 		ObjectMeta: metav1.ObjectMeta{
@@ -50,8 +47,9 @@ func TestAccountInventory(t *testing.T) {
 
 	// we do *not* set this in any of our current service accounts,
 	// but this might be set by the cluster -- in this case,
-	// we keep whatever is there, not touching the fields at all
-	assert.Equal(t, &trueVar, inv.Update[0].AutomountServiceAccountToken)
+	// we leave it out of the applied object, not touching the field at all
+	assert.Nil(t, inv.Update[0].AutomountServiceAccountToken)
+	assert.Equal(t, "jaeger", inv.Update[0].Annotations["gopher"])
 	assert.Empty(t, inv.Update[0].Secrets)
 	assert.Empty(t, inv.Update[0].ImagePullSecrets)
 
@@ -0,0 +1,52 @@
+package inventory
+
+import (
+	"fmt"
+
+	"github.com/jaegertracing/jaeger-operator/pkg/cassandra/v1beta1"
+)
+
+// CassandraDatacenter represents the inventory of cassandra datacenters based on the current and desired states
+type CassandraDatacenter struct {
+	Create []v1beta1.CassandraDatacenter
+	Update []v1beta1.CassandraDatacenter
+	Delete []v1beta1.CassandraDatacenter
+}
+
+// ForCassandraDatacenters builds an inventory of cassandra datacenters based on the existing and desired states
+func ForCassandraDatacenters(existing []v1beta1.CassandraDatacenter, desired []v1beta1.CassandraDatacenter) CassandraDatacenter {
+	update := []v1beta1.CassandraDatacenter{}
+	mcreate := cassandraDatacenterMap(desired)
+	mdelete := cassandraDatacenterMap(existing)
+
+	for k, v := range mcreate {
+		if _, ok := mdelete[k]; ok {
+			// the desired state is applied as is, leaving alone the fields set by others
+			update = append(update, v)
+			delete(mcreate, k)
+			delete(mdelete, k)
+		}
+	}
+
+	return CassandraDatacenter{
+		Create: cassandraDatacenterList(mcreate),
+		Update: update,
+		Delete: cassandraDatacenterList(mdelete),
+	}
+}
+
+func cassandraDatacenterMap(deps []v1beta1.CassandraDatacenter) map[string]v1beta1.CassandraDatacenter {
+	m := map[string]v1beta1.CassandraDatacenter{}
+	for _, d := range deps {
+		m[fmt.Sprintf("%s.%s", d.Namespace, d.Name)] = d
+	}
+	return m
+}
+
+func cassandraDatacenterList(m map[string]v1beta1.CassandraDatacenter) []v1beta1.CassandraDatacenter {
+	l := []v1beta1.CassandraDatacenter{}
+	for _, v := range m {
+		l = append(l, v)
+	}
+	return l
+}
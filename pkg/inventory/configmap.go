@@ -4,8 +4,6 @@ import (
 	"fmt"
 
 	v1 "k8s.io/api/core/v1"
-
-	"github.com/jaegertracing/jaeger-operator/pkg/util"
 )
 
 // ConfigMap represents the config maps inventory based on the current and desired states
@@ -22,24 +20,9 @@ func ForConfigMaps(existing []v1.ConfigMap, desired []v1.ConfigMap) ConfigMap {
 	mdelete := configsMap(existing)
 
 	for k, v := range mcreate {
-		if t, ok := mdelete[k]; ok {
-			tp := t.DeepCopy()
-			util.InitObjectMeta(tp)
-
-			// we can't blindly DeepCopyInto, so, we select what we bring from the new to the old object
-			tp.Data = v.Data
-			tp.BinaryData = v.BinaryData
-			tp.ObjectMeta.OwnerReferences = v.ObjectMeta.OwnerReferences
-
-			for k, v := range v.ObjectMeta.Annotations {
-				tp.ObjectMeta.Annotations[k] = v
-			}
-
-			for k, v := range v.ObjectMeta.Labels {
-				tp.ObjectMeta.Labels[k] = v
-			}
-
-			update = append(update, *tp)
+		if _, ok := mdelete[k]; ok {
+			// the desired state is applied as is, leaving alone the fields set by others
+			update = append(update, v)
 			delete(mcreate, k)
 			delete(mdelete, k)
 		}
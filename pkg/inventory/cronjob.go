@@ -10,8 +10,6 @@ import (
 	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
 
 	batchv1beta1 "k8s.io/api/batch/v1beta1"
-
-	"github.com/jaegertracing/jaeger-operator/pkg/util"
 )
 
 // CronJob represents the inventory of cronjobs based on the current and desired states
@@ -27,50 +25,10 @@ func ForCronJobs(existing []runtime.Object, desired []runtime.Object) CronJob {
 	desiredCronjobsMap := jobsMap(desired)
 	existingCronJobsMap := jobsMap(existing)
 
-	cronjobsVersion := viper.GetString(v1.FlagCronJobsVersion)
-
 	for desiredKey, desiredValue := range desiredCronjobsMap {
-		if existingValue, ok := existingCronJobsMap[desiredKey]; ok {
-			if cronjobsVersion == v1.FlagCronJobsVersionBatchV1Beta1 {
-				t1 := existingValue.(*batchv1beta1.CronJob)
-				v1 := desiredValue.(*batchv1beta1.CronJob)
-				tp := t1.DeepCopy()
-				util.InitObjectMeta(tp)
-
-				// we can't blindly DeepCopyInto, so, we select what we bring from the new to the old object
-				tp.Spec = v1.Spec
-				tp.ObjectMeta.OwnerReferences = v1.ObjectMeta.OwnerReferences
-
-				for k, v := range v1.ObjectMeta.Annotations {
-					tp.ObjectMeta.Annotations[k] = v
-				}
-
-				for k, v := range v1.ObjectMeta.Labels {
-					tp.ObjectMeta.Labels[k] = v
-				}
-
-				update = append(update, tp)
-			} else {
-				t1 := existingValue.(*batchv1.CronJob)
-				v1 := desiredValue.(*batchv1.CronJob)
-				tp := t1.DeepCopy()
-				util.InitObjectMeta(tp)
-
-				// we can't blindly DeepCopyInto, so, we select what we bring from the new to the old object
-				tp.Spec = v1.Spec
-				tp.ObjectMeta.OwnerReferences = v1.ObjectMeta.OwnerReferences
-
-				for k, v := range v1.ObjectMeta.Annotations {
-					tp.ObjectMeta.Annotations[k] = v
-				}
-
-				for k, v := range v1.ObjectMeta.Labels {
-					tp.ObjectMeta.Labels[k] = v
-				}
-
-				update = append(update, tp)
-			}
-
+		if _, ok := existingCronJobsMap[desiredKey]; ok {
+			// the desired state is applied as is, leaving alone the fields set by others
+			update = append(update, desiredValue)
 			delete(desiredCronjobsMap, desiredKey)
 			delete(existingCronJobsMap, desiredKey)
 		}
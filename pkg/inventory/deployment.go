@@ -6,7 +6,6 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 
 	"github.com/jaegertracing/jaeger-operator/pkg/inject"
-	"github.com/jaegertracing/jaeger-operator/pkg/util"
 )
 
 // Deployment represents the deployment inventory based on the current and desired states
@@ -24,34 +23,13 @@ func ForDeployments(existing []appsv1.Deployment, desired []appsv1.Deployment) D
 
 	for k, v := range mcreate {
 		if t, ok := mdelete[k]; ok {
-			tp := t.DeepCopy()
-			util.InitObjectMeta(tp)
-
-			// if we have a nil value for the replicas in the desired deployment
-			// but we have a specific value in the current deployment, we override the desired with the current
-			// as this might have been written by an HPA
-			if tp.Spec.Replicas != nil && v.Spec.Replicas == nil {
-				v.Spec.Replicas = tp.Spec.Replicas
-			}
-
-			// we can't blindly DeepCopyInto, so, we select what we bring from the new to the old object
-			tp.Spec = v.Spec
+			tp := v.DeepCopy()
 			tp.Spec = inject.PropagateOAuthCookieSecret(t.Spec, v.Spec)
 
 			// Deployment.Spec.Selector is an immutable field: we can't update
 			// this field with a new value, we MUST keep the original field value
 			tp.Spec.Selector = t.Spec.Selector
 
-			tp.ObjectMeta.OwnerReferences = v.ObjectMeta.OwnerReferences
-
-			for k, v := range v.ObjectMeta.Annotations {
-				tp.ObjectMeta.Annotations[k] = v
-			}
-
-			for k, v := range v.ObjectMeta.Labels {
-				tp.ObjectMeta.Labels[k] = v
-			}
-
 			update = append(update, *tp)
 			delete(mcreate, k)
 			delete(mdelete, k)
@@ -4,8 +4,6 @@ import (
 	"fmt"
 
 	esv1 "github.com/openshift/elasticsearch-operator/apis/logging/v1"
-
-	"github.com/jaegertracing/jaeger-operator/pkg/util"
 )
 
 // Elasticsearch represents the elastic search inventory based on the current and desired states
@@ -22,22 +20,9 @@ func ForElasticsearches(existing []esv1.Elasticsearch, desired []esv1.Elasticsea
 	mdelete := esMap(existing)
 
 	for k, v := range mcreate {
-		if t, ok := mdelete[k]; ok {
-			tp := t.DeepCopy()
-			util.InitObjectMeta(tp)
-
-			tp.Spec = v.Spec
-			tp.ObjectMeta.OwnerReferences = v.ObjectMeta.OwnerReferences
-
-			for k, v := range v.ObjectMeta.Annotations {
-				tp.ObjectMeta.Annotations[k] = v
-			}
-
-			for k, v := range v.ObjectMeta.Labels {
-				tp.ObjectMeta.Labels[k] = v
-			}
-
-			update = append(update, *tp)
+		if _, ok := mdelete[k]; ok {
+			// the desired state is applied as is, leaving alone the fields set by others
+			update = append(update, v)
 			delete(mcreate, k)
 			delete(mdelete, k)
 		}
@@ -10,7 +10,6 @@ import (
 	"github.com/spf13/viper"
 
 	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
-	"github.com/jaegertracing/jaeger-operator/pkg/util"
 )
 
 // HorizontalPodAutoscaler represents the HorizontalPodAutoscaler inventory based on the current and desired states
@@ -26,56 +25,12 @@ func ForHorizontalPodAutoscalers(existing []runtime.Object, desired []runtime.Ob
 	mcreate := hpaMap(desired)
 	mdelete := hpaMap(existing)
 
-	autoscalingVersion := viper.GetString(v1.FlagAutoscalingVersion)
-
 	for k, v := range mcreate {
-		if t, ok := mdelete[k]; ok {
-			if autoscalingVersion == v1.FlagAutoscalingVersionV2Beta2 {
-				t1 := t.(*autoscalingv2beta2.HorizontalPodAutoscaler)
-				v1 := v.(*autoscalingv2beta2.HorizontalPodAutoscaler)
-
-				tp := t1.DeepCopy()
-				util.InitObjectMeta(tp)
-
-				// we can't blindly DeepCopyInto, so, we select what we bring from the new to the old object
-				tp.Spec = v1.Spec
-				tp.ObjectMeta.OwnerReferences = v1.ObjectMeta.OwnerReferences
-
-				for k, v := range v1.ObjectMeta.Annotations {
-					tp.ObjectMeta.Annotations[k] = v
-				}
-
-				for k, v := range v1.ObjectMeta.Labels {
-					tp.ObjectMeta.Labels[k] = v
-				}
-
-				update = append(update, tp)
-				delete(mcreate, k)
-				delete(mdelete, k)
-
-			} else {
-				t1 := t.(*autoscalingv2.HorizontalPodAutoscaler)
-				v1 := v.(*autoscalingv2.HorizontalPodAutoscaler)
-
-				tp := t1.DeepCopy()
-				util.InitObjectMeta(tp)
-
-				// we can't blindly DeepCopyInto, so, we select what we bring from the new to the old object
-				tp.Spec = v1.Spec
-				tp.ObjectMeta.OwnerReferences = v1.ObjectMeta.OwnerReferences
-
-				for k, v := range v1.ObjectMeta.Annotations {
-					tp.ObjectMeta.Annotations[k] = v
-				}
-
-				for k, v := range v1.ObjectMeta.Labels {
-					tp.ObjectMeta.Labels[k] = v
-				}
-
-				update = append(update, tp)
-				delete(mcreate, k)
-				delete(mdelete, k)
-			}
+		if _, ok := mdelete[k]; ok {
+			// the desired state is applied as is, leaving alone the fields set by others
+			update = append(update, v)
+			delete(mcreate, k)
+			delete(mdelete, k)
 		}
 	}
 
@@ -4,8 +4,6 @@ import (
 	"fmt"
 
 	networkingv1 "k8s.io/api/networking/v1"
-
-	"github.com/jaegertracing/jaeger-operator/pkg/util"
 )
 
 // Ingress represents the inventory of ingresses based on the current and desired states
@@ -22,23 +20,9 @@ func ForIngresses(existing []networkingv1.Ingress, desired []networkingv1.Ingres
 	mdelete := ingressMap(existing)
 
 	for k, v := range mcreate {
-		if t, ok := mdelete[k]; ok {
-			tp := t.DeepCopy()
-			util.InitObjectMeta(tp)
-
-			// we can't blindly DeepCopyInto, so, we select what we bring from the new to the old object
-			tp.Spec = v.Spec
-			tp.ObjectMeta.OwnerReferences = v.ObjectMeta.OwnerReferences
-
-			for k, v := range v.ObjectMeta.Annotations {
-				tp.ObjectMeta.Annotations[k] = v
-			}
-
-			for k, v := range v.ObjectMeta.Labels {
-				tp.ObjectMeta.Labels[k] = v
-			}
-
-			update = append(update, *tp)
+		if _, ok := mdelete[k]; ok {
+			// the desired state is applied as is, leaving alone the fields set by others
+			update = append(update, v)
 			delete(mcreate, k)
 			delete(mdelete, k)
 		}
@@ -0,0 +1,52 @@
+package inventory
+
+import (
+	"fmt"
+
+	"github.com/jaegertracing/jaeger-operator/pkg/k8ssandra/v1alpha1"
+)
+
+// K8ssandraCluster represents the inventory of k8ssandra clusters based on the current and desired states
+type K8ssandraCluster struct {
+	Create []v1alpha1.K8ssandraCluster
+	Update []v1alpha1.K8ssandraCluster
+	Delete []v1alpha1.K8ssandraCluster
+}
+
+// ForK8ssandraClusters builds an inventory of k8ssandra clusters based on the existing and desired states
+func ForK8ssandraClusters(existing []v1alpha1.K8ssandraCluster, desired []v1alpha1.K8ssandraCluster) K8ssandraCluster {
+	update := []v1alpha1.K8ssandraCluster{}
+	mcreate := k8ssandraClusterMap(desired)
+	mdelete := k8ssandraClusterMap(existing)
+
+	for k, v := range mcreate {
+		if _, ok := mdelete[k]; ok {
+			// the desired state is applied as is, leaving alone the fields set by others
+			update = append(update, v)
+			delete(mcreate, k)
+			delete(mdelete, k)
+		}
+	}
+
+	return K8ssandraCluster{
+		Create: k8ssandraClusterList(mcreate),
+		Update: update,
+		Delete: k8ssandraClusterList(mdelete),
+	}
+}
+
+func k8ssandraClusterMap(deps []v1alpha1.K8ssandraCluster) map[string]v1alpha1.K8ssandraCluster {
+	m := map[string]v1alpha1.K8ssandraCluster{}
+	for _, d := range deps {
+		m[fmt.Sprintf("%s.%s", d.Namespace, d.Name)] = d
+	}
+	return m
+}
+
+func k8ssandraClusterList(m map[string]v1alpha1.K8ssandraCluster) []v1alpha1.K8ssandraCluster {
+	l := []v1alpha1.K8ssandraCluster{}
+	for _, v := range m {
+		l = append(l, v)
+	}
+	return l
+}
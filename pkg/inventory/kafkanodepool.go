@@ -0,0 +1,52 @@
+package inventory
+
+import (
+	"fmt"
+
+	"github.com/jaegertracing/jaeger-operator/pkg/kafka/v1beta2"
+)
+
+// KafkaNodePool represents the inventory of kafka node pools based on the current and desired states
+type KafkaNodePool struct {
+	Create []v1beta2.KafkaNodePool
+	Update []v1beta2.KafkaNodePool
+	Delete []v1beta2.KafkaNodePool
+}
+
+// ForKafkaNodePools builds an inventory of kafka node pools based on the existing and desired states
+func ForKafkaNodePools(existing []v1beta2.KafkaNodePool, desired []v1beta2.KafkaNodePool) KafkaNodePool {
+	update := []v1beta2.KafkaNodePool{}
+	mcreate := kafkaNodePoolMap(desired)
+	mdelete := kafkaNodePoolMap(existing)
+
+	for k, v := range mcreate {
+		if _, ok := mdelete[k]; ok {
+			// the desired state is applied as is, leaving alone the fields set by others
+			update = append(update, v)
+			delete(mcreate, k)
+			delete(mdelete, k)
+		}
+	}
+
+	return KafkaNodePool{
+		Create: kafkaNodePoolList(mcreate),
+		Update: update,
+		Delete: kafkaNodePoolList(mdelete),
+	}
+}
+
+func kafkaNodePoolMap(deps []v1beta2.KafkaNodePool) map[string]v1beta2.KafkaNodePool {
+	m := map[string]v1beta2.KafkaNodePool{}
+	for _, d := range deps {
+		m[fmt.Sprintf("%s.%s", d.Namespace, d.Name)] = d
+	}
+	return m
+}
+
+func kafkaNodePoolList(m map[string]v1beta2.KafkaNodePool) []v1beta2.KafkaNodePool {
+	l := []v1beta2.KafkaNodePool{}
+	for _, v := range m {
+		l = append(l, v)
+	}
+	return l
+}
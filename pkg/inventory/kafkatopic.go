@@ -0,0 +1,52 @@
+package inventory
+
+import (
+	"fmt"
+
+	"github.com/jaegertracing/jaeger-operator/pkg/kafka/v1beta2"
+)
+
+// KafkaTopic represents the inventory of kafka topics based on the current and desired states
+type KafkaTopic struct {
+	Create []v1beta2.KafkaTopic
+	Update []v1beta2.KafkaTopic
+	Delete []v1beta2.KafkaTopic
+}
+
+// ForKafkaTopics builds an inventory of kafka topics based on the existing and desired states
+func ForKafkaTopics(existing []v1beta2.KafkaTopic, desired []v1beta2.KafkaTopic) KafkaTopic {
+	update := []v1beta2.KafkaTopic{}
+	mcreate := kafkaTopicMap(desired)
+	mdelete := kafkaTopicMap(existing)
+
+	for k, v := range mcreate {
+		if _, ok := mdelete[k]; ok {
+			// the desired state is applied as is, leaving alone the fields set by others
+			update = append(update, v)
+			delete(mcreate, k)
+			delete(mdelete, k)
+		}
+	}
+
+	return KafkaTopic{
+		Create: kafkaTopicList(mcreate),
+		Update: update,
+		Delete: kafkaTopicList(mdelete),
+	}
+}
+
+func kafkaTopicMap(deps []v1beta2.KafkaTopic) map[string]v1beta2.KafkaTopic {
+	m := map[string]v1beta2.KafkaTopic{}
+	for _, d := range deps {
+		m[fmt.Sprintf("%s.%s", d.Namespace, d.Name)] = d
+	}
+	return m
+}
+
+func kafkaTopicList(m map[string]v1beta2.KafkaTopic) []v1beta2.KafkaTopic {
+	l := []v1beta2.KafkaTopic{}
+	for _, v := range m {
+		l = append(l, v)
+	}
+	return l
+}
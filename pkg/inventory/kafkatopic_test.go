@@ -0,0 +1,84 @@
+package inventory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/kafka/v1beta2"
+)
+
+func TestKafkaTopicInventory(t *testing.T) {
+	toCreate := v1beta2.KafkaTopic{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "to-create",
+		},
+	}
+	toUpdate := v1beta2.KafkaTopic{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "to-update",
+		},
+		Spec: v1beta2.KafkaTopicSpec{
+			FreeForm: v1.NewFreeForm(map[string]interface{}{
+				"key": "original",
+			}),
+		},
+	}
+	updated := v1beta2.KafkaTopic{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "to-update",
+			Annotations: map[string]string{"gopher": "jaeger"},
+			Labels:      map[string]string{"gopher": "jaeger"},
+		},
+		Spec: v1beta2.KafkaTopicSpec{
+			FreeForm: v1.NewFreeForm(map[string]interface{}{
+				"key": "changed",
+			}),
+		},
+	}
+	toDelete := v1beta2.KafkaTopic{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "to-delete",
+		},
+	}
+
+	existing := []v1beta2.KafkaTopic{toUpdate, toDelete}
+	desired := []v1beta2.KafkaTopic{updated, toCreate}
+
+	inv := ForKafkaTopics(existing, desired)
+	assert.Len(t, inv.Create, 1)
+	assert.Equal(t, "to-create", inv.Create[0].Name)
+
+	assert.Len(t, inv.Update, 1)
+	assert.Equal(t, "to-update", inv.Update[0].Name)
+	contentMap, err := inv.Update[0].Spec.GetMap()
+	require.NoError(t, err)
+	assert.Equal(t, "changed", contentMap["key"])
+
+	assert.Len(t, inv.Delete, 1)
+	assert.Equal(t, "to-delete", inv.Delete[0].Name)
+}
+
+func TestKafkaTopicInventoryWithSameNameInstances(t *testing.T) {
+	create := []v1beta2.KafkaTopic{{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "to-create",
+			Namespace: "tenant1",
+		},
+	}, {
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "to-create",
+			Namespace: "tenant2",
+		},
+	}}
+
+	inv := ForKafkaTopics([]v1beta2.KafkaTopic{}, create)
+	assert.Len(t, inv.Create, 2)
+	assert.Contains(t, inv.Create, create[0])
+	assert.Contains(t, inv.Create, create[1])
+	assert.Empty(t, inv.Update)
+	assert.Empty(t, inv.Delete)
+}
@@ -0,0 +1,52 @@
+package inventory
+
+import (
+	"fmt"
+
+	networkingv1 "k8s.io/api/networking/v1"
+)
+
+// NetworkPolicy represents the inventory of network policies based on the current and desired states
+type NetworkPolicy struct {
+	Create []networkingv1.NetworkPolicy
+	Update []networkingv1.NetworkPolicy
+	Delete []networkingv1.NetworkPolicy
+}
+
+// ForNetworkPolicies builds an inventory of network policies based on the existing and desired states
+func ForNetworkPolicies(existing []networkingv1.NetworkPolicy, desired []networkingv1.NetworkPolicy) NetworkPolicy {
+	update := []networkingv1.NetworkPolicy{}
+	mcreate := networkPolicyMap(desired)
+	mdelete := networkPolicyMap(existing)
+
+	for k, v := range mcreate {
+		if _, ok := mdelete[k]; ok {
+			// the desired state is applied as is, leaving alone the fields set by others
+			update = append(update, v)
+			delete(mcreate, k)
+			delete(mdelete, k)
+		}
+	}
+
+	return NetworkPolicy{
+		Create: networkPolicyList(mcreate),
+		Update: update,
+		Delete: networkPolicyList(mdelete),
+	}
+}
+
+func networkPolicyMap(policies []networkingv1.NetworkPolicy) map[string]networkingv1.NetworkPolicy {
+	m := map[string]networkingv1.NetworkPolicy{}
+	for _, p := range policies {
+		m[fmt.Sprintf("%s.%s", p.Namespace, p.Name)] = p
+	}
+	return m
+}
+
+func networkPolicyList(m map[string]networkingv1.NetworkPolicy) []networkingv1.NetworkPolicy {
+	l := []networkingv1.NetworkPolicy{}
+	for _, v := range m {
+		l = append(l, v)
+	}
+	return l
+}
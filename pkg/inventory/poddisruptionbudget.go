@@ -0,0 +1,52 @@
+package inventory
+
+import (
+	"fmt"
+
+	policyv1 "k8s.io/api/policy/v1"
+)
+
+// PodDisruptionBudget represents the inventory of pod disruption budgets based on the current and desired states
+type PodDisruptionBudget struct {
+	Create []policyv1.PodDisruptionBudget
+	Update []policyv1.PodDisruptionBudget
+	Delete []policyv1.PodDisruptionBudget
+}
+
+// ForPodDisruptionBudgets builds an inventory of pod disruption budgets based on the existing and desired states
+func ForPodDisruptionBudgets(existing []policyv1.PodDisruptionBudget, desired []policyv1.PodDisruptionBudget) PodDisruptionBudget {
+	update := []policyv1.PodDisruptionBudget{}
+	mcreate := podDisruptionBudgetMap(desired)
+	mdelete := podDisruptionBudgetMap(existing)
+
+	for k, v := range mcreate {
+		if _, ok := mdelete[k]; ok {
+			// the desired state is applied as is, leaving alone the fields set by others
+			update = append(update, v)
+			delete(mcreate, k)
+			delete(mdelete, k)
+		}
+	}
+
+	return PodDisruptionBudget{
+		Create: podDisruptionBudgetList(mcreate),
+		Update: update,
+		Delete: podDisruptionBudgetList(mdelete),
+	}
+}
+
+func podDisruptionBudgetMap(pdbs []policyv1.PodDisruptionBudget) map[string]policyv1.PodDisruptionBudget {
+	m := map[string]policyv1.PodDisruptionBudget{}
+	for _, p := range pdbs {
+		m[fmt.Sprintf("%s.%s", p.Namespace, p.Name)] = p
+	}
+	return m
+}
+
+func podDisruptionBudgetList(m map[string]policyv1.PodDisruptionBudget) []policyv1.PodDisruptionBudget {
+	l := []policyv1.PodDisruptionBudget{}
+	for _, v := range m {
+		l = append(l, v)
+	}
+	return l
+}
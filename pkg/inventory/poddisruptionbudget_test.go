@@ -0,0 +1,62 @@
+package inventory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestPodDisruptionBudgetInventory(t *testing.T) {
+	one := intstr.FromInt32(1)
+	half := intstr.FromString("50%")
+	toCreate := policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "to-create",
+		},
+	}
+	toUpdate := policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "to-update",
+			Annotations: map[string]string{"gopher": "jaeger", "kept": "this"},
+		},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			MaxUnavailable: &one,
+		},
+	}
+	updated := policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "to-update",
+			Annotations: map[string]string{"gopher": "jaeger-updated"},
+			Labels:      map[string]string{"gopher": "jaeger-updated"},
+		},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			MinAvailable: &half,
+		},
+	}
+	toDelete := policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "to-delete",
+		},
+	}
+
+	existing := []policyv1.PodDisruptionBudget{toUpdate, toDelete}
+	desired := []policyv1.PodDisruptionBudget{updated, toCreate}
+
+	inv := ForPodDisruptionBudgets(existing, desired)
+	assert.Len(t, inv.Create, 1)
+	assert.Equal(t, "to-create", inv.Create[0].Name)
+
+	assert.Len(t, inv.Update, 1)
+	assert.Equal(t, "to-update", inv.Update[0].Name)
+	assert.Equal(t, &half, inv.Update[0].Spec.MinAvailable)
+	assert.Nil(t, inv.Update[0].Spec.MaxUnavailable)
+	assert.Equal(t, "jaeger-updated", inv.Update[0].Annotations["gopher"])
+	assert.NotContains(t, inv.Update[0].Annotations, "kept") // left alone by the server-side apply
+	assert.Equal(t, "jaeger-updated", inv.Update[0].Labels["gopher"])
+
+	assert.Len(t, inv.Delete, 1)
+	assert.Equal(t, "to-delete", inv.Delete[0].Name)
+}
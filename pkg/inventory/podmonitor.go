@@ -0,0 +1,70 @@
+package inventory
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	monitoringv1 "github.com/jaegertracing/jaeger-operator/pkg/monitoring/v1"
+)
+
+// PodMonitor represents the inventory of Prometheus Operator pod monitors based on the current and desired states
+type PodMonitor struct {
+	Create []monitoringv1.PodMonitor
+	Update []monitoringv1.PodMonitor
+	Delete []monitoringv1.PodMonitor
+}
+
+// ForPodMonitors builds an inventory of Prometheus Operator pod monitors based on the existing and desired states
+func ForPodMonitors(existing []monitoringv1.PodMonitor, desired []monitoringv1.PodMonitor) PodMonitor {
+	update := []monitoringv1.PodMonitor{}
+	mcreate := podMonitorMap(desired)
+	mdelete := podMonitorMap(existing)
+
+	for _, o := range existing {
+		log.Log.V(-1).Info(
+			"existing",
+			"podmonitor", o.GetName(),
+			"namespace", o.GetNamespace(),
+		)
+	}
+
+	for _, o := range desired {
+		log.Log.V(-1).Info(
+			"desired",
+			"podmonitor", o.GetName(),
+			"namespace", o.GetNamespace(),
+		)
+	}
+
+	for k, v := range mcreate {
+		if _, ok := mdelete[k]; ok {
+			// the desired state is applied as is, leaving alone the fields set by others
+			update = append(update, v)
+			delete(mcreate, k)
+			delete(mdelete, k)
+		}
+	}
+
+	return PodMonitor{
+		Create: podMonitorList(mcreate),
+		Update: update,
+		Delete: podMonitorList(mdelete),
+	}
+}
+
+func podMonitorMap(objs []monitoringv1.PodMonitor) map[string]monitoringv1.PodMonitor {
+	m := map[string]monitoringv1.PodMonitor{}
+	for _, o := range objs {
+		m[fmt.Sprintf("%s.%s", o.Namespace, o.Name)] = o
+	}
+	return m
+}
+
+func podMonitorList(m map[string]monitoringv1.PodMonitor) []monitoringv1.PodMonitor {
+	l := []monitoringv1.PodMonitor{}
+	for _, v := range m {
+		l = append(l, v)
+	}
+	return l
+}
@@ -0,0 +1,56 @@
+package inventory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	monitoringv1 "github.com/jaegertracing/jaeger-operator/pkg/monitoring/v1"
+)
+
+func TestPodMonitorInventory(t *testing.T) {
+	toCreate := monitoringv1.PodMonitor{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "to-create",
+		},
+	}
+	toUpdate := monitoringv1.PodMonitor{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "to-update",
+		},
+		Spec: monitoringv1.PodMonitorSpec{
+			PodMetricsEndpoints: []monitoringv1.PodMetricsEndpoint{{Interval: "30s"}},
+		},
+	}
+	updated := monitoringv1.PodMonitor{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "to-update",
+			Annotations: map[string]string{"gopher": "jaeger"},
+			Labels:      map[string]string{"gopher": "jaeger"},
+		},
+		Spec: monitoringv1.PodMonitorSpec{
+			PodMetricsEndpoints: []monitoringv1.PodMetricsEndpoint{{Interval: "1m"}},
+		},
+	}
+	toDelete := monitoringv1.PodMonitor{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "to-delete",
+		},
+	}
+
+	existing := []monitoringv1.PodMonitor{toUpdate, toDelete}
+	desired := []monitoringv1.PodMonitor{updated, toCreate}
+
+	inv := ForPodMonitors(existing, desired)
+	assert.Len(t, inv.Create, 1)
+	assert.Equal(t, "to-create", inv.Create[0].Name)
+
+	assert.Len(t, inv.Update, 1)
+	assert.Equal(t, "to-update", inv.Update[0].Name)
+	assert.Equal(t, "1m", inv.Update[0].Spec.PodMetricsEndpoints[0].Interval)
+	assert.Equal(t, "jaeger", inv.Update[0].Labels["gopher"])
+
+	assert.Len(t, inv.Delete, 1)
+	assert.Equal(t, "to-delete", inv.Delete[0].Name)
+}
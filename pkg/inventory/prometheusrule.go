@@ -0,0 +1,70 @@
+package inventory
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	monitoringv1 "github.com/jaegertracing/jaeger-operator/pkg/monitoring/v1"
+)
+
+// PrometheusRule represents the inventory of Prometheus Operator rules based on the current and desired states
+type PrometheusRule struct {
+	Create []monitoringv1.PrometheusRule
+	Update []monitoringv1.PrometheusRule
+	Delete []monitoringv1.PrometheusRule
+}
+
+// ForPrometheusRules builds an inventory of Prometheus Operator rules based on the existing and desired states
+func ForPrometheusRules(existing []monitoringv1.PrometheusRule, desired []monitoringv1.PrometheusRule) PrometheusRule {
+	update := []monitoringv1.PrometheusRule{}
+	mcreate := prometheusRuleMap(desired)
+	mdelete := prometheusRuleMap(existing)
+
+	for _, o := range existing {
+		log.Log.V(-1).Info(
+			"existing",
+			"prometheusrule", o.GetName(),
+			"namespace", o.GetNamespace(),
+		)
+	}
+
+	for _, o := range desired {
+		log.Log.V(-1).Info(
+			"desired",
+			"prometheusrule", o.GetName(),
+			"namespace", o.GetNamespace(),
+		)
+	}
+
+	for k, v := range mcreate {
+		if _, ok := mdelete[k]; ok {
+			// the desired state is applied as is, leaving alone the fields set by others
+			update = append(update, v)
+			delete(mcreate, k)
+			delete(mdelete, k)
+		}
+	}
+
+	return PrometheusRule{
+		Create: prometheusRuleList(mcreate),
+		Update: update,
+		Delete: prometheusRuleList(mdelete),
+	}
+}
+
+func prometheusRuleMap(objs []monitoringv1.PrometheusRule) map[string]monitoringv1.PrometheusRule {
+	m := map[string]monitoringv1.PrometheusRule{}
+	for _, o := range objs {
+		m[fmt.Sprintf("%s.%s", o.Namespace, o.Name)] = o
+	}
+	return m
+}
+
+func prometheusRuleList(m map[string]monitoringv1.PrometheusRule) []monitoringv1.PrometheusRule {
+	l := []monitoringv1.PrometheusRule{}
+	for _, v := range m {
+		l = append(l, v)
+	}
+	return l
+}
@@ -0,0 +1,70 @@
+package inventory
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	kedav1alpha1 "github.com/jaegertracing/jaeger-operator/pkg/keda/v1alpha1"
+)
+
+// ScaledObject represents the inventory of KEDA ScaledObjects based on the current and desired states
+type ScaledObject struct {
+	Create []kedav1alpha1.ScaledObject
+	Update []kedav1alpha1.ScaledObject
+	Delete []kedav1alpha1.ScaledObject
+}
+
+// ForScaledObjects builds an inventory of KEDA ScaledObjects based on the existing and desired states
+func ForScaledObjects(existing []kedav1alpha1.ScaledObject, desired []kedav1alpha1.ScaledObject) ScaledObject {
+	update := []kedav1alpha1.ScaledObject{}
+	mcreate := scaledObjectMap(desired)
+	mdelete := scaledObjectMap(existing)
+
+	for _, o := range existing {
+		log.Log.V(-1).Info(
+			"existing",
+			"scaledobject", o.GetName(),
+			"namespace", o.GetNamespace(),
+		)
+	}
+
+	for _, o := range desired {
+		log.Log.V(-1).Info(
+			"desired",
+			"scaledobject", o.GetName(),
+			"namespace", o.GetNamespace(),
+		)
+	}
+
+	for k, v := range mcreate {
+		if _, ok := mdelete[k]; ok {
+			// the desired state is applied as is, leaving alone the fields set by others
+			update = append(update, v)
+			delete(mcreate, k)
+			delete(mdelete, k)
+		}
+	}
+
+	return ScaledObject{
+		Create: scaledObjectList(mcreate),
+		Update: update,
+		Delete: scaledObjectList(mdelete),
+	}
+}
+
+func scaledObjectMap(objs []kedav1alpha1.ScaledObject) map[string]kedav1alpha1.ScaledObject {
+	m := map[string]kedav1alpha1.ScaledObject{}
+	for _, o := range objs {
+		m[fmt.Sprintf("%s.%s", o.Namespace, o.Name)] = o
+	}
+	return m
+}
+
+func scaledObjectList(m map[string]kedav1alpha1.ScaledObject) []kedav1alpha1.ScaledObject {
+	l := []kedav1alpha1.ScaledObject{}
+	for _, v := range m {
+		l = append(l, v)
+	}
+	return l
+}
@@ -0,0 +1,58 @@
+package inventory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kedav1alpha1 "github.com/jaegertracing/jaeger-operator/pkg/keda/v1alpha1"
+)
+
+func TestScaledObjectInventory(t *testing.T) {
+	one := int32(1)
+	five := int32(5)
+	toCreate := kedav1alpha1.ScaledObject{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "to-create",
+		},
+	}
+	toUpdate := kedav1alpha1.ScaledObject{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "to-update",
+		},
+		Spec: kedav1alpha1.ScaledObjectSpec{
+			MinReplicaCount: &one,
+		},
+	}
+	updated := kedav1alpha1.ScaledObject{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "to-update",
+			Annotations: map[string]string{"gopher": "jaeger"},
+			Labels:      map[string]string{"gopher": "jaeger"},
+		},
+		Spec: kedav1alpha1.ScaledObjectSpec{
+			MinReplicaCount: &five,
+		},
+	}
+	toDelete := kedav1alpha1.ScaledObject{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "to-delete",
+		},
+	}
+
+	existing := []kedav1alpha1.ScaledObject{toUpdate, toDelete}
+	desired := []kedav1alpha1.ScaledObject{updated, toCreate}
+
+	inv := ForScaledObjects(existing, desired)
+	assert.Len(t, inv.Create, 1)
+	assert.Equal(t, "to-create", inv.Create[0].Name)
+
+	assert.Len(t, inv.Update, 1)
+	assert.Equal(t, "to-update", inv.Update[0].Name)
+	assert.Equal(t, int32(5), *inv.Update[0].Spec.MinReplicaCount)
+	assert.Equal(t, "jaeger", inv.Update[0].Labels["gopher"])
+
+	assert.Len(t, inv.Delete, 1)
+	assert.Equal(t, "to-delete", inv.Delete[0].Name)
+}
@@ -4,8 +4,6 @@ import (
 	"fmt"
 
 	v1 "k8s.io/api/core/v1"
-
-	"github.com/jaegertracing/jaeger-operator/pkg/util"
 )
 
 // Service represents the inventory of routes based on the current and desired states
@@ -22,30 +20,9 @@ func ForServices(existing []v1.Service, desired []v1.Service) Service {
 	mcreate := serviceMap(desired)
 
 	for k, v := range mcreate {
-		if t, ok := mdelete[k]; ok {
-			tp := t.DeepCopy()
-			util.InitObjectMeta(tp)
-
-			// we keep the ClusterIP that got assigned by the cluster, if it's empty in the "desired" and not empty on the "current"
-			if v.Spec.ClusterIP == "" && len(tp.Spec.ClusterIP) > 0 {
-				v.Spec.ClusterIP = tp.Spec.ClusterIP
-			}
-
-			// we can't blindly DeepCopyInto, so, we select what we bring from the new to the old object
-			tp.Spec = v.Spec
-			tp.ObjectMeta.OwnerReferences = v.ObjectMeta.OwnerReferences
-
-			// there might be annotations not created by us, so, we need to just replace the ones we care about,
-			// leaving all others there
-			for k, v := range v.ObjectMeta.Annotations {
-				tp.ObjectMeta.Annotations[k] = v
-			}
-
-			for k, v := range v.ObjectMeta.Labels {
-				tp.ObjectMeta.Labels[k] = v
-			}
-
-			update = append(update, *tp)
+		if _, ok := mdelete[k]; ok {
+			// the desired state is applied as is, leaving alone the fields set by others
+			update = append(update, v)
 			delete(mcreate, k)
 			delete(mdelete, k)
 		}
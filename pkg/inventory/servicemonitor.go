@@ -0,0 +1,70 @@
+package inventory
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	monitoringv1 "github.com/jaegertracing/jaeger-operator/pkg/monitoring/v1"
+)
+
+// ServiceMonitor represents the inventory of Prometheus Operator service monitors based on the current and desired states
+type ServiceMonitor struct {
+	Create []monitoringv1.ServiceMonitor
+	Update []monitoringv1.ServiceMonitor
+	Delete []monitoringv1.ServiceMonitor
+}
+
+// ForServiceMonitors builds an inventory of Prometheus Operator service monitors based on the existing and desired states
+func ForServiceMonitors(existing []monitoringv1.ServiceMonitor, desired []monitoringv1.ServiceMonitor) ServiceMonitor {
+	update := []monitoringv1.ServiceMonitor{}
+	mcreate := serviceMonitorMap(desired)
+	mdelete := serviceMonitorMap(existing)
+
+	for _, o := range existing {
+		log.Log.V(-1).Info(
+			"existing",
+			"servicemonitor", o.GetName(),
+			"namespace", o.GetNamespace(),
+		)
+	}
+
+	for _, o := range desired {
+		log.Log.V(-1).Info(
+			"desired",
+			"servicemonitor", o.GetName(),
+			"namespace", o.GetNamespace(),
+		)
+	}
+
+	for k, v := range mcreate {
+		if _, ok := mdelete[k]; ok {
+			// the desired state is applied as is, leaving alone the fields set by others
+			update = append(update, v)
+			delete(mcreate, k)
+			delete(mdelete, k)
+		}
+	}
+
+	return ServiceMonitor{
+		Create: serviceMonitorList(mcreate),
+		Update: update,
+		Delete: serviceMonitorList(mdelete),
+	}
+}
+
+func serviceMonitorMap(objs []monitoringv1.ServiceMonitor) map[string]monitoringv1.ServiceMonitor {
+	m := map[string]monitoringv1.ServiceMonitor{}
+	for _, o := range objs {
+		m[fmt.Sprintf("%s.%s", o.Namespace, o.Name)] = o
+	}
+	return m
+}
+
+func serviceMonitorList(m map[string]monitoringv1.ServiceMonitor) []monitoringv1.ServiceMonitor {
+	l := []monitoringv1.ServiceMonitor{}
+	for _, v := range m {
+		l = append(l, v)
+	}
+	return l
+}
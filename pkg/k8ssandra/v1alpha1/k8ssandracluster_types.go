@@ -0,0 +1,50 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+)
+
+// K8ssandraClusterSpec defines the desired state of K8ssandraCluster
+type K8ssandraClusterSpec struct {
+	v1.FreeForm `json:",inline"`
+}
+
+// K8ssandraClusterStatus defines the observed state of K8ssandraCluster
+type K8ssandraClusterStatus struct {
+	// +listType=set
+	Conditions []K8ssandraClusterCondition `json:"conditions,omitempty"`
+}
+
+// K8ssandraClusterCondition holds the different conditions affecting the K8ssandraCluster
+type K8ssandraClusterCondition struct {
+	Type               string `json:"type,omitempty"`
+	Status             string `json:"status,omitempty"`
+	LastTransitionTime string `json:"lastTransitionTime,omitempty"`
+}
+
+// K8ssandraCluster is the Schema for the k8ssandraclusters API
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=k8ssandraclusters,scope=Namespaced
+type K8ssandraCluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   K8ssandraClusterSpec   `json:"spec,omitempty"`
+	Status K8ssandraClusterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// K8ssandraClusterList contains a list of K8ssandraCluster
+type K8ssandraClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []K8ssandraCluster `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&K8ssandraCluster{}, &K8ssandraClusterList{})
+}
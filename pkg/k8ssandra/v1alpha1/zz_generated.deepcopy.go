@@ -0,0 +1,119 @@
+//go:build !ignore_autogenerated
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *K8ssandraCluster) DeepCopyInto(out *K8ssandraCluster) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new K8ssandraCluster.
+func (in *K8ssandraCluster) DeepCopy() *K8ssandraCluster {
+	if in == nil {
+		return nil
+	}
+	out := new(K8ssandraCluster)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *K8ssandraCluster) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *K8ssandraClusterCondition) DeepCopyInto(out *K8ssandraClusterCondition) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new K8ssandraClusterCondition.
+func (in *K8ssandraClusterCondition) DeepCopy() *K8ssandraClusterCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(K8ssandraClusterCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *K8ssandraClusterList) DeepCopyInto(out *K8ssandraClusterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]K8ssandraCluster, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new K8ssandraClusterList.
+func (in *K8ssandraClusterList) DeepCopy() *K8ssandraClusterList {
+	if in == nil {
+		return nil
+	}
+	out := new(K8ssandraClusterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *K8ssandraClusterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *K8ssandraClusterSpec) DeepCopyInto(out *K8ssandraClusterSpec) {
+	*out = *in
+	in.FreeForm.DeepCopyInto(&out.FreeForm)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new K8ssandraClusterSpec.
+func (in *K8ssandraClusterSpec) DeepCopy() *K8ssandraClusterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(K8ssandraClusterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *K8ssandraClusterStatus) DeepCopyInto(out *K8ssandraClusterStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]K8ssandraClusterCondition, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new K8ssandraClusterStatus.
+func (in *K8ssandraClusterStatus) DeepCopy() *K8ssandraClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(K8ssandraClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,220 @@
+package kafka
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/util"
+)
+
+const (
+	// Producer is the Kafka client of the collector
+	Producer = "producer"
+
+	// Consumer is the Kafka client of the ingester
+	Consumer = "consumer"
+
+	caMountPath          = "/var/run/secrets/kafka/ca"
+	credentialsMountPath = "/var/run/secrets/kafka/credentials"
+)
+
+// listenerPorts are the ports of the listeners of the provisioned Kafka cluster, per authentication type
+var listenerPorts = map[v1.JaegerKafkaAuthenticationType]int{
+	v1.KafkaAuthenticationNone:        9092,
+	v1.KafkaAuthenticationTLS:         9093,
+	v1.KafkaAuthenticationSCRAMSHA512: 9094,
+}
+
+// IsProvisioned returns whether the instance connects to the Kafka cluster provisioned by the operator
+func IsProvisioned(jaeger *v1.Jaeger) bool {
+	return jaeger.Spec.Kafka.Brokers == "" &&
+		jaeger.Annotations[v1.AnnotationProvisionedKafkaKey] == v1.AnnotationProvisionedKafkaValue
+}
+
+// AuthenticationType returns the authentication type to use with the brokers, which defaults to tls for the
+// provisioned Kafka cluster and to none otherwise
+func AuthenticationType(jaeger *v1.Jaeger) v1.JaegerKafkaAuthenticationType {
+	if jaeger.Spec.Kafka.Authentication != nil && jaeger.Spec.Kafka.Authentication.Type != "" {
+		return jaeger.Spec.Kafka.Authentication.Type
+	}
+	if IsProvisioned(jaeger) {
+		return v1.KafkaAuthenticationTLS
+	}
+	return v1.KafkaAuthenticationNone
+}
+
+// Brokers returns the brokers the collector and ingester connect to as described by the Kafka spec of the instance:
+// the bootstrap of the provisioned Kafka cluster, on the listener of the authentication type, or the brokers of the
+// spec. An empty string is returned when the spec describes no connection, leaving the brokers to the options.
+func Brokers(jaeger *v1.Jaeger) string {
+	spec := jaeger.Spec.Kafka
+	if spec.Brokers == "" && spec.TLS == nil && spec.Authentication == nil {
+		return ""
+	}
+	if IsProvisioned(jaeger) {
+		return fmt.Sprintf("%s-kafka-bootstrap.%s.svc.cluster.local:%d", jaeger.Name, jaeger.Namespace, listenerPorts[AuthenticationType(jaeger)])
+	}
+	return spec.Brokers
+}
+
+// Update wires the connection described by the Kafka spec of the instance into the given client, either the
+// producer of the collector or the consumer of the ingester. The options already set for the connection flags are
+// replaced, the secrets are mounted and the credentials are exposed as environment variables. Nothing is done when
+// the spec describes no connection, leaving it to the options.
+func Update(jaeger *v1.Jaeger, client string, commonSpec *v1.JaegerCommonSpec, env *[]corev1.EnvVar, options *[]string) {
+	spec := jaeger.Spec.Kafka
+	if spec.Brokers == "" && spec.TLS == nil && spec.Authentication == nil {
+		return
+	}
+
+	auth := v1.JaegerKafkaAuthenticationSpec{}
+	if spec.Authentication != nil {
+		auth = *spec.Authentication
+	}
+	auth.Type = AuthenticationType(jaeger)
+
+	brokers := Brokers(jaeger)
+	tls := spec.TLS
+	username := ""
+	if IsProvisioned(jaeger) {
+		if auth.Type != v1.KafkaAuthenticationNone {
+			tls = &v1.JaegerKafkaTLSSpec{CASecretName: fmt.Sprintf("%s-cluster-ca-cert", jaeger.Name)}
+		}
+		// the credentials are the ones of the KafkaUser, which has the name of the instance
+		auth.SecretName = jaeger.Name
+		username = jaeger.Name
+	}
+
+	prefix := fmt.Sprintf("kafka.%s", client)
+	*options = removeConnectionOptions(*options, prefix, brokers != "")
+
+	if brokers != "" {
+		*options = append(*options, fmt.Sprintf("--%s.brokers=%s", prefix, brokers))
+	}
+
+	if tls != nil || auth.Type == v1.KafkaAuthenticationTLS {
+		*options = append(*options, fmt.Sprintf("--%s.tls.enabled=true", prefix))
+	}
+	if tls != nil && tls.CASecretName != "" {
+		mountSecret(jaeger, commonSpec, "kafka-ca", tls.CASecretName, caMountPath)
+		*options = append(*options, fmt.Sprintf("--%s.tls.ca=%s/ca.crt", prefix, caMountPath))
+	}
+	if tls != nil && tls.SkipHostVerify {
+		*options = append(*options, fmt.Sprintf("--%s.tls.skip-host-verify=true", prefix))
+	}
+
+	switch auth.Type {
+	case v1.KafkaAuthenticationTLS:
+		mountSecret(jaeger, commonSpec, "kafka-credentials", auth.SecretName, credentialsMountPath)
+		*options = append(*options,
+			fmt.Sprintf("--%s.authentication=tls", prefix),
+			fmt.Sprintf("--%s.tls.cert=%s/user.crt", prefix, credentialsMountPath),
+			fmt.Sprintf("--%s.tls.key=%s/user.key", prefix, credentialsMountPath),
+		)
+	case v1.KafkaAuthenticationSCRAMSHA512, v1.KafkaAuthenticationPlaintext:
+		mechanism := auth.Mechanism
+		if auth.Type == v1.KafkaAuthenticationSCRAMSHA512 {
+			mechanism = "SCRAM-SHA-512"
+		} else if mechanism == "" {
+			mechanism = "PLAIN"
+		}
+		*options = append(*options,
+			fmt.Sprintf("--%s.authentication=plaintext", prefix),
+			fmt.Sprintf("--%s.plaintext.mechanism=%s", prefix, mechanism),
+		)
+		if username != "" {
+			*options = append(*options, fmt.Sprintf("--%s.plaintext.username=%s", prefix, username))
+		} else {
+			*env = append(*env, secretEnvVar(prefix+".plaintext.username", auth.SecretName, "username"))
+		}
+		// the password isn't passed as an option, so that it doesn't show up in the pod spec
+		*env = append(*env, secretEnvVar(prefix+".plaintext.password", auth.SecretName, "password"))
+	case v1.KafkaAuthenticationKerberos:
+		kerberos := v1.JaegerKafkaKerberosSpec{}
+		if auth.Kerberos != nil {
+			kerberos = *auth.Kerberos
+		}
+		if kerberos.ServiceName == "" {
+			kerberos.ServiceName = "kafka"
+		}
+		mountSecret(jaeger, commonSpec, "kafka-credentials", auth.SecretName, credentialsMountPath)
+		*options = append(*options,
+			fmt.Sprintf("--%s.authentication=kerberos", prefix),
+			fmt.Sprintf("--%s.kerberos.use-keytab=true", prefix),
+			fmt.Sprintf("--%s.kerberos.keytab-file=%s/keytab", prefix, credentialsMountPath),
+			fmt.Sprintf("--%s.kerberos.config-file=%s/krb5.conf", prefix, credentialsMountPath),
+			fmt.Sprintf("--%s.kerberos.service-name=%s", prefix, kerberos.ServiceName),
+		)
+		if kerberos.Realm != "" {
+			*options = append(*options, fmt.Sprintf("--%s.kerberos.realm=%s", prefix, kerberos.Realm))
+		}
+		if kerberos.Username != "" {
+			*options = append(*options, fmt.Sprintf("--%s.kerberos.username=%s", prefix, kerberos.Username))
+		}
+	default:
+		*options = append(*options, fmt.Sprintf("--%s.authentication=none", prefix))
+	}
+}
+
+// removeConnectionOptions removes the options for the connection flags of the given client, keeping the brokers
+// when the spec doesn't replace them
+func removeConnectionOptions(options []string, prefix string, brokers bool) []string {
+	flags := []string{
+		fmt.Sprintf("--%s.authentication=", prefix),
+		fmt.Sprintf("--%s.tls.", prefix),
+		fmt.Sprintf("--%s.plaintext.", prefix),
+		fmt.Sprintf("--%s.kerberos.", prefix),
+	}
+	if brokers {
+		flags = append(flags, fmt.Sprintf("--%s.brokers=", prefix))
+	}
+
+	kept := []string{}
+	for _, option := range options {
+		connection := false
+		for _, flag := range flags {
+			if strings.HasPrefix(option, flag) {
+				connection = true
+				break
+			}
+		}
+		if !connection {
+			kept = append(kept, option)
+		}
+	}
+	return kept
+}
+
+func mountSecret(jaeger *v1.Jaeger, commonSpec *v1.JaegerCommonSpec, name, secretName, mountPath string) {
+	volumeName := util.DNSName(util.Truncate("%s-%s", 63, jaeger.Name, name))
+	commonSpec.Volumes = append(commonSpec.Volumes, corev1.Volume{
+		Name: volumeName,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: secretName,
+			},
+		},
+	})
+	commonSpec.VolumeMounts = append(commonSpec.VolumeMounts, corev1.VolumeMount{
+		Name:      volumeName,
+		MountPath: mountPath,
+		ReadOnly:  true,
+	})
+}
+
+// secretEnvVar returns the environment variable setting the given flag from the given key of the secret, as the
+// Jaeger components read their flags from the environment as well
+func secretEnvVar(flag, secretName, key string) corev1.EnvVar {
+	return corev1.EnvVar{
+		Name: strings.ToUpper(strings.NewReplacer(".", "_", "-", "_").Replace(flag)),
+		ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+				Key:                  key,
+			},
+		},
+	}
+}
@@ -0,0 +1,224 @@
+package kafka
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+)
+
+func TestUpdateNoKafkaSpec(t *testing.T) {
+	// prepare
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	commonSpec := v1.JaegerCommonSpec{}
+	env := []corev1.EnvVar{}
+	options := []string{"--kafka.producer.brokers=my-cluster:9092", "--kafka.producer.authentication=tls"}
+
+	// test
+	Update(jaeger, Producer, &commonSpec, &env, &options)
+
+	// verify
+	assert.Equal(t, []string{"--kafka.producer.brokers=my-cluster:9092", "--kafka.producer.authentication=tls"}, options)
+	assert.Empty(t, env)
+	assert.Empty(t, commonSpec.Volumes)
+}
+
+func TestUpdateExternalSCRAM(t *testing.T) {
+	// prepare
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Kafka = v1.JaegerKafkaSpec{
+		Brokers: "my-cluster-kafka-bootstrap.kafka:9094",
+		TLS:     &v1.JaegerKafkaTLSSpec{CASecretName: "my-cluster-ca-cert"},
+		Authentication: &v1.JaegerKafkaAuthenticationSpec{
+			Type:       v1.KafkaAuthenticationSCRAMSHA512,
+			SecretName: "my-user",
+		},
+	}
+	commonSpec := v1.JaegerCommonSpec{}
+	env := []corev1.EnvVar{}
+	options := []string{
+		"--kafka.producer.brokers=old:9092",
+		"--kafka.producer.authentication=tls",
+		"--kafka.producer.tls.cert=/old/user.crt",
+		"--kafka.producer.topic=spans",
+	}
+
+	// test
+	Update(jaeger, Producer, &commonSpec, &env, &options)
+
+	// verify
+	assert.ElementsMatch(t, []string{
+		"--kafka.producer.topic=spans",
+		"--kafka.producer.brokers=my-cluster-kafka-bootstrap.kafka:9094",
+		"--kafka.producer.tls.enabled=true",
+		"--kafka.producer.tls.ca=/var/run/secrets/kafka/ca/ca.crt",
+		"--kafka.producer.authentication=plaintext",
+		"--kafka.producer.plaintext.mechanism=SCRAM-SHA-512",
+	}, options)
+
+	require.Len(t, env, 2)
+	assert.Equal(t, "KAFKA_PRODUCER_PLAINTEXT_USERNAME", env[0].Name)
+	assert.Equal(t, "my-user", env[0].ValueFrom.SecretKeyRef.Name)
+	assert.Equal(t, "username", env[0].ValueFrom.SecretKeyRef.Key)
+	assert.Equal(t, "KAFKA_PRODUCER_PLAINTEXT_PASSWORD", env[1].Name)
+	assert.Equal(t, "my-user", env[1].ValueFrom.SecretKeyRef.Name)
+	assert.Equal(t, "password", env[1].ValueFrom.SecretKeyRef.Key)
+
+	require.Len(t, commonSpec.Volumes, 1)
+	assert.Equal(t, "my-instance-kafka-ca", commonSpec.Volumes[0].Name)
+	assert.Equal(t, "my-cluster-ca-cert", commonSpec.Volumes[0].Secret.SecretName)
+	require.Len(t, commonSpec.VolumeMounts, 1)
+	assert.Equal(t, "/var/run/secrets/kafka/ca", commonSpec.VolumeMounts[0].MountPath)
+}
+
+func TestUpdateProvisionedSCRAM(t *testing.T) {
+	// prepare
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance", Namespace: "observability"})
+	jaeger.Annotations = map[string]string{v1.AnnotationProvisionedKafkaKey: v1.AnnotationProvisionedKafkaValue}
+	jaeger.Spec.Kafka.Authentication = &v1.JaegerKafkaAuthenticationSpec{Type: v1.KafkaAuthenticationSCRAMSHA512}
+	commonSpec := v1.JaegerCommonSpec{}
+	env := []corev1.EnvVar{}
+	options := []string{}
+
+	// test
+	Update(jaeger, Consumer, &commonSpec, &env, &options)
+
+	// verify
+	assert.ElementsMatch(t, []string{
+		"--kafka.consumer.brokers=my-instance-kafka-bootstrap.observability.svc.cluster.local:9094",
+		"--kafka.consumer.tls.enabled=true",
+		"--kafka.consumer.tls.ca=/var/run/secrets/kafka/ca/ca.crt",
+		"--kafka.consumer.authentication=plaintext",
+		"--kafka.consumer.plaintext.mechanism=SCRAM-SHA-512",
+		"--kafka.consumer.plaintext.username=my-instance",
+	}, options)
+
+	require.Len(t, env, 1)
+	assert.Equal(t, "KAFKA_CONSUMER_PLAINTEXT_PASSWORD", env[0].Name)
+	assert.Equal(t, "my-instance", env[0].ValueFrom.SecretKeyRef.Name)
+
+	require.Len(t, commonSpec.Volumes, 1)
+	assert.Equal(t, "my-instance-cluster-ca-cert", commonSpec.Volumes[0].Secret.SecretName)
+}
+
+func TestUpdateExternalTLS(t *testing.T) {
+	// prepare
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Kafka = v1.JaegerKafkaSpec{
+		Brokers: "kafka:9093",
+		TLS:     &v1.JaegerKafkaTLSSpec{CASecretName: "kafka-ca", SkipHostVerify: true},
+		Authentication: &v1.JaegerKafkaAuthenticationSpec{
+			Type:       v1.KafkaAuthenticationTLS,
+			SecretName: "kafka-user",
+		},
+	}
+	commonSpec := v1.JaegerCommonSpec{}
+	env := []corev1.EnvVar{}
+	options := []string{}
+
+	// test
+	Update(jaeger, Producer, &commonSpec, &env, &options)
+
+	// verify
+	assert.ElementsMatch(t, []string{
+		"--kafka.producer.brokers=kafka:9093",
+		"--kafka.producer.tls.enabled=true",
+		"--kafka.producer.tls.ca=/var/run/secrets/kafka/ca/ca.crt",
+		"--kafka.producer.tls.skip-host-verify=true",
+		"--kafka.producer.authentication=tls",
+		"--kafka.producer.tls.cert=/var/run/secrets/kafka/credentials/user.crt",
+		"--kafka.producer.tls.key=/var/run/secrets/kafka/credentials/user.key",
+	}, options)
+	assert.Empty(t, env)
+	require.Len(t, commonSpec.Volumes, 2)
+	assert.Equal(t, "my-instance-kafka-credentials", commonSpec.Volumes[1].Name)
+	assert.Equal(t, "kafka-user", commonSpec.Volumes[1].Secret.SecretName)
+}
+
+func TestUpdatePlaintext(t *testing.T) {
+	// prepare
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Kafka = v1.JaegerKafkaSpec{
+		Brokers: "kafka:9092",
+		Authentication: &v1.JaegerKafkaAuthenticationSpec{
+			Type:       v1.KafkaAuthenticationPlaintext,
+			SecretName: "kafka-user",
+		},
+	}
+	commonSpec := v1.JaegerCommonSpec{}
+	env := []corev1.EnvVar{}
+	options := []string{}
+
+	// test
+	Update(jaeger, Producer, &commonSpec, &env, &options)
+
+	// verify
+	assert.ElementsMatch(t, []string{
+		"--kafka.producer.brokers=kafka:9092",
+		"--kafka.producer.authentication=plaintext",
+		"--kafka.producer.plaintext.mechanism=PLAIN",
+	}, options)
+	assert.Len(t, env, 2)
+	assert.Empty(t, commonSpec.Volumes)
+}
+
+func TestUpdateKerberos(t *testing.T) {
+	// prepare
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Kafka = v1.JaegerKafkaSpec{
+		Brokers: "kafka:9092",
+		Authentication: &v1.JaegerKafkaAuthenticationSpec{
+			Type:       v1.KafkaAuthenticationKerberos,
+			SecretName: "kafka-keytab",
+			Kerberos: &v1.JaegerKafkaKerberosSpec{
+				Realm:    "EXAMPLE.COM",
+				Username: "jaeger",
+			},
+		},
+	}
+	commonSpec := v1.JaegerCommonSpec{}
+	env := []corev1.EnvVar{}
+	options := []string{}
+
+	// test
+	Update(jaeger, Consumer, &commonSpec, &env, &options)
+
+	// verify
+	assert.ElementsMatch(t, []string{
+		"--kafka.consumer.brokers=kafka:9092",
+		"--kafka.consumer.authentication=kerberos",
+		"--kafka.consumer.kerberos.use-keytab=true",
+		"--kafka.consumer.kerberos.keytab-file=/var/run/secrets/kafka/credentials/keytab",
+		"--kafka.consumer.kerberos.config-file=/var/run/secrets/kafka/credentials/krb5.conf",
+		"--kafka.consumer.kerberos.service-name=kafka",
+		"--kafka.consumer.kerberos.realm=EXAMPLE.COM",
+		"--kafka.consumer.kerberos.username=jaeger",
+	}, options)
+	assert.Empty(t, env)
+	require.Len(t, commonSpec.Volumes, 1)
+	assert.Equal(t, "kafka-keytab", commonSpec.Volumes[0].Secret.SecretName)
+}
+
+func TestUpdateKeepsBrokersFromOptions(t *testing.T) {
+	// prepare
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Kafka.TLS = &v1.JaegerKafkaTLSSpec{CASecretName: "kafka-ca"}
+	commonSpec := v1.JaegerCommonSpec{}
+	env := []corev1.EnvVar{}
+	options := []string{"--kafka.producer.brokers=kafka:9093"}
+
+	// test
+	Update(jaeger, Producer, &commonSpec, &env, &options)
+
+	// verify
+	assert.ElementsMatch(t, []string{
+		"--kafka.producer.brokers=kafka:9093",
+		"--kafka.producer.tls.enabled=true",
+		"--kafka.producer.tls.ca=/var/run/secrets/kafka/ca/ca.crt",
+		"--kafka.producer.authentication=none",
+	}, options)
+}
@@ -0,0 +1,110 @@
+package kafka
+
+import (
+	"github.com/spf13/viper"
+	corev1 "k8s.io/api/core/v1"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+)
+
+// profile is a named sizing of the provisioned Kafka cluster
+type profile struct {
+	replicas int32
+
+	// replicationFactor and minISR apply to the internal topics of the brokers
+	replicationFactor int32
+	minISR            int32
+
+	size string
+}
+
+var profiles = map[v1.JaegerKafkaProvisioningProfile]profile{
+	v1.KafkaProvisioningProfileProduction: {
+		replicas:          3,
+		replicationFactor: 3,
+		minISR:            2,
+		size:              "100Gi",
+	},
+	v1.KafkaProvisioningProfileMinimal: {
+		replicas:          1,
+		replicationFactor: 1,
+		minISR:            1,
+		size:              "10Gi",
+	},
+}
+
+// sizing is the size of the provisioned Kafka cluster, from its profile and the overrides of the instance
+type sizing struct {
+	replicas          int32
+	replicationFactor int32
+	minISR            int32
+	storageClassName  *string
+	size              string
+	resources         *corev1.ResourceRequirements
+}
+
+// operatorProfile returns the profile set for the operator, which is the minimal one when only the deprecated
+// kafka-provisioning-minimal flag is set
+func operatorProfile() v1.JaegerKafkaProvisioningProfile {
+	if !viper.IsSet(v1.FlagKafkaProvisioningProfile) && viper.GetBool(v1.FlagKafkaProvisioningMinimal) {
+		return v1.KafkaProvisioningProfileMinimal
+	}
+	return v1.JaegerKafkaProvisioningProfile(viper.GetString(v1.FlagKafkaProvisioningProfile))
+}
+
+// sizingFor returns the size of the Kafka cluster provisioned for the given instance
+func sizingFor(jaeger *v1.Jaeger) sizing {
+	spec := v1.JaegerKafkaProvisioningSpec{}
+	if jaeger.Spec.Kafka.Provisioning != nil {
+		spec = *jaeger.Spec.Kafka.Provisioning
+	}
+
+	name := spec.Profile
+	if name == "" {
+		name = operatorProfile()
+	}
+	p, ok := profiles[name]
+	if !ok {
+		if name != "" {
+			jaeger.Logger().V(1).Info("unknown kafka provisioning profile, using the production profile", "profile", name)
+		}
+		p = profiles[v1.KafkaProvisioningProfileProduction]
+	}
+
+	s := sizing{
+		replicas:          p.replicas,
+		replicationFactor: p.replicationFactor,
+		minISR:            p.minISR,
+		storageClassName:  spec.StorageClassName,
+		size:              p.size,
+		resources:         spec.Resources,
+	}
+	if spec.Replicas != nil {
+		s.replicas = *spec.Replicas
+	}
+	if spec.Size != nil {
+		s.size = spec.Size.String()
+	}
+
+	// the internal topics can't be replicated on more brokers than there are
+	if s.replicationFactor > s.replicas {
+		s.replicationFactor = s.replicas
+	}
+	if s.minISR > s.replicationFactor {
+		s.minISR = s.replicationFactor
+	}
+	return s
+}
+
+// volume returns the persistent volume of each broker
+func (s sizing) volume() map[string]interface{} {
+	volume := map[string]interface{}{
+		"type":        "persistent-claim",
+		"size":        s.size,
+		"deleteClaim": false,
+	}
+	if s.storageClassName != nil {
+		volume["class"] = *s.storageClassName
+	}
+	return volume
+}
@@ -1,39 +1,113 @@
 package kafka
 
 import (
-	"fmt"
-
-	"github.com/spf13/viper"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/autodetect"
 	"github.com/jaegertracing/jaeger-operator/pkg/kafka/v1beta2"
 	"github.com/jaegertracing/jaeger-operator/pkg/util"
 )
 
-// Persistent returns the custom resource for a persistent Kafka
-// Reference: https://github.com/strimzi/strimzi-kafka-operator/blob/master/examples/kafka/kafka-persistent.yaml
+// defaultTopic is the topic used by the collector and ingester when none is set in their options
+const defaultTopic = "jaeger-spans"
+
+// Mode returns whether the Kafka cluster provisioned for the instance runs in KRaft mode or with ZooKeeper. The mode
+// of an existing cluster is kept: only new clusters run in KRaft mode, when the Kafka Operator supports KafkaNodePools.
+func Mode(jaeger *v1.Jaeger) string {
+	if mode, ok := jaeger.Annotations[v1.AnnotationKafkaModeKey]; ok {
+		return mode
+	}
+	if jaeger.Annotations[v1.AnnotationProvisionedKafkaKey] == v1.AnnotationProvisionedKafkaValue {
+		// the clusters provisioned before the mode was recorded run with ZooKeeper
+		return v1.AnnotationKafkaModeZooKeeper
+	}
+	if autodetect.OperatorConfiguration.IsKafkaNodePoolsAvailable() {
+		return v1.AnnotationKafkaModeKRaft
+	}
+	return v1.AnnotationKafkaModeZooKeeper
+}
+
+// Persistent returns the custom resource for a persistent Kafka. In KRaft mode, the brokers are in the node pools
+// returned by NodePools. Otherwise, the cluster runs with ZooKeeper. See Mode.
+// Reference: https://github.com/strimzi/strimzi-kafka-operator/blob/main/packaging/examples/kafka/kafka-persistent.yaml
 func Persistent(jaeger *v1.Jaeger) v1beta2.Kafka {
-	var replicas, replFactor, minIst, storage uint
-	if viper.GetBool("kafka-provisioning-minimal") {
-		jaeger.Logger().V(1).Info("usage of kafka-provisioning-minimal is not supported")
-		replicas = 1
-		replFactor = 1
-		minIst = 1
-		storage = 10
+	size := sizingFor(jaeger)
+
+	listeners := []map[string]interface{}{
+		{
+			"name": "plain",
+			"port": 9092,
+			"type": "internal",
+			"tls":  false,
+		},
+		{
+			"name": "tls",
+			"port": 9093,
+			"type": "internal",
+			"tls":  true,
+		},
+	}
+	if AuthenticationType(jaeger) == v1.KafkaAuthenticationSCRAMSHA512 {
+		listeners = append(listeners, map[string]interface{}{
+			"name": "scram",
+			"port": 9094,
+			"type": "internal",
+			"tls":  true,
+			"authentication": map[string]interface{}{
+				"type": "scram-sha-512",
+			},
+		})
+	}
+
+	config := map[string]interface{}{
+		"offsets.topic.replication.factor":         size.replicationFactor,
+		"transaction.state.log.replication.factor": size.replicationFactor,
+		"transaction.state.log.min.isr":            size.minISR,
+	}
+
+	kafka := map[string]interface{}{
+		"listeners": listeners,
+		"config":    config,
+	}
+	spec := map[string]interface{}{
+		"kafka": kafka,
+		"entityOperator": map[string]interface{}{
+			"topicOperator": map[string]interface{}{},
+			"userOperator":  map[string]interface{}{},
+		},
+	}
+
+	var annotations map[string]string
+	if Mode(jaeger) == v1.AnnotationKafkaModeKRaft {
+		// the brokers, their storage and resources are defined by the node pools
+		annotations = map[string]string{
+			"strimzi.io/node-pools": "enabled",
+			"strimzi.io/kraft":      "enabled",
+		}
 	} else {
-		replicas = 3
-		replFactor = 3
-		minIst = 2
-		storage = 100
+		kafka["replicas"] = size.replicas
+		kafka["storage"] = map[string]interface{}{
+			"type":    "jbod",
+			"volumes": []map[string]interface{}{withID(size.volume(), 0)},
+		}
+		if size.resources != nil {
+			kafka["resources"] = size.resources
+		}
+		config["log.message.format.version"] = "2.3"
+		spec["zookeeper"] = map[string]interface{}{
+			"replicas": size.replicas,
+			"storage":  size.volume(),
+		}
 	}
 
 	trueVar := true
 	return v1beta2.Kafka{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      jaeger.Name,
-			Namespace: jaeger.Namespace,
-			Labels:    util.Labels(jaeger.Name, "kafka", *jaeger),
+			Name:        jaeger.Name,
+			Namespace:   jaeger.Namespace,
+			Labels:      util.Labels(jaeger.Name, "kafka", *jaeger),
+			Annotations: annotations,
 			OwnerReferences: []metav1.OwnerReference{
 				{
 					APIVersion: jaeger.APIVersion,
@@ -45,56 +119,70 @@ func Persistent(jaeger *v1.Jaeger) v1beta2.Kafka {
 			},
 		},
 		Spec: v1beta2.KafkaSpec{
-			FreeForm: v1.NewFreeForm(map[string]interface{}{
-				"kafka": map[string]interface{}{
-					"replicas": uint(replicas),
-					"listeners": []map[string]interface{}{
-						{
-							"name": "plain",
-							"port": 9092,
-							"type": "internal",
-							"tls":  false,
-						},
-						{
-							"name": "tls",
-							"port": 9093,
-							"type": "internal",
-							"tls":  true,
-						},
-					},
-					"config": map[string]interface{}{
-						"offsets.topic.replication.factor":         replFactor,
-						"transaction.state.log.replication.factor": replFactor,
-						"transaction.state.log.min.isr":            minIst,
-						"log.message.format.version":               "2.3",
-					},
-					"storage": map[string]interface{}{
-						"type": "jbod",
-						"volumes": []map[string]interface{}{{
-							"id":          0,
-							"type":        "persistent-claim",
-							"size":        fmt.Sprintf("%dGi", storage),
-							"deleteClaim": false,
-						}},
-					},
-				},
-				"zookeeper": map[string]interface{}{
-					"replicas": uint(replicas),
-					"storage": map[string]interface{}{
-						"type":        "persistent-claim",
-						"size":        fmt.Sprintf("%dGi", storage),
-						"deleteClaim": false,
-					},
-				},
-				"entityOperator": map[string]interface{}{
-					"topicOperator": map[string]interface{}{},
-					"userOperator":  map[string]interface{}{},
-				},
-			}),
+			FreeForm: v1.NewFreeForm(spec),
 		},
 	}
 }
 
+// NodePools returns the custom resources for the node pools of the KRaft Kafka cluster returned by Persistent: a
+// single pool of nodes acting both as controllers and brokers. No node pools are returned for clusters running with
+// ZooKeeper.
+// Reference: https://github.com/strimzi/strimzi-kafka-operator/blob/main/packaging/examples/kafka/kraft/kafka-single-node.yaml
+func NodePools(jaeger *v1.Jaeger) []v1beta2.KafkaNodePool {
+	if Mode(jaeger) != v1.AnnotationKafkaModeKRaft {
+		return []v1beta2.KafkaNodePool{}
+	}
+
+	size := sizingFor(jaeger)
+
+	// the controllers keep the cluster metadata on the volume of the brokers
+	volume := withID(size.volume(), 0)
+	volume["kraftMetadata"] = "shared"
+
+	spec := map[string]interface{}{
+		"replicas": size.replicas,
+		"roles":    []string{"controller", "broker"},
+		"storage": map[string]interface{}{
+			"type":    "jbod",
+			"volumes": []map[string]interface{}{volume},
+		},
+	}
+	if size.resources != nil {
+		spec["resources"] = size.resources
+	}
+
+	name := util.DNSName(util.Truncate("%s-dual-role", 63, jaeger.Name))
+	labels := util.Labels(name, "kafkanodepool", *jaeger)
+	// the node pool belongs to the cluster with the name of the instance
+	labels["strimzi.io/cluster"] = jaeger.Name
+
+	trueVar := true
+	return []v1beta2.KafkaNodePool{{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: jaeger.Namespace,
+			Labels:    labels,
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: jaeger.APIVersion,
+					Kind:       jaeger.Kind,
+					Name:       jaeger.Name,
+					UID:        jaeger.UID,
+					Controller: &trueVar,
+				},
+			},
+		},
+		Spec: v1beta2.KafkaNodePoolSpec{
+			FreeForm: v1.NewFreeForm(spec),
+		},
+	}}
+}
+
+func withID(volume map[string]interface{}, id int) map[string]interface{} {
+	volume["id"] = id
+	return volume
+}
+
 // User returns a custom resource for a Kafka user. The Kafka Operator will then create a secret with the
 // credentials for this user
 func User(jaeger *v1.Jaeger) v1beta2.KafkaUser {
@@ -124,9 +212,106 @@ func User(jaeger *v1.Jaeger) v1beta2.KafkaUser {
 		Spec: v1beta2.KafkaUserSpec{
 			FreeForm: v1.NewFreeForm(map[string]interface{}{
 				"authentication": map[string]interface{}{
-					"type": "tls",
+					"type": userAuthenticationType(jaeger),
 				},
 			}),
 		},
 	}
 }
+
+// userAuthenticationType returns the type of the credentials the Kafka Operator creates for the user: a password
+// when SCRAM-SHA-512 is requested, a client certificate otherwise
+func userAuthenticationType(jaeger *v1.Jaeger) string {
+	if AuthenticationType(jaeger) == v1.KafkaAuthenticationSCRAMSHA512 {
+		return string(v1.KafkaAuthenticationSCRAMSHA512)
+	}
+	return string(v1.KafkaAuthenticationTLS)
+}
+
+// Topics returns the custom resources for the topics the collector writes the spans to and the ingester reads them
+// from, configured after the topic spec of the instance. The Kafka Operator will then create them in the cluster.
+func Topics(jaeger *v1.Jaeger) []v1beta2.KafkaTopic {
+	trueVar := true
+
+	spec := v1.JaegerKafkaTopicSpec{}
+	if jaeger.Spec.Kafka.Topic != nil {
+		spec = *jaeger.Spec.Kafka.Topic
+	}
+
+	config := map[string]interface{}{}
+	if spec.RetentionMs != nil {
+		config["retention.ms"] = *spec.RetentionMs
+	}
+	if spec.Compression != "" {
+		config["compression.type"] = spec.Compression
+	}
+
+	topics := []v1beta2.KafkaTopic{}
+	for _, topic := range topicNames(jaeger) {
+		name := util.DNSName(util.Truncate("%s-%s", 63, jaeger.Name, topic))
+
+		labels := util.Labels(name, "kafkatopic", *jaeger)
+		// the topic is created in the cluster with the name of the instance
+		labels["strimzi.io/cluster"] = jaeger.Name
+
+		topicSpec := map[string]interface{}{
+			"topicName": topic,
+		}
+		if spec.Partitions != nil {
+			topicSpec["partitions"] = *spec.Partitions
+		}
+		if spec.Replicas != nil {
+			topicSpec["replicas"] = *spec.Replicas
+		}
+		if len(config) > 0 {
+			topicSpec["config"] = config
+		}
+
+		topics = append(topics, v1beta2.KafkaTopic{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: jaeger.Namespace,
+				Labels:    labels,
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion: jaeger.APIVersion,
+						Kind:       jaeger.Kind,
+						Name:       jaeger.Name,
+						UID:        jaeger.UID,
+						Controller: &trueVar,
+					},
+				},
+			},
+			Spec: v1beta2.KafkaTopicSpec{
+				FreeForm: v1.NewFreeForm(topicSpec),
+			},
+		})
+	}
+	return topics
+}
+
+// topicNames returns the topics the collector writes the spans to and the ingester reads them from, which are
+// usually the same one
+func topicNames(jaeger *v1.Jaeger) []string {
+	storageOpts := jaeger.Spec.Storage.Options.StringMap()
+	producer := jaeger.Spec.Collector.Options.StringMap()["kafka.producer.topic"]
+	if producer == "" {
+		producer = storageOpts["kafka.producer.topic"]
+	}
+	consumer := jaeger.Spec.Ingester.Options.StringMap()["kafka.consumer.topic"]
+	if consumer == "" {
+		consumer = storageOpts["kafka.consumer.topic"]
+	}
+
+	if producer == "" {
+		producer = defaultTopic
+	}
+	if consumer == "" {
+		consumer = defaultTopic
+	}
+
+	if producer == consumer {
+		return []string{producer}
+	}
+	return []string{producer, consumer}
+}
@@ -6,10 +6,13 @@ import (
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
 
 	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/autodetect"
 )
 
 func TestKafkaUserName(t *testing.T) {
@@ -74,7 +77,7 @@ func TestKafkaSizing(t *testing.T) {
 
 func TestKafkaMinimalSizing(t *testing.T) {
 	// prepare
-	viper.Set("kafka-provisioning-minimal", true)
+	viper.Set(v1.FlagKafkaProvisioningProfile, string(v1.KafkaProvisioningProfileMinimal))
 	defer viper.Reset()
 	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
 
@@ -104,3 +107,358 @@ func TestKafkaMinimalSizing(t *testing.T) {
 	assert.True(t, found)
 	assert.EqualValues(t, 1, v)
 }
+
+func TestKafkaMinimalSizingFromDeprecatedFlag(t *testing.T) {
+	// prepare
+	viper.Set(v1.FlagKafkaProvisioningMinimal, true)
+	defer viper.Reset()
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+
+	// test
+	s := sizingFor(jaeger)
+
+	// verify
+	assert.EqualValues(t, 1, s.replicas)
+	assert.Equal(t, "10Gi", s.size)
+}
+
+func TestKafkaProfileFlagWinsOverDeprecatedFlag(t *testing.T) {
+	// prepare
+	viper.Set(v1.FlagKafkaProvisioningMinimal, true)
+	viper.Set(v1.FlagKafkaProvisioningProfile, string(v1.KafkaProvisioningProfileProduction))
+	defer viper.Reset()
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+
+	// test
+	s := sizingFor(jaeger)
+
+	// verify
+	assert.EqualValues(t, 3, s.replicas)
+}
+
+func TestKafkaSizingFromInstance(t *testing.T) {
+	// prepare
+	replicas := int32(5)
+	size := resource.MustParse("50Gi")
+	storageClassName := "fast"
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Kafka.Provisioning = &v1.JaegerKafkaProvisioningSpec{
+		Profile:          v1.KafkaProvisioningProfileMinimal,
+		Replicas:         &replicas,
+		StorageClassName: &storageClassName,
+		Size:             &size,
+		Resources: &corev1.ResourceRequirements{
+			Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("2Gi")},
+		},
+	}
+
+	// test
+	u := Persistent(jaeger)
+
+	// verify
+	contentMap, err := u.Spec.GetMap()
+	require.NoError(t, err)
+	v, found, err := unstructured.NestedFieldNoCopy(contentMap, "kafka", "replicas")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.EqualValues(t, 5, v)
+
+	// the replication of the internal topics comes from the profile
+	v, found, err = unstructured.NestedFieldNoCopy(contentMap, "kafka", "config", "offsets.topic.replication.factor")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.EqualValues(t, 1, v)
+
+	volumes, found, err := unstructured.NestedSlice(contentMap, "kafka", "storage", "volumes")
+	require.NoError(t, err)
+	assert.True(t, found)
+	require.Len(t, volumes, 1)
+	assert.Equal(t, "50Gi", volumes[0].(map[string]interface{})["size"])
+	assert.Equal(t, "fast", volumes[0].(map[string]interface{})["class"])
+
+	memory, found, err := unstructured.NestedString(contentMap, "kafka", "resources", "limits", "memory")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "2Gi", memory)
+}
+
+func TestKafkaSizingReplicationBoundByReplicas(t *testing.T) {
+	// prepare
+	replicas := int32(1)
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Kafka.Provisioning = &v1.JaegerKafkaProvisioningSpec{
+		Replicas: &replicas,
+	}
+
+	// test
+	s := sizingFor(jaeger)
+
+	// verify
+	assert.EqualValues(t, 1, s.replicas)
+	assert.EqualValues(t, 1, s.replicationFactor)
+	assert.EqualValues(t, 1, s.minISR)
+	assert.Equal(t, "100Gi", s.size)
+}
+
+func TestKafkaMode(t *testing.T) {
+	for _, tt := range []struct {
+		name        string
+		nodePools   autodetect.KafkaNodePoolsAvailability
+		annotations map[string]string
+		expected    string
+	}{
+		{
+			name:      "new cluster with node pools",
+			nodePools: autodetect.KafkaNodePoolsAvailabilityYes,
+			expected:  v1.AnnotationKafkaModeKRaft,
+		},
+		{
+			name:      "new cluster without node pools",
+			nodePools: autodetect.KafkaNodePoolsAvailabilityNo,
+			expected:  v1.AnnotationKafkaModeZooKeeper,
+		},
+		{
+			name:        "existing cluster provisioned before the mode was recorded",
+			nodePools:   autodetect.KafkaNodePoolsAvailabilityYes,
+			annotations: map[string]string{v1.AnnotationProvisionedKafkaKey: v1.AnnotationProvisionedKafkaValue},
+			expected:    v1.AnnotationKafkaModeZooKeeper,
+		},
+		{
+			name:      "existing cluster in KRaft mode",
+			nodePools: autodetect.KafkaNodePoolsAvailabilityNo,
+			annotations: map[string]string{
+				v1.AnnotationProvisionedKafkaKey: v1.AnnotationProvisionedKafkaValue,
+				v1.AnnotationKafkaModeKey:        v1.AnnotationKafkaModeKRaft,
+			},
+			expected: v1.AnnotationKafkaModeKRaft,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			// prepare
+			autodetect.OperatorConfiguration.SetKafkaNodePoolsAvailability(tt.nodePools)
+			defer viper.Reset()
+			jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+			jaeger.Annotations = tt.annotations
+
+			// test and verify
+			assert.Equal(t, tt.expected, Mode(jaeger))
+		})
+	}
+}
+
+func TestKafkaKeepsZooKeeperMode(t *testing.T) {
+	// prepare
+	autodetect.OperatorConfiguration.SetKafkaNodePoolsAvailability(autodetect.KafkaNodePoolsAvailabilityYes)
+	defer viper.Reset()
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Annotations = map[string]string{v1.AnnotationKafkaModeKey: v1.AnnotationKafkaModeZooKeeper}
+
+	// test
+	k := Persistent(jaeger)
+	pools := NodePools(jaeger)
+
+	// verify
+	assert.NotContains(t, k.Annotations, "strimzi.io/kraft")
+	contentMap, err := k.Spec.GetMap()
+	require.NoError(t, err)
+	assert.Contains(t, contentMap, "zookeeper")
+	assert.Empty(t, pools)
+}
+
+func TestKafkaKRaft(t *testing.T) {
+	// prepare
+	autodetect.OperatorConfiguration.SetKafkaNodePoolsAvailability(autodetect.KafkaNodePoolsAvailabilityYes)
+	defer viper.Reset()
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+
+	// test
+	k := Persistent(jaeger)
+
+	// verify
+	assert.Equal(t, "enabled", k.Annotations["strimzi.io/kraft"])
+	assert.Equal(t, "enabled", k.Annotations["strimzi.io/node-pools"])
+
+	contentMap, err := k.Spec.GetMap()
+	require.NoError(t, err)
+	assert.NotContains(t, contentMap, "zookeeper")
+
+	kafka, found, err := unstructured.NestedMap(contentMap, "kafka")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.NotContains(t, kafka, "replicas")
+	assert.NotContains(t, kafka, "storage")
+	assert.NotContains(t, kafka["config"], "log.message.format.version")
+}
+
+func TestKafkaNodePools(t *testing.T) {
+	// prepare
+	autodetect.OperatorConfiguration.SetKafkaNodePoolsAvailability(autodetect.KafkaNodePoolsAvailabilityYes)
+	defer viper.Reset()
+	storageClassName := "fast"
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance", Namespace: "observability"})
+	jaeger.Spec.Kafka.Provisioning = &v1.JaegerKafkaProvisioningSpec{
+		Profile:          v1.KafkaProvisioningProfileMinimal,
+		StorageClassName: &storageClassName,
+	}
+
+	// test
+	pools := NodePools(jaeger)
+
+	// verify
+	require.Len(t, pools, 1)
+	assert.Equal(t, "my-instance-dual-role", pools[0].Name)
+	assert.Equal(t, "observability", pools[0].Namespace)
+	assert.Equal(t, "my-instance", pools[0].Labels["strimzi.io/cluster"])
+
+	contentMap, err := pools[0].Spec.GetMap()
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, contentMap["replicas"])
+	assert.Equal(t, []interface{}{"controller", "broker"}, contentMap["roles"])
+
+	volumes, found, err := unstructured.NestedSlice(contentMap, "storage", "volumes")
+	require.NoError(t, err)
+	assert.True(t, found)
+	require.Len(t, volumes, 1)
+	volume := volumes[0].(map[string]interface{})
+	assert.Equal(t, "10Gi", volume["size"])
+	assert.Equal(t, "fast", volume["class"])
+	assert.Equal(t, "shared", volume["kraftMetadata"])
+}
+
+func TestKafkaNoNodePoolsWithZooKeeper(t *testing.T) {
+	// prepare
+	autodetect.OperatorConfiguration.SetKafkaNodePoolsAvailability(autodetect.KafkaNodePoolsAvailabilityNo)
+	defer viper.Reset()
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+
+	// test
+	pools := NodePools(jaeger)
+
+	// verify
+	assert.Empty(t, pools)
+}
+
+func TestKafkaTopicDefaults(t *testing.T) {
+	// prepare
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance", Namespace: "observability"})
+
+	// test
+	topics := Topics(jaeger)
+
+	// verify
+	require.Len(t, topics, 1)
+	assert.Equal(t, "my-instance-jaeger-spans", topics[0].Name)
+	assert.Equal(t, jaeger.Name, topics[0].Labels["strimzi.io/cluster"])
+
+	contentMap, err := topics[0].Spec.GetMap()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"topicName": "jaeger-spans"}, contentMap)
+}
+
+func TestKafkaTopicSpec(t *testing.T) {
+	// prepare
+	partitions, replicas, retention := int32(12), int32(3), int64(86400000)
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Kafka.Topic = &v1.JaegerKafkaTopicSpec{
+		Partitions:  &partitions,
+		Replicas:    &replicas,
+		RetentionMs: &retention,
+		Compression: "zstd",
+	}
+
+	// test
+	topics := Topics(jaeger)
+
+	// verify
+	require.Len(t, topics, 1)
+	contentMap, err := topics[0].Spec.GetMap()
+	require.NoError(t, err)
+	assert.EqualValues(t, 12, contentMap["partitions"])
+	assert.EqualValues(t, 3, contentMap["replicas"])
+
+	config, found, err := unstructured.NestedMap(contentMap, "config")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.EqualValues(t, 86400000, config["retention.ms"])
+	assert.Equal(t, "zstd", config["compression.type"])
+}
+
+func TestKafkaTopicNamesFromOptions(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		collector map[string]interface{}
+		ingester  map[string]interface{}
+		storage   map[string]interface{}
+		expected  []string
+	}{
+		{
+			name:      "same topic",
+			collector: map[string]interface{}{"kafka.producer.topic": "spans"},
+			ingester:  map[string]interface{}{"kafka.consumer.topic": "spans"},
+			expected:  []string{"spans"},
+		},
+		{
+			name:      "different topics",
+			collector: map[string]interface{}{"kafka.producer.topic": "spans"},
+			expected:  []string{"spans", "jaeger-spans"},
+		},
+		{
+			name:     "from the storage options",
+			storage:  map[string]interface{}{"kafka.producer.topic": "spans", "kafka.consumer.topic": "spans"},
+			expected: []string{"spans"},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			// prepare
+			jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+			jaeger.Spec.Collector.Options = v1.NewOptions(tt.collector)
+			jaeger.Spec.Ingester.Options = v1.NewOptions(tt.ingester)
+			jaeger.Spec.Storage.Options = v1.NewOptions(tt.storage)
+
+			// test
+			names := topicNames(jaeger)
+
+			// verify
+			assert.Equal(t, tt.expected, names)
+		})
+	}
+}
+
+func TestKafkaUserSCRAM(t *testing.T) {
+	// prepare
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Kafka.Authentication = &v1.JaegerKafkaAuthenticationSpec{Type: v1.KafkaAuthenticationSCRAMSHA512}
+
+	// test
+	u := User(jaeger)
+
+	// verify
+	contentMap, err := u.Spec.GetMap()
+	require.NoError(t, err)
+	v, found, err := unstructured.NestedString(contentMap, "authentication", "type")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "scram-sha-512", v)
+}
+
+func TestKafkaSCRAMListener(t *testing.T) {
+	// prepare
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Kafka.Authentication = &v1.JaegerKafkaAuthenticationSpec{Type: v1.KafkaAuthenticationSCRAMSHA512}
+
+	// test
+	k := Persistent(jaeger)
+
+	// verify
+	contentMap, err := k.Spec.GetMap()
+	require.NoError(t, err)
+	listeners, found, err := unstructured.NestedSlice(contentMap, "kafka", "listeners")
+	require.NoError(t, err)
+	assert.True(t, found)
+	require.Len(t, listeners, 3)
+
+	scram := listeners[2].(map[string]interface{})
+	assert.Equal(t, "scram", scram["name"])
+	assert.EqualValues(t, 9094, scram["port"])
+	assert.Equal(t, map[string]interface{}{"type": "scram-sha-512"}, scram["authentication"])
+}
@@ -0,0 +1,43 @@
+package v1beta2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+)
+
+// KafkaNodePoolSpec defines the desired state of KafkaNodePool
+type KafkaNodePoolSpec struct {
+	v1.FreeForm `json:",inline"`
+}
+
+// KafkaNodePoolStatus defines the observed state of KafkaNodePool
+type KafkaNodePoolStatus struct {
+	// +listType=set
+	Conditions []KafkaStatusCondition `json:"conditions,omitempty"`
+}
+
+// KafkaNodePool is the Schema for the kafkanodepools API
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=kafkanodepools,scope=Namespaced
+type KafkaNodePool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KafkaNodePoolSpec   `json:"spec,omitempty"`
+	Status KafkaNodePoolStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// KafkaNodePoolList contains a list of KafkaNodePool
+type KafkaNodePoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KafkaNodePool `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&KafkaNodePool{}, &KafkaNodePoolList{})
+}
@@ -0,0 +1,43 @@
+package v1beta2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+)
+
+// KafkaTopicSpec defines the desired state of KafkaTopic
+type KafkaTopicSpec struct {
+	v1.FreeForm `json:",inline"`
+}
+
+// KafkaTopicStatus defines the observed state of KafkaTopic
+type KafkaTopicStatus struct {
+	// +listType=set
+	Conditions []KafkaStatusCondition `json:"conditions,omitempty"`
+}
+
+// KafkaTopic is the Schema for the kafkatopics API
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=kafkatopics,scope=Namespaced
+type KafkaTopic struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KafkaTopicSpec   `json:"spec,omitempty"`
+	Status KafkaTopicStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// KafkaTopicList contains a list of KafkaTopic
+type KafkaTopicList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KafkaTopic `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&KafkaTopic{}, &KafkaTopicList{})
+}
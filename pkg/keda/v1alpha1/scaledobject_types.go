@@ -0,0 +1,88 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+)
+
+// ScaledObjectSpec defines the desired state of ScaledObject, only with the fields we use
+type ScaledObjectSpec struct {
+	ScaleTargetRef *ScaleTarget `json:"scaleTargetRef"`
+
+	// +optional
+	PollingInterval *int32 `json:"pollingInterval,omitempty"`
+
+	// +optional
+	CooldownPeriod *int32 `json:"cooldownPeriod,omitempty"`
+
+	// +optional
+	MinReplicaCount *int32 `json:"minReplicaCount,omitempty"`
+
+	// +optional
+	MaxReplicaCount *int32 `json:"maxReplicaCount,omitempty"`
+
+	Triggers []ScaleTriggers `json:"triggers"`
+}
+
+// ScaleTarget holds the reference to the scale target object
+type ScaleTarget struct {
+	Name string `json:"name"`
+
+	// +optional
+	APIVersion string `json:"apiVersion,omitempty"`
+
+	// +optional
+	Kind string `json:"kind,omitempty"`
+}
+
+// ScaleTriggers reference the scaler that will be used
+type ScaleTriggers struct {
+	Type string `json:"type"`
+
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	Metadata map[string]string `json:"metadata"`
+
+	// +optional
+	AuthenticationRef *AuthenticationRef `json:"authenticationRef,omitempty"`
+}
+
+// AuthenticationRef points to the TriggerAuthentication object used by the trigger
+type AuthenticationRef struct {
+	Name string `json:"name"`
+
+	// +optional
+	Kind string `json:"kind,omitempty"`
+}
+
+// ScaledObjectStatus defines the observed state of ScaledObject
+type ScaledObjectStatus struct {
+	v1.FreeForm `json:",inline"`
+}
+
+// ScaledObject is the Schema for the scaledobjects API
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=scaledobjects,scope=Namespaced
+type ScaledObject struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ScaledObjectSpec   `json:"spec"`
+	Status ScaledObjectStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ScaledObjectList contains a list of ScaledObject
+type ScaledObjectList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ScaledObject `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ScaledObject{}, &ScaledObjectList{})
+}
@@ -0,0 +1,258 @@
+package monitoring
+
+import (
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+	monitoringv1 "github.com/jaegertracing/jaeger-operator/pkg/monitoring/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/service"
+	"github.com/jaegertracing/jaeger-operator/pkg/util"
+)
+
+const (
+	// metricsPort is the name of the container and service ports exposing the admin endpoints
+	metricsPort = "admin-http"
+
+	// instanceLabel is the target label identifying the Jaeger instance of the scraped metrics, used by the alerts
+	instanceLabel = "jaeger_instance"
+)
+
+// Monitoring builds the Prometheus Operator objects scraping and alerting on the metrics of the Jaeger components
+type Monitoring struct {
+	jaeger *v1.Jaeger
+}
+
+// New builds a new Monitoring struct based on the given spec
+func New(jaeger *v1.Jaeger) *Monitoring {
+	return &Monitoring{jaeger: jaeger}
+}
+
+// ServiceMonitors returns the service monitors for the components exposing their admin port through a service,
+// when service monitors are requested
+func (m *Monitoring) ServiceMonitors() []monitoringv1.ServiceMonitor {
+	monitors := []monitoringv1.ServiceMonitor{}
+	if m.jaeger.Spec.Observability.Metrics.MonitorType != v1.JaegerServiceMonitor {
+		return monitors
+	}
+
+	for _, component := range m.components() {
+		svc, ok := m.service(component)
+		if !ok {
+			continue
+		}
+
+		relabelings := m.relabelings()
+		if svc == "service-collector" {
+			// the headless service selects the very same pods, which would be scraped twice
+			relabelings = append(relabelings, monitoringv1.RelabelConfig{
+				SourceLabels: []string{"__meta_kubernetes_service_name"},
+				Regex:        service.GetNameForHeadlessCollectorService(m.jaeger),
+				Action:       "drop",
+			})
+		}
+
+		monitors = append(monitors, monitoringv1.ServiceMonitor{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "ServiceMonitor",
+				APIVersion: monitoringv1.GroupVersion.String(),
+			},
+			ObjectMeta: m.objectMeta(component, "servicemonitor"),
+			Spec: monitoringv1.ServiceMonitorSpec{
+				Endpoints: []monitoringv1.Endpoint{{
+					Port:           metricsPort,
+					Path:           "/metrics",
+					Interval:       m.jaeger.Spec.Observability.Metrics.Interval,
+					RelabelConfigs: relabelings,
+				}},
+				Selector:          *m.selector(svc),
+				NamespaceSelector: monitoringv1.NamespaceSelector{MatchNames: []string{m.jaeger.Namespace}},
+			},
+		})
+	}
+	return monitors
+}
+
+// PodMonitors returns the pod monitors for the components, when pod monitors are requested. When service monitors
+// are requested, only the components without a service exposing their admin port get a pod monitor.
+func (m *Monitoring) PodMonitors() []monitoringv1.PodMonitor {
+	monitors := []monitoringv1.PodMonitor{}
+	monitorType := m.jaeger.Spec.Observability.Metrics.MonitorType
+	if monitorType != v1.JaegerPodMonitor && monitorType != v1.JaegerServiceMonitor {
+		return monitors
+	}
+
+	for _, component := range m.components() {
+		if _, ok := m.service(component); ok && monitorType == v1.JaegerServiceMonitor {
+			continue
+		}
+
+		monitors = append(monitors, monitoringv1.PodMonitor{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "PodMonitor",
+				APIVersion: monitoringv1.GroupVersion.String(),
+			},
+			ObjectMeta: m.objectMeta(component, "podmonitor"),
+			Spec: monitoringv1.PodMonitorSpec{
+				PodMetricsEndpoints: []monitoringv1.PodMetricsEndpoint{{
+					Port:           metricsPort,
+					Path:           "/metrics",
+					Interval:       m.jaeger.Spec.Observability.Metrics.Interval,
+					RelabelConfigs: m.relabelings(),
+				}},
+				Selector:          *m.selector(component),
+				NamespaceSelector: monitoringv1.NamespaceSelector{MatchNames: []string{m.jaeger.Namespace}},
+			},
+		})
+	}
+	return monitors
+}
+
+// PrometheusRules returns the rule with the standard alerts for the components of this instance, when requested
+func (m *Monitoring) PrometheusRules() []monitoringv1.PrometheusRule {
+	metrics := m.jaeger.Spec.Observability.Metrics
+	if metrics.AlertRules == nil || !*metrics.AlertRules || metrics.MonitorType == "" {
+		return []monitoringv1.PrometheusRule{}
+	}
+
+	selector := fmt.Sprintf(`namespace="%s",%s="%s"`, m.jaeger.Namespace, instanceLabel, m.jaeger.Name)
+	instance := fmt.Sprintf("%s/%s", m.jaeger.Namespace, m.jaeger.Name)
+	annotations := func(summary, description string) map[string]string {
+		return map[string]string{
+			"summary":     summary,
+			"description": fmt.Sprintf(description, instance),
+		}
+	}
+
+	rules := []monitoringv1.Rule{
+		{
+			Alert: "JaegerCollectorDroppingSpans",
+			Expr: intstr.FromString(fmt.Sprintf(
+				`sum by (pod) (rate(jaeger_collector_spans_dropped_total{%[1]s}[5m])) / sum by (pod) (rate(jaeger_collector_spans_received_total{%[1]s}[5m])) > 0.01`,
+				selector,
+			)),
+			For:         "15m",
+			Labels:      map[string]string{"severity": "warning"},
+			Annotations: annotations("Jaeger collector is dropping spans", "The collector {{ $labels.pod }} of the Jaeger instance %s is dropping {{ $value | humanizePercentage }} of the received spans."),
+		},
+		{
+			Alert: "JaegerCollectorQueueFull",
+			Expr: intstr.FromString(fmt.Sprintf(
+				`max by (pod) (jaeger_collector_queue_length{%[1]s}) / max by (pod) (jaeger_collector_queue_capacity{%[1]s}) > 0.8`,
+				selector,
+			)),
+			For:         "10m",
+			Labels:      map[string]string{"severity": "warning"},
+			Annotations: annotations("Jaeger collector queue is almost full", "The queue of the collector {{ $labels.pod }} of the Jaeger instance %s is {{ $value | humanizePercentage }} full."),
+		},
+		{
+			Alert: "JaegerStorageWriteErrors",
+			Expr: intstr.FromString(fmt.Sprintf(
+				`sum by (pod) (rate(jaeger_collector_spans_saved_by_svc_total{%[1]s,result="err"}[5m])) / sum by (pod) (rate(jaeger_collector_spans_saved_by_svc_total{%[1]s}[5m])) > 0.01`,
+				selector,
+			)),
+			For:         "15m",
+			Labels:      map[string]string{"severity": "warning"},
+			Annotations: annotations("Jaeger is failing to write spans", "The collector {{ $labels.pod }} of the Jaeger instance %s fails to write {{ $value | humanizePercentage }} of the spans to the storage."),
+		},
+	}
+
+	if m.jaeger.Spec.Strategy == v1.DeploymentStrategyStreaming {
+		rules = append(rules, monitoringv1.Rule{
+			Alert: "JaegerIngesterKafkaLag",
+			Expr: intstr.FromString(fmt.Sprintf(
+				`sum(jaeger_ingester_sarama_consumer_offset_lag{%s}) > 100000`,
+				selector,
+			)),
+			For:         "15m",
+			Labels:      map[string]string{"severity": "warning"},
+			Annotations: annotations("Jaeger ingester is lagging behind", "The ingesters of the Jaeger instance %s are {{ $value }} messages behind the Kafka topic."),
+		})
+	}
+
+	return []monitoringv1.PrometheusRule{{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "PrometheusRule",
+			APIVersion: monitoringv1.GroupVersion.String(),
+		},
+		ObjectMeta: m.objectMeta("alerts", "prometheusrule"),
+		Spec: monitoringv1.PrometheusRuleSpec{
+			Groups: []monitoringv1.RuleGroup{{
+				Name:  fmt.Sprintf("jaeger-%s", m.jaeger.Name),
+				Rules: rules,
+			}},
+		},
+	}}
+}
+
+// components returns the components of this instance, which are the pods exposing metrics
+func (m *Monitoring) components() []string {
+	components := []string{}
+	switch m.jaeger.Spec.Strategy {
+	case v1.DeploymentStrategyAllInOne:
+		components = append(components, "all-in-one")
+	case v1.DeploymentStrategyStreaming:
+		components = append(components, "collector", "query", "ingester")
+	default:
+		components = append(components, "collector", "query")
+	}
+
+	if strings.EqualFold(m.jaeger.Spec.Agent.Strategy, "daemonset") {
+		components = append(components, "agent")
+	}
+	return components
+}
+
+// service returns the component label of the service exposing the admin port of the given component, if any
+func (m *Monitoring) service(component string) (string, bool) {
+	switch component {
+	case "collector", "all-in-one":
+		// the collector service exposes the admin port of the all-in-one pod
+		return "service-collector", true
+	case "query":
+		return "service-query", true
+	default:
+		return "", false
+	}
+}
+
+// relabelings adds the instance label to the scraped metrics, so that the alerts can tell the instances apart
+func (m *Monitoring) relabelings() []monitoringv1.RelabelConfig {
+	return []monitoringv1.RelabelConfig{{
+		SourceLabels: []string{"__meta_kubernetes_pod_label_app_kubernetes_io_instance"},
+		TargetLabel:  instanceLabel,
+		Action:       "replace",
+	}}
+}
+
+func (m *Monitoring) objectMeta(component, kind string) metav1.ObjectMeta {
+	name := util.DNSName(util.Truncate("%s-%s", 63, m.jaeger.Name, component))
+
+	baseCommonSpec := v1.JaegerCommonSpec{
+		Labels: util.Labels(name, fmt.Sprintf("%s-%s", kind, component), *m.jaeger),
+	}
+	commonSpec := util.Merge([]v1.JaegerCommonSpec{
+		{Labels: m.jaeger.Spec.Observability.Metrics.Labels},
+		m.jaeger.Spec.JaegerCommonSpec,
+		baseCommonSpec,
+	})
+
+	return metav1.ObjectMeta{
+		Name:            name,
+		Namespace:       m.jaeger.Namespace,
+		Labels:          commonSpec.Labels,
+		Annotations:     commonSpec.Annotations,
+		OwnerReferences: []metav1.OwnerReference{util.AsOwner(m.jaeger)},
+	}
+}
+
+func (m *Monitoring) selector(component string) *metav1.LabelSelector {
+	return &metav1.LabelSelector{MatchLabels: map[string]string{
+		"app.kubernetes.io/instance":   util.Truncate(m.jaeger.Name, 63),
+		"app.kubernetes.io/component":  component,
+		"app.kubernetes.io/managed-by": "jaeger-operator",
+	}}
+}
@@ -0,0 +1,162 @@
+package monitoring
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+)
+
+func TestNoMonitorsByDefault(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	m := New(jaeger)
+	assert.Empty(t, m.ServiceMonitors())
+	assert.Empty(t, m.PodMonitors())
+	assert.Empty(t, m.PrometheusRules())
+}
+
+func TestPodMonitors(t *testing.T) {
+	for _, tt := range []struct {
+		strategy v1.DeploymentStrategy
+		agent    string
+		expected []string
+	}{
+		{
+			strategy: v1.DeploymentStrategyAllInOne,
+			expected: []string{"all-in-one"},
+		},
+		{
+			strategy: v1.DeploymentStrategyProduction,
+			expected: []string{"collector", "query"},
+		},
+		{
+			strategy: v1.DeploymentStrategyStreaming,
+			agent:    "DaemonSet",
+			expected: []string{"collector", "query", "ingester", "agent"},
+		},
+	} {
+		t.Run(string(tt.strategy), func(t *testing.T) {
+			// prepare
+			jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance", Namespace: "observability"})
+			jaeger.Spec.Strategy = tt.strategy
+			jaeger.Spec.Agent.Strategy = tt.agent
+			jaeger.Spec.Observability.Metrics.MonitorType = v1.JaegerPodMonitor
+			jaeger.Spec.Observability.Metrics.Interval = "30s"
+			jaeger.Spec.Observability.Metrics.Labels = map[string]string{"release": "prometheus"}
+
+			// test
+			monitors := New(jaeger).PodMonitors()
+
+			// verify
+			assert.Empty(t, New(jaeger).ServiceMonitors())
+			components := []string{}
+			for _, m := range monitors {
+				component := m.Spec.Selector.MatchLabels["app.kubernetes.io/component"]
+				components = append(components, component)
+				assert.Equal(t, "my-instance-"+component, m.Name)
+				assert.Equal(t, "prometheus", m.Labels["release"])
+				assert.Equal(t, "my-instance", m.Labels["app.kubernetes.io/instance"])
+				assert.Equal(t, []string{"observability"}, m.Spec.NamespaceSelector.MatchNames)
+				require.Len(t, m.Spec.PodMetricsEndpoints, 1)
+				assert.Equal(t, "admin-http", m.Spec.PodMetricsEndpoints[0].Port)
+				assert.Equal(t, "30s", m.Spec.PodMetricsEndpoints[0].Interval)
+				assert.Equal(t, "jaeger_instance", m.Spec.PodMetricsEndpoints[0].RelabelConfigs[0].TargetLabel)
+			}
+			assert.Equal(t, tt.expected, components)
+		})
+	}
+}
+
+func TestServiceMonitors(t *testing.T) {
+	// prepare
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance", Namespace: "observability"})
+	jaeger.Spec.Strategy = v1.DeploymentStrategyStreaming
+	jaeger.Spec.Observability.Metrics.MonitorType = v1.JaegerServiceMonitor
+
+	// test
+	serviceMonitors := New(jaeger).ServiceMonitors()
+	podMonitors := New(jaeger).PodMonitors()
+
+	// verify
+	require.Len(t, serviceMonitors, 2)
+	assert.Equal(t, "my-instance-collector", serviceMonitors[0].Name)
+	assert.Equal(t, "service-collector", serviceMonitors[0].Spec.Selector.MatchLabels["app.kubernetes.io/component"])
+	relabelings := serviceMonitors[0].Spec.Endpoints[0].RelabelConfigs
+	require.Len(t, relabelings, 2)
+	assert.Equal(t, "drop", relabelings[1].Action)
+	assert.Equal(t, "my-instance-collector-headless", relabelings[1].Regex)
+
+	assert.Equal(t, "my-instance-query", serviceMonitors[1].Name)
+	assert.Equal(t, "service-query", serviceMonitors[1].Spec.Selector.MatchLabels["app.kubernetes.io/component"])
+	assert.Len(t, serviceMonitors[1].Spec.Endpoints[0].RelabelConfigs, 1)
+
+	// the ingester has no service, so it's scraped through its pods
+	require.Len(t, podMonitors, 1)
+	assert.Equal(t, "my-instance-ingester", podMonitors[0].Name)
+}
+
+func TestServiceMonitorForAllInOne(t *testing.T) {
+	// prepare
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Strategy = v1.DeploymentStrategyAllInOne
+	jaeger.Spec.Observability.Metrics.MonitorType = v1.JaegerServiceMonitor
+
+	// test
+	monitors := New(jaeger).ServiceMonitors()
+
+	// verify
+	require.Len(t, monitors, 1)
+	assert.Equal(t, "my-instance-all-in-one", monitors[0].Name)
+	assert.Equal(t, "service-collector", monitors[0].Spec.Selector.MatchLabels["app.kubernetes.io/component"])
+	assert.Empty(t, New(jaeger).PodMonitors())
+}
+
+func TestPrometheusRules(t *testing.T) {
+	trueVar := true
+	for _, tt := range []struct {
+		strategy v1.DeploymentStrategy
+		alerts   []string
+	}{
+		{
+			strategy: v1.DeploymentStrategyProduction,
+			alerts:   []string{"JaegerCollectorDroppingSpans", "JaegerCollectorQueueFull", "JaegerStorageWriteErrors"},
+		},
+		{
+			strategy: v1.DeploymentStrategyStreaming,
+			alerts:   []string{"JaegerCollectorDroppingSpans", "JaegerCollectorQueueFull", "JaegerStorageWriteErrors", "JaegerIngesterKafkaLag"},
+		},
+	} {
+		t.Run(string(tt.strategy), func(t *testing.T) {
+			// prepare
+			jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance", Namespace: "observability"})
+			jaeger.Spec.Strategy = tt.strategy
+			jaeger.Spec.Observability.Metrics.MonitorType = v1.JaegerPodMonitor
+			jaeger.Spec.Observability.Metrics.AlertRules = &trueVar
+
+			// test
+			rules := New(jaeger).PrometheusRules()
+
+			// verify
+			require.Len(t, rules, 1)
+			assert.Equal(t, "my-instance-alerts", rules[0].Name)
+			require.Len(t, rules[0].Spec.Groups, 1)
+			alerts := []string{}
+			for _, r := range rules[0].Spec.Groups[0].Rules {
+				alerts = append(alerts, r.Alert)
+				assert.Contains(t, r.Expr.String(), `namespace="observability",jaeger_instance="my-instance"`)
+				assert.Contains(t, r.Annotations["description"], "observability/my-instance")
+			}
+			assert.Equal(t, tt.alerts, alerts)
+		})
+	}
+}
+
+func TestNoPrometheusRulesWithoutMonitors(t *testing.T) {
+	trueVar := true
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Observability.Metrics.AlertRules = &trueVar
+	assert.Empty(t, New(jaeger).PrometheusRules())
+}
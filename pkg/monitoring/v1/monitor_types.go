@@ -0,0 +1,121 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ServiceMonitorSpec defines the services to be scraped by Prometheus, only with the fields we use
+type ServiceMonitorSpec struct {
+	Endpoints []Endpoint `json:"endpoints"`
+
+	Selector metav1.LabelSelector `json:"selector"`
+
+	// +optional
+	NamespaceSelector NamespaceSelector `json:"namespaceSelector,omitempty"`
+}
+
+// Endpoint defines a scrapeable endpoint serving Prometheus metrics
+type Endpoint struct {
+	// +optional
+	Port string `json:"port,omitempty"`
+
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// +optional
+	Interval string `json:"interval,omitempty"`
+
+	// +optional
+	RelabelConfigs []RelabelConfig `json:"relabelings,omitempty"`
+}
+
+// PodMonitorSpec defines the pods to be scraped by Prometheus, only with the fields we use
+type PodMonitorSpec struct {
+	PodMetricsEndpoints []PodMetricsEndpoint `json:"podMetricsEndpoints"`
+
+	Selector metav1.LabelSelector `json:"selector"`
+
+	// +optional
+	NamespaceSelector NamespaceSelector `json:"namespaceSelector,omitempty"`
+}
+
+// PodMetricsEndpoint defines a scrapeable endpoint of a pod serving Prometheus metrics
+type PodMetricsEndpoint struct {
+	// +optional
+	Port string `json:"port,omitempty"`
+
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// +optional
+	Interval string `json:"interval,omitempty"`
+
+	// +optional
+	RelabelConfigs []RelabelConfig `json:"relabelings,omitempty"`
+}
+
+// NamespaceSelector selects the namespaces of the scraped objects
+type NamespaceSelector struct {
+	// +optional
+	Any bool `json:"any,omitempty"`
+
+	// +optional
+	MatchNames []string `json:"matchNames,omitempty"`
+}
+
+// RelabelConfig allows dynamic rewriting of the label set of the targets
+type RelabelConfig struct {
+	// +optional
+	SourceLabels []string `json:"sourceLabels,omitempty"`
+
+	// +optional
+	Regex string `json:"regex,omitempty"`
+
+	// +optional
+	TargetLabel string `json:"targetLabel,omitempty"`
+
+	// +optional
+	Action string `json:"action,omitempty"`
+}
+
+// ServiceMonitor is the Schema for the servicemonitors API
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=servicemonitors,scope=Namespaced
+type ServiceMonitor struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ServiceMonitorSpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceMonitorList contains a list of ServiceMonitor
+type ServiceMonitorList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceMonitor `json:"items"`
+}
+
+// PodMonitor is the Schema for the podmonitors API
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=podmonitors,scope=Namespaced
+type PodMonitor struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec PodMonitorSpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+
+// PodMonitorList contains a list of PodMonitor
+type PodMonitorList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PodMonitor `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ServiceMonitor{}, &ServiceMonitorList{}, &PodMonitor{}, &PodMonitorList{})
+}
@@ -0,0 +1,65 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// PrometheusRuleSpec contains the alerting and recording rules, only with the fields we use
+type PrometheusRuleSpec struct {
+	// +optional
+	Groups []RuleGroup `json:"groups,omitempty"`
+}
+
+// RuleGroup is a list of sequentially evaluated rules
+type RuleGroup struct {
+	Name string `json:"name"`
+
+	// +optional
+	Interval string `json:"interval,omitempty"`
+
+	Rules []Rule `json:"rules"`
+}
+
+// Rule describes an alerting or recording rule
+type Rule struct {
+	// +optional
+	Record string `json:"record,omitempty"`
+
+	// +optional
+	Alert string `json:"alert,omitempty"`
+
+	Expr intstr.IntOrString `json:"expr"`
+
+	// +optional
+	For string `json:"for,omitempty"`
+
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// PrometheusRule is the Schema for the prometheusrules API
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=prometheusrules,scope=Namespaced
+type PrometheusRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec PrometheusRuleSpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+
+// PrometheusRuleList contains a list of PrometheusRule
+type PrometheusRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PrometheusRule `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&PrometheusRule{}, &PrometheusRuleList{})
+}
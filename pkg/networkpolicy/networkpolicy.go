@@ -0,0 +1,365 @@
+package networkpolicy
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/autodetect"
+	"github.com/jaegertracing/jaeger-operator/pkg/config/sampling"
+	"github.com/jaegertracing/jaeger-operator/pkg/kafka"
+	"github.com/jaegertracing/jaeger-operator/pkg/service"
+	"github.com/jaegertracing/jaeger-operator/pkg/util"
+)
+
+// NetworkPolicies builds the network policies allowing only the expected flows between the Jaeger components
+type NetworkPolicies struct {
+	jaeger *v1.Jaeger
+}
+
+// New builds a new NetworkPolicies struct based on the given spec
+func New(jaeger *v1.Jaeger) *NetworkPolicies {
+	return &NetworkPolicies{jaeger: jaeger}
+}
+
+// Get returns the network policies for the components of the current instance, or nothing when they aren't enabled
+func (n *NetworkPolicies) Get() []networkingv1.NetworkPolicy {
+	if n.jaeger.Spec.NetworkPolicy.Enabled == nil || !*n.jaeger.Spec.NetworkPolicy.Enabled {
+		return []networkingv1.NetworkPolicy{}
+	}
+
+	policies := []networkingv1.NetworkPolicy{}
+	switch n.jaeger.Spec.Strategy {
+	case v1.DeploymentStrategyAllInOne:
+		policies = append(policies, n.allInOne())
+	case v1.DeploymentStrategyStreaming:
+		policies = append(policies, n.collector(), n.query(), n.ingester())
+	default:
+		policies = append(policies, n.collector(), n.query())
+	}
+
+	if strings.EqualFold(n.jaeger.Spec.Agent.Strategy, "daemonset") {
+		policies = append(policies, n.agent())
+	}
+
+	return policies
+}
+
+func (n *NetworkPolicies) collector() networkingv1.NetworkPolicy {
+	args := n.jaeger.Spec.Collector.Options.ToArgs()
+
+	ingress := []networkingv1.NetworkPolicyIngressRule{
+		// agents, injected sidecars and instrumented applications may live in any namespace
+		{Ports: ports(collectorPorts(n.jaeger)...), From: anyNamespace()},
+		{Ports: ports(util.GetAdminPort(args, 14269)), From: n.monitoringFrom()},
+	}
+
+	storagePorts := n.storagePorts()
+	if n.jaeger.Spec.Strategy == v1.DeploymentStrategyStreaming {
+		kafkaPorts := n.kafkaPorts(n.jaeger.Spec.Collector.Options, kafka.Producer)
+		switch {
+		case !sampling.IsAdaptive(n.jaeger):
+			storagePorts = kafkaPorts
+		case storagePorts != nil:
+			// the adaptive sampling keeps its state in the span storage
+			storagePorts = append(storagePorts, kafkaPorts...)
+		}
+	}
+
+	return n.policy("collector", ingress, n.egress(storagePorts))
+}
+
+func (n *NetworkPolicies) query() networkingv1.NetworkPolicy {
+	args := n.jaeger.Spec.Query.Options.ToArgs()
+
+	ingress := []networkingv1.NetworkPolicyIngressRule{
+		{Ports: ports(n.queryPort(), 16685), From: n.ingressFrom()},
+		{Ports: ports(util.GetAdminPort(args, 16687)), From: n.monitoringFrom()},
+	}
+
+	storagePorts := n.storagePorts()
+	if storagePorts != nil && n.jaeger.Spec.Query.MetricsStorage.Type != "" {
+		storagePorts = append(storagePorts, endpointPorts(n.jaeger.Spec.Query.Options.StringMap()["prometheus.server-url"], 9090)...)
+	}
+
+	egress := n.egress(storagePorts)
+	if egress != nil {
+		// the agent sidecar reports the query's own spans to the collector
+		egress = append(egress, networkingv1.NetworkPolicyEgressRule{
+			Ports: ports(14250),
+			To:    []networkingv1.NetworkPolicyPeer{{PodSelector: n.selector("collector")}},
+		})
+		if n.jaeger.Spec.Ingress.Security == v1.IngressSecurityOAuthProxy {
+			// the OAuth proxy validates the tokens against the API server
+			egress = append(egress, networkingv1.NetworkPolicyEgressRule{Ports: ports(443, 6443)})
+		}
+	}
+
+	return n.policy("query", ingress, egress)
+}
+
+func (n *NetworkPolicies) ingester() networkingv1.NetworkPolicy {
+	args := n.jaeger.Spec.Ingester.Options.ToArgs()
+
+	ingress := []networkingv1.NetworkPolicyIngressRule{
+		{Ports: ports(util.GetAdminPort(args, 14270)), From: n.monitoringFrom()},
+	}
+
+	storagePorts := n.storagePorts()
+	if storagePorts != nil {
+		storagePorts = append(storagePorts, n.kafkaPorts(n.jaeger.Spec.Ingester.Options, kafka.Consumer)...)
+	}
+
+	return n.policy("ingester", ingress, n.egress(storagePorts))
+}
+
+func (n *NetworkPolicies) allInOne() networkingv1.NetworkPolicy {
+	args := n.jaeger.Spec.AllInOne.Options.ToArgs()
+
+	agentPorts := ports(5778)
+	agentPorts = append(agentPorts, udpPorts(5775, 6831, 6832)...)
+	ingress := []networkingv1.NetworkPolicyIngressRule{
+		{Ports: append(ports(collectorPorts(n.jaeger)...), agentPorts...), From: anyNamespace()},
+		{Ports: ports(n.queryPort(), 16685), From: n.ingressFrom()},
+		{Ports: ports(util.GetAdminPort(args, 14269)), From: n.monitoringFrom()},
+	}
+
+	return n.policy("all-in-one", ingress, n.egress(n.storagePorts()))
+}
+
+func (n *NetworkPolicies) agent() networkingv1.NetworkPolicy {
+	args := n.jaeger.Spec.Agent.Options.ToArgs()
+
+	agentPorts := ports(util.GetPort("--http-server.host-port=", args, 5778))
+	agentPorts = append(agentPorts, udpPorts(
+		util.GetPort("--processor.zipkin-compact.server-host-port=", args, 5775),
+		util.GetPort("--processor.jaeger-compact.server-host-port=", args, 6831),
+		util.GetPort("--processor.jaeger-binary.server-host-port=", args, 6832),
+	)...)
+	ingress := []networkingv1.NetworkPolicyIngressRule{
+		{Ports: agentPorts, From: anyNamespace()},
+		{Ports: ports(util.GetAdminPort(args, 14271)), From: n.monitoringFrom()},
+	}
+
+	egress := append(n.egress([]int32{}), networkingv1.NetworkPolicyEgressRule{
+		Ports: ports(14250),
+		To:    []networkingv1.NetworkPolicyPeer{{PodSelector: n.selector("collector")}},
+	})
+
+	return n.policy("agent", ingress, egress)
+}
+
+// policy builds the network policy for the pods of the given component. The egress traffic is only restricted
+// when the egress rules are known
+func (n *NetworkPolicies) policy(component string, ingress []networkingv1.NetworkPolicyIngressRule, egress []networkingv1.NetworkPolicyEgressRule) networkingv1.NetworkPolicy {
+	name := fmt.Sprintf("%s-%s", n.jaeger.Name, component)
+
+	baseCommonSpec := v1.JaegerCommonSpec{
+		Labels: util.Labels(name, fmt.Sprintf("networkpolicy-%s", component), *n.jaeger),
+	}
+	commonSpec := util.Merge([]v1.JaegerCommonSpec{n.jaeger.Spec.JaegerCommonSpec, baseCommonSpec})
+
+	policyTypes := []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}
+	if egress != nil {
+		policyTypes = append(policyTypes, networkingv1.PolicyTypeEgress)
+	}
+
+	return networkingv1.NetworkPolicy{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "NetworkPolicy",
+			APIVersion: "networking.k8s.io/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            util.DNSName(util.Truncate("%s-%s", 63, n.jaeger.Name, component)),
+			Namespace:       n.jaeger.Namespace,
+			Labels:          commonSpec.Labels,
+			Annotations:     commonSpec.Annotations,
+			OwnerReferences: []metav1.OwnerReference{util.AsOwner(n.jaeger)},
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: *n.selector(component),
+			Ingress:     ingress,
+			Egress:      egress,
+			PolicyTypes: policyTypes,
+		},
+	}
+}
+
+// egress returns the egress rules allowing the name resolution and the given storage ports, or nil when
+// the storage ports aren't known
+func (n *NetworkPolicies) egress(storagePorts []int32) []networkingv1.NetworkPolicyEgressRule {
+	if storagePorts == nil {
+		return nil
+	}
+
+	dns := ports(53)
+	dns = append(dns, udpPorts(53)...)
+	egress := []networkingv1.NetworkPolicyEgressRule{{Ports: dns}}
+	if len(storagePorts) > 0 {
+		egress = append(egress, networkingv1.NetworkPolicyEgressRule{
+			Ports: ports(storagePorts...),
+			To:    n.jaeger.Spec.NetworkPolicy.StorageTo,
+		})
+	}
+	return egress
+}
+
+// storagePorts returns the ports of the storage endpoints, or nil when they can't be determined
+func (n *NetworkPolicies) storagePorts() []int32 {
+	options := n.jaeger.Spec.Storage.Options.StringMap()
+	switch n.jaeger.Spec.Storage.Type {
+	case v1.JaegerMemoryStorage, v1.JaegerBadgerStorage:
+		return []int32{}
+	case v1.JaegerESStorage:
+		return endpointPorts(options["es.server-urls"], 9200)
+	case v1.JaegerCassandraStorage:
+		port := int32(9042)
+		if p, err := strconv.ParseInt(options["cassandra.port"], 10, 32); err == nil {
+			port = int32(p)
+		}
+		return []int32{port}
+	case v1.JaegerKafkaStorage:
+		return endpointPorts(options["kafka.producer.brokers"], 9092)
+	default:
+		// remote storage plugins can talk to anything
+		return nil
+	}
+}
+
+// kafkaPorts returns the ports of the brokers the given Kafka client connects to, which are set by the Kafka spec
+// of the instance the same way as for the client itself, or by the client's options otherwise
+func (n *NetworkPolicies) kafkaPorts(options v1.Options, client string) []int32 {
+	brokers := kafka.Brokers(n.jaeger)
+	if brokers == "" {
+		brokers = options.StringMap()[fmt.Sprintf("kafka.%s.brokers", client)]
+	}
+	return endpointPorts(brokers, 9092)
+}
+
+func (n *NetworkPolicies) queryPort() int32 {
+	if n.jaeger.Spec.Ingress.Security == v1.IngressSecurityOAuthProxy {
+		return 8443
+	}
+	return 16686
+}
+
+func (n *NetworkPolicies) selector(component string) *metav1.LabelSelector {
+	return &metav1.LabelSelector{MatchLabels: map[string]string{
+		"app.kubernetes.io/instance":   util.Truncate(n.jaeger.Name, 63),
+		"app.kubernetes.io/component":  component,
+		"app.kubernetes.io/managed-by": "jaeger-operator",
+	}}
+}
+
+func (n *NetworkPolicies) ingressFrom() []networkingv1.NetworkPolicyPeer {
+	if len(n.jaeger.Spec.NetworkPolicy.IngressFrom) > 0 {
+		return n.jaeger.Spec.NetworkPolicy.IngressFrom
+	}
+	if autodetect.OperatorConfiguration.GetPlatform() == autodetect.OpenShiftPlatform {
+		return []networkingv1.NetworkPolicyPeer{{NamespaceSelector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{"network.openshift.io/policy-group": "ingress"},
+		}}}
+	}
+	return anyNamespace()
+}
+
+func (n *NetworkPolicies) monitoringFrom() []networkingv1.NetworkPolicyPeer {
+	if len(n.jaeger.Spec.NetworkPolicy.MonitoringFrom) > 0 {
+		return n.jaeger.Spec.NetworkPolicy.MonitoringFrom
+	}
+	if autodetect.OperatorConfiguration.GetPlatform() == autodetect.OpenShiftPlatform {
+		return []networkingv1.NetworkPolicyPeer{{NamespaceSelector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{"network.openshift.io/policy-group": "monitoring"},
+		}}}
+	}
+	return []networkingv1.NetworkPolicyPeer{{
+		NamespaceSelector: &metav1.LabelSelector{},
+		PodSelector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{"app.kubernetes.io/name": "prometheus"},
+		},
+	}}
+}
+
+// collectorPorts returns the ports the collector receives spans on, which are the ones exposed by its service
+func collectorPorts(jaeger *v1.Jaeger) []int32 {
+	result := []int32{}
+	for _, p := range service.NewCollectorServices(jaeger, nil)[0].Spec.Ports {
+		if p.Name != "admin-http" {
+			result = append(result, p.Port)
+		}
+	}
+	return result
+}
+
+// endpointPorts returns the ports of the comma-separated list of endpoints, either URLs or host:port pairs
+func endpointPorts(endpoints string, defaultPort int32) []int32 {
+	seen := map[int32]bool{}
+	result := []int32{}
+	for _, endpoint := range strings.Split(endpoints, ",") {
+		port := endpointPort(strings.TrimSpace(endpoint), defaultPort)
+		if !seen[port] {
+			seen[port] = true
+			result = append(result, port)
+		}
+	}
+	return result
+}
+
+func endpointPort(endpoint string, defaultPort int32) int32 {
+	if strings.Contains(endpoint, "://") {
+		u, err := url.Parse(endpoint)
+		if err != nil {
+			return defaultPort
+		}
+		if u.Port() == "" {
+			switch u.Scheme {
+			case "https":
+				return 443
+			case "http":
+				return 80
+			}
+			return defaultPort
+		}
+		endpoint = u.Host
+	}
+
+	_, p, err := net.SplitHostPort(endpoint)
+	if err != nil {
+		return defaultPort
+	}
+	port, err := strconv.ParseInt(p, 10, 32)
+	if err != nil {
+		return defaultPort
+	}
+	return int32(port)
+}
+
+func anyNamespace() []networkingv1.NetworkPolicyPeer {
+	return []networkingv1.NetworkPolicyPeer{{NamespaceSelector: &metav1.LabelSelector{}}}
+}
+
+func ports(numbers ...int32) []networkingv1.NetworkPolicyPort {
+	return protocolPorts(corev1.ProtocolTCP, numbers)
+}
+
+func udpPorts(numbers ...int32) []networkingv1.NetworkPolicyPort {
+	return protocolPorts(corev1.ProtocolUDP, numbers)
+}
+
+func protocolPorts(protocol corev1.Protocol, numbers []int32) []networkingv1.NetworkPolicyPort {
+	result := []networkingv1.NetworkPolicyPort{}
+	for _, number := range numbers {
+		proto := protocol
+		port := intstr.FromInt32(number)
+		result = append(result, networkingv1.NetworkPolicyPort{Protocol: &proto, Port: &port})
+	}
+	return result
+}
@@ -0,0 +1,282 @@
+package networkpolicy
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/autodetect"
+)
+
+func TestNetworkPoliciesDisabledByDefault(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	assert.Empty(t, New(jaeger).Get())
+}
+
+func TestNetworkPoliciesForStrategies(t *testing.T) {
+	for _, tt := range []struct {
+		strategy v1.DeploymentStrategy
+		agent    string
+		expected []string
+	}{
+		{
+			strategy: v1.DeploymentStrategyAllInOne,
+			expected: []string{"my-instance-all-in-one"},
+		},
+		{
+			strategy: v1.DeploymentStrategyProduction,
+			expected: []string{"my-instance-collector", "my-instance-query"},
+		},
+		{
+			strategy: v1.DeploymentStrategyStreaming,
+			expected: []string{"my-instance-collector", "my-instance-query", "my-instance-ingester"},
+		},
+		{
+			strategy: v1.DeploymentStrategyProduction,
+			agent:    "DaemonSet",
+			expected: []string{"my-instance-collector", "my-instance-query", "my-instance-agent"},
+		},
+	} {
+		t.Run(string(tt.strategy)+tt.agent, func(t *testing.T) {
+			// prepare
+			jaeger := enabled(v1.NewJaeger(types.NamespacedName{Name: "my-instance", Namespace: "observability"}))
+			jaeger.Spec.Strategy = tt.strategy
+			jaeger.Spec.Agent.Strategy = tt.agent
+			jaeger.Spec.Storage.Type = v1.JaegerMemoryStorage
+
+			// test
+			policies := New(jaeger).Get()
+
+			// verify
+			names := []string{}
+			for _, p := range policies {
+				names = append(names, p.Name)
+				assert.Equal(t, "observability", p.Namespace)
+				assert.Equal(t, "jaeger-operator", p.Spec.PodSelector.MatchLabels["app.kubernetes.io/managed-by"])
+				assert.Len(t, p.OwnerReferences, 1)
+			}
+			assert.Equal(t, tt.expected, names)
+		})
+	}
+}
+
+func TestCollectorNetworkPolicy(t *testing.T) {
+	// prepare
+	jaeger := enabled(v1.NewJaeger(types.NamespacedName{Name: "my-instance"}))
+	jaeger.Spec.Storage.Type = v1.JaegerESStorage
+	jaeger.Spec.Storage.Options = v1.NewOptions(map[string]interface{}{
+		"es.server-urls": "https://elasticsearch-0:9201,https://elasticsearch-1:9201",
+	})
+	storageTo := []networkingv1.NetworkPolicyPeer{{PodSelector: &metav1.LabelSelector{
+		MatchLabels: map[string]string{"app": "elasticsearch"},
+	}}}
+	jaeger.Spec.NetworkPolicy.StorageTo = storageTo
+
+	// test
+	p := New(jaeger).collector()
+
+	// verify
+	assert.Equal(t, "collector", p.Spec.PodSelector.MatchLabels["app.kubernetes.io/component"])
+	assert.Equal(t, "networkpolicy-collector", p.Labels["app.kubernetes.io/component"])
+	assert.Equal(t, []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress}, p.Spec.PolicyTypes)
+
+	require.Len(t, p.Spec.Ingress, 2)
+	assert.Equal(t, anyNamespace(), p.Spec.Ingress[0].From)
+	assert.Contains(t, portNumbers(p.Spec.Ingress[0].Ports), int32(14250))
+	assert.NotContains(t, portNumbers(p.Spec.Ingress[0].Ports), int32(14269))
+	assert.Equal(t, []int32{14269}, portNumbers(p.Spec.Ingress[1].Ports))
+	assert.Equal(t, "prometheus", p.Spec.Ingress[1].From[0].PodSelector.MatchLabels["app.kubernetes.io/name"])
+
+	require.Len(t, p.Spec.Egress, 2)
+	assert.Equal(t, []int32{53, 53}, portNumbers(p.Spec.Egress[0].Ports))
+	assert.Equal(t, []int32{9201}, portNumbers(p.Spec.Egress[1].Ports))
+	assert.Equal(t, storageTo, p.Spec.Egress[1].To)
+}
+
+func TestStreamingCollectorNetworkPolicy(t *testing.T) {
+	// prepare
+	jaeger := enabled(v1.NewJaeger(types.NamespacedName{Name: "my-instance"}))
+	jaeger.Spec.Strategy = v1.DeploymentStrategyStreaming
+	jaeger.Spec.Storage.Type = v1.JaegerCassandraStorage
+	jaeger.Spec.Collector.Options = v1.NewOptions(map[string]interface{}{
+		"kafka.producer.brokers": "my-cluster-kafka-brokers:9093",
+	})
+	jaeger.Spec.Ingester.Options = v1.NewOptions(map[string]interface{}{
+		"kafka.consumer.brokers": "my-cluster-kafka-brokers:9093",
+	})
+
+	// test
+	collector := New(jaeger).collector()
+	ingester := New(jaeger).ingester()
+
+	// verify
+	require.Len(t, collector.Spec.Egress, 2)
+	assert.Equal(t, []int32{9093}, portNumbers(collector.Spec.Egress[1].Ports))
+	require.Len(t, ingester.Spec.Egress, 2)
+	assert.Equal(t, []int32{9042, 9093}, portNumbers(ingester.Spec.Egress[1].Ports))
+	assert.Equal(t, []int32{14270}, portNumbers(ingester.Spec.Ingress[0].Ports))
+}
+
+func TestStreamingNetworkPolicyWithKafkaSpec(t *testing.T) {
+	for _, tt := range []struct {
+		name        string
+		provisioned bool
+		brokers     string
+		expected    int32
+	}{
+		{
+			name:        "provisioned",
+			provisioned: true,
+			expected:    9094,
+		},
+		{
+			name:     "external",
+			brokers:  "my-cluster-kafka-bootstrap.kafka:9096",
+			expected: 9096,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			// prepare
+			jaeger := enabled(v1.NewJaeger(types.NamespacedName{Name: "my-instance", Namespace: "observability"}))
+			jaeger.Spec.Strategy = v1.DeploymentStrategyStreaming
+			jaeger.Spec.Storage.Type = v1.JaegerCassandraStorage
+			jaeger.Spec.Kafka.Brokers = tt.brokers
+			jaeger.Spec.Kafka.Authentication = &v1.JaegerKafkaAuthenticationSpec{Type: v1.KafkaAuthenticationSCRAMSHA512}
+			if tt.provisioned {
+				jaeger.Annotations = map[string]string{v1.AnnotationProvisionedKafkaKey: v1.AnnotationProvisionedKafkaValue}
+			}
+			// the options set by a former version of the instance are replaced by the spec
+			jaeger.Spec.Collector.Options = v1.NewOptions(map[string]interface{}{
+				"kafka.producer.brokers": "my-old-cluster:9092",
+			})
+
+			// test
+			collector := New(jaeger).collector()
+			ingester := New(jaeger).ingester()
+
+			// verify
+			require.Len(t, collector.Spec.Egress, 2)
+			assert.Equal(t, []int32{tt.expected}, portNumbers(collector.Spec.Egress[1].Ports))
+			require.Len(t, ingester.Spec.Egress, 2)
+			assert.Equal(t, []int32{9042, tt.expected}, portNumbers(ingester.Spec.Egress[1].Ports))
+		})
+	}
+}
+
+func TestQueryNetworkPolicy(t *testing.T) {
+	// prepare
+	jaeger := enabled(v1.NewJaeger(types.NamespacedName{Name: "my-instance"}))
+	jaeger.Spec.Storage.Type = v1.JaegerCassandraStorage
+	jaeger.Spec.Ingress.Security = v1.IngressSecurityOAuthProxy
+	ingressFrom := []networkingv1.NetworkPolicyPeer{{NamespaceSelector: &metav1.LabelSelector{
+		MatchLabels: map[string]string{"name": "ingress-nginx"},
+	}}}
+	jaeger.Spec.NetworkPolicy.IngressFrom = ingressFrom
+
+	// test
+	p := New(jaeger).query()
+
+	// verify
+	require.Len(t, p.Spec.Ingress, 2)
+	assert.Equal(t, []int32{8443, 16685}, portNumbers(p.Spec.Ingress[0].Ports))
+	assert.Equal(t, ingressFrom, p.Spec.Ingress[0].From)
+	assert.Equal(t, []int32{16687}, portNumbers(p.Spec.Ingress[1].Ports))
+
+	require.Len(t, p.Spec.Egress, 4)
+	assert.Equal(t, []int32{9042}, portNumbers(p.Spec.Egress[1].Ports))
+	assert.Equal(t, []int32{14250}, portNumbers(p.Spec.Egress[2].Ports))
+	assert.Equal(t, "collector", p.Spec.Egress[2].To[0].PodSelector.MatchLabels["app.kubernetes.io/component"])
+	assert.Equal(t, []int32{443, 6443}, portNumbers(p.Spec.Egress[3].Ports))
+}
+
+func TestAllInOneNetworkPolicy(t *testing.T) {
+	// prepare
+	jaeger := enabled(v1.NewJaeger(types.NamespacedName{Name: "my-instance"}))
+	jaeger.Spec.Strategy = v1.DeploymentStrategyAllInOne
+	jaeger.Spec.Storage.Type = v1.JaegerMemoryStorage
+
+	// test
+	p := New(jaeger).allInOne()
+
+	// verify
+	assert.Equal(t, "all-in-one", p.Spec.PodSelector.MatchLabels["app.kubernetes.io/component"])
+	require.Len(t, p.Spec.Ingress, 3)
+	udp := []int32{}
+	for _, port := range p.Spec.Ingress[0].Ports {
+		if *port.Protocol == corev1.ProtocolUDP {
+			udp = append(udp, port.Port.IntVal)
+		}
+	}
+	assert.Equal(t, []int32{5775, 6831, 6832}, udp)
+
+	// the in-memory storage doesn't need anything but the name resolution
+	require.Len(t, p.Spec.Egress, 1)
+	assert.Equal(t, []int32{53, 53}, portNumbers(p.Spec.Egress[0].Ports))
+}
+
+func TestNetworkPolicyWithUnknownStorage(t *testing.T) {
+	// prepare
+	jaeger := enabled(v1.NewJaeger(types.NamespacedName{Name: "my-instance"}))
+	jaeger.Spec.Storage.Type = v1.JaegerGRPCPluginStorage
+
+	// test
+	p := New(jaeger).collector()
+
+	// verify
+	assert.Equal(t, []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}, p.Spec.PolicyTypes)
+	assert.Nil(t, p.Spec.Egress)
+}
+
+func TestNetworkPolicyOpenShiftDefaults(t *testing.T) {
+	// prepare
+	autodetect.OperatorConfiguration.SetPlatform(autodetect.OpenShiftPlatform)
+	defer viper.Reset()
+	jaeger := enabled(v1.NewJaeger(types.NamespacedName{Name: "my-instance"}))
+	jaeger.Spec.Storage.Type = v1.JaegerMemoryStorage
+
+	// test
+	p := New(jaeger).query()
+
+	// verify
+	assert.Equal(t, "ingress", p.Spec.Ingress[0].From[0].NamespaceSelector.MatchLabels["network.openshift.io/policy-group"])
+	assert.Equal(t, "monitoring", p.Spec.Ingress[1].From[0].NamespaceSelector.MatchLabels["network.openshift.io/policy-group"])
+}
+
+func TestEndpointPorts(t *testing.T) {
+	for _, tt := range []struct {
+		endpoints string
+		expected  []int32
+	}{
+		{endpoints: "", expected: []int32{9200}},
+		{endpoints: "http://elasticsearch:9200", expected: []int32{9200}},
+		{endpoints: "https://elasticsearch", expected: []int32{443}},
+		{endpoints: "http://elasticsearch", expected: []int32{80}},
+		{endpoints: "es-0:9201, es-1:9201,es-2:9202", expected: []int32{9201, 9202}},
+		{endpoints: "elasticsearch", expected: []int32{9200}},
+	} {
+		t.Run(tt.endpoints, func(t *testing.T) {
+			assert.Equal(t, tt.expected, endpointPorts(tt.endpoints, 9200))
+		})
+	}
+}
+
+func enabled(jaeger *v1.Jaeger) *v1.Jaeger {
+	trueVar := true
+	jaeger.Spec.NetworkPolicy.Enabled = &trueVar
+	return jaeger
+}
+
+func portNumbers(ports []networkingv1.NetworkPolicyPort) []int32 {
+	result := []int32{}
+	for _, p := range ports {
+		result = append(result, p.Port.IntVal)
+	}
+	return result
+}
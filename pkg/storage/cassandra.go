@@ -0,0 +1,234 @@
+package storage
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/autodetect"
+	cassandrav1beta1 "github.com/jaegertracing/jaeger-operator/pkg/cassandra/v1beta1"
+	k8ssandrav1alpha1 "github.com/jaegertracing/jaeger-operator/pkg/k8ssandra/v1alpha1"
+	"github.com/jaegertracing/jaeger-operator/pkg/util"
+)
+
+const (
+	defaultCassandraDatacenter    = "dc1"
+	defaultCassandraServerVersion = "4.1.5"
+	defaultCassandraSize          = int32(3)
+	defaultCassandraStorageSize   = "10Gi"
+
+	// the keyspace isn't replicated on more nodes than this, no matter the size of the datacenter
+	maxCassandraReplicationFactor = int32(3)
+)
+
+// ShouldProvisionCassandra returns whether a Cassandra cluster should be provisioned for the given instance: its
+// storage is cassandra, cass-operator is available and no servers have been set, unless they have been set by us
+func ShouldProvisionCassandra(jaeger *v1.Jaeger) bool {
+	if jaeger.Spec.Storage.Type != v1.JaegerCassandraStorage {
+		return false
+	}
+	if !autodetect.OperatorConfiguration.IsCassandraOperatorIntegrationEnabled() {
+		return false
+	}
+	if jaeger.Annotations[v1.AnnotationProvisionedCassandraKey] == v1.AnnotationProvisionedCassandraValue {
+		return true
+	}
+	_, found := jaeger.Spec.Storage.Options.GenericMap()["cassandra.servers"]
+	return !found
+}
+
+// CassandraDeployment represents a Cassandra cluster provisioned for Jaeger through cass-operator or K8ssandra
+type CassandraDeployment struct {
+	Jaeger *v1.Jaeger
+}
+
+// ConfigureStorage points the storage options and the create-schema job of the instance to the provisioned cluster.
+// It has to be called before the deployments and the dependencies are built from the instance.
+func (cd *CassandraDeployment) ConfigureStorage() {
+	jaeger := cd.Jaeger
+	if jaeger.Annotations == nil {
+		jaeger.Annotations = map[string]string{}
+	}
+	// the kind is decided when the cluster is first provisioned, and kept afterwards
+	jaeger.Annotations[v1.AnnotationCassandraKindKey] = cd.Kind()
+	// mark that we auto provisioned a cassandra for this instance
+	jaeger.Annotations[v1.AnnotationProvisionedCassandraKey] = v1.AnnotationProvisionedCassandraValue
+
+	options := jaeger.Spec.Storage.Options.GenericMap()
+	options["cassandra.servers"] = cd.ContactPoints()
+	options["cassandra.local-dc"] = cd.datacenter()
+	jaeger.Spec.Storage.Options = v1.NewOptions(options)
+
+	// the keyspace is replicated in the datacenter of the provisioned cluster
+	jaeger.Spec.Storage.CassandraCreateSchema.Datacenter = cd.datacenter()
+	if jaeger.Spec.Storage.CassandraCreateSchema.ReplicationFactor == nil {
+		replicationFactor := cd.size()
+		if replicationFactor > maxCassandraReplicationFactor {
+			replicationFactor = maxCassandraReplicationFactor
+		}
+		jaeger.Spec.Storage.CassandraCreateSchema.ReplicationFactor = &replicationFactor
+	}
+}
+
+// Kind returns the kind of the custom resource the cluster is provisioned with. The kind of an existing cluster is
+// kept: only new clusters are provisioned as K8ssandraClusters, when the K8ssandra operator is available.
+func (cd *CassandraDeployment) Kind() string {
+	if kind, ok := cd.Jaeger.Annotations[v1.AnnotationCassandraKindKey]; ok {
+		return kind
+	}
+	if autodetect.OperatorConfiguration.IsK8ssandraAvailable() {
+		return v1.AnnotationCassandraKindK8ssandra
+	}
+	return v1.AnnotationCassandraKindDatacenter
+}
+
+// ContactPoints returns the address of the service exposing the nodes of the provisioned datacenter
+func (cd *CassandraDeployment) ContactPoints() string {
+	return fmt.Sprintf("%s-%s-service.%s.svc.cluster.local", cd.clusterName(), cd.datacenter(), cd.Jaeger.Namespace)
+}
+
+// CredentialsSecretName returns the name of the secret with the superuser credentials created by cass-operator
+func (cd *CassandraDeployment) CredentialsSecretName() string {
+	return fmt.Sprintf("%s-superuser", cd.clusterName())
+}
+
+// InjectStorageConfiguration changes the given spec to read the Cassandra credentials from the secret created for
+// the provisioned cluster
+func (cd *CassandraDeployment) InjectStorageConfiguration(p *corev1.PodSpec) {
+	// we assume jaeger containers are first
+	if len(p.Containers) == 0 {
+		return
+	}
+	container := &p.Containers[0]
+	for _, credential := range []struct{ env, key string }{
+		{env: "CASSANDRA_USERNAME", key: "username"},
+		{env: "CASSANDRA_PASSWORD", key: "password"},
+	} {
+		envVar := corev1.EnvVar{
+			Name: credential.env,
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: cd.CredentialsSecretName()},
+					Key:                  credential.key,
+				},
+			},
+		}
+
+		// the create-schema job may already have the credentials from the options
+		replaced := false
+		for i := range container.Env {
+			if container.Env[i].Name == credential.env {
+				container.Env[i] = envVar
+				replaced = true
+			}
+		}
+		if !replaced {
+			container.Env = append(container.Env, envVar)
+		}
+	}
+}
+
+// CassandraDatacenter returns the cass-operator CR for the provisioned cluster
+// Reference: https://github.com/k8ssandra/cass-operator/blob/master/config/samples/cassandra-4.0.x/example-cassdc-minimal.yaml
+func (cd *CassandraDeployment) CassandraDatacenter() *cassandrav1beta1.CassandraDatacenter {
+	spec := cd.clusterSpec()
+	spec["clusterName"] = cd.clusterName()
+	spec["serverType"] = "cassandra"
+	spec["size"] = cd.size()
+
+	return &cassandrav1beta1.CassandraDatacenter{
+		ObjectMeta: cd.objectMeta(cd.datacenter(), "cassandradatacenter"),
+		Spec: cassandrav1beta1.CassandraDatacenterSpec{
+			FreeForm: v1.NewFreeForm(spec),
+		},
+	}
+}
+
+// K8ssandraCluster returns the K8ssandra CR for the provisioned cluster, which then creates the CassandraDatacenter
+// Reference: https://github.com/k8ssandra/k8ssandra-operator/blob/main/config/samples/k8ssandra-single-dc.yaml
+func (cd *CassandraDeployment) K8ssandraCluster() *k8ssandrav1alpha1.K8ssandraCluster {
+	cassandra := cd.clusterSpec()
+	cassandra["datacenters"] = []map[string]interface{}{{
+		"metadata": map[string]interface{}{
+			"name": cd.datacenter(),
+		},
+		"size": cd.size(),
+	}}
+
+	return &k8ssandrav1alpha1.K8ssandraCluster{
+		ObjectMeta: cd.objectMeta(cd.clusterName(), "k8ssandracluster"),
+		Spec: k8ssandrav1alpha1.K8ssandraClusterSpec{
+			FreeForm: v1.NewFreeForm(map[string]interface{}{
+				"cassandra": cassandra,
+			}),
+		},
+	}
+}
+
+// clusterSpec returns the settings shared by the CassandraDatacenter and the cassandra spec of the K8ssandraCluster
+func (cd *CassandraDeployment) clusterSpec() map[string]interface{} {
+	spec := cd.Jaeger.Spec.Storage.Cassandra
+
+	serverVersion := spec.ServerVersion
+	if serverVersion == "" {
+		serverVersion = defaultCassandraServerVersion
+	}
+
+	storageSize := resource.MustParse(defaultCassandraStorageSize)
+	if spec.StorageSize != nil {
+		storageSize = *spec.StorageSize
+	}
+	claim := map[string]interface{}{
+		"accessModes": []string{string(corev1.ReadWriteOnce)},
+		"resources": map[string]interface{}{
+			"requests": map[string]interface{}{
+				"storage": storageSize.String(),
+			},
+		},
+	}
+	if spec.StorageClassName != nil {
+		claim["storageClassName"] = *spec.StorageClassName
+	}
+
+	cluster := map[string]interface{}{
+		"serverVersion": serverVersion,
+		"storageConfig": map[string]interface{}{
+			"cassandraDataVolumeClaimSpec": claim,
+		},
+	}
+	if spec.Resources != nil {
+		cluster["resources"] = spec.Resources
+	}
+	return cluster
+}
+
+func (cd *CassandraDeployment) objectMeta(name, component string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:            name,
+		Namespace:       cd.Jaeger.Namespace,
+		Labels:          util.Labels(name, component, *cd.Jaeger),
+		OwnerReferences: []metav1.OwnerReference{util.AsOwner(cd.Jaeger)},
+	}
+}
+
+// clusterName is the name of the Cassandra cluster, after which cass-operator names its services and secrets
+func (cd *CassandraDeployment) clusterName() string {
+	return util.DNSName(cd.Jaeger.Name)
+}
+
+func (cd *CassandraDeployment) datacenter() string {
+	if cd.Jaeger.Spec.Storage.Cassandra.Datacenter != "" {
+		return cd.Jaeger.Spec.Storage.Cassandra.Datacenter
+	}
+	return defaultCassandraDatacenter
+}
+
+func (cd *CassandraDeployment) size() int32 {
+	if cd.Jaeger.Spec.Storage.Cassandra.Size != nil {
+		return *cd.Jaeger.Spec.Storage.Cassandra.Size
+	}
+	return defaultCassandraSize
+}
@@ -1,7 +1,9 @@
 package storage
 
 import (
+	"crypto/sha256"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -13,6 +15,160 @@ import (
 	"github.com/jaegertracing/jaeger-operator/pkg/util"
 )
 
+// cassandraSchemaScript creates the keyspace with the entrypoint of the create-schema image, whose statements don't
+// touch the existing tables but add the ones introduced by newer versions of Jaeger. It then alters the existing keyspace
+// to match the replication of the instance, and the tables of the keyspace holding the trace data to match its TTL: the
+// tables differ between the schema versions, so only the ones found in the keyspace are altered. The credentials are
+// passed to cqlsh through a cqlshrc file, keeping them off its command line.
+const cassandraSchemaScript = `/cassandra-schema/docker.sh || exit 1
+CQLSHRC=$(mktemp) || exit 1
+trap 'rm -f "$CQLSHRC"' EXIT
+if [ -n "$CASSANDRA_USERNAME" ]; then
+  printf '[authentication]\nusername = %s\npassword = %s\n' "$CASSANDRA_USERNAME" "$CASSANDRA_PASSWORD" > "$CQLSHRC"
+fi
+cql() {
+  ${CQLSH:-/opt/cassandra/bin/cqlsh} ${CQLSH_SSL} --cqlshrc "$CQLSHRC" -e "$1" "$CQLSH_HOST" "$CQLSH_PORT"
+}
+tables=$(cql "SELECT table_name FROM system_schema.tables WHERE keyspace_name = '$KEYSPACE';") || exit 1
+migration="$SCHEMA_MIGRATION"
+for table in $TTL_TABLES; do
+  if echo "$tables" | grep -qw "$table"; then
+    migration="$migration
+ALTER TABLE $KEYSPACE.$table WITH default_time_to_live = $TRACE_TTL;"
+  fi
+done
+cql "$migration"
+`
+
+// cassandraTTLTables are the tables holding the trace data, expiring after the trace TTL, in any of the schema versions
+// see: https://github.com/jaegertracing/jaeger/blob/main/plugin/storage/cassandra/schema/v004.cql.tmpl
+var cassandraTTLTables = []string{
+	"traces",
+	"service_names",
+	"operation_names",
+	"operation_names_v2",
+	"service_operation_index",
+	"service_name_index",
+	"duration_index",
+	"tag_index",
+}
+
+// cassandraSchema holds the settings of the keyspace managed by the create-schema job
+type cassandraSchema struct {
+	keyspace          string
+	mode              string
+	datacenter        string
+	replicationFactor int32
+	traceTTLSeconds   int64
+	image             string
+}
+
+// cassandraSchemaFor returns the settings of the keyspace of the given instance, with the defaults of the
+// create-schema image for the ones that aren't set
+func cassandraSchemaFor(jaeger *v1.Jaeger) cassandraSchema {
+	spec := jaeger.Spec.Storage.CassandraCreateSchema
+	s := cassandraSchema{
+		keyspace:   jaeger.Spec.Storage.Options.StringMap()["cassandra.keyspace"],
+		mode:       spec.Mode,
+		datacenter: spec.Datacenter,
+		// TTL for trace data, in seconds (default: 172800, 2 days)
+		// see: https://github.com/jaegertracing/jaeger/blob/main/plugin/storage/cassandra/schema/create.sh
+		traceTTLSeconds: 172800,
+		image:           util.InstanceImageName(*jaeger, spec.Image, "jaeger-cassandra-schema-image"),
+	}
+	if s.keyspace == "" {
+		s.keyspace = "jaeger_v1_test" // this is default in the image
+	}
+	if s.mode == "" {
+		s.mode = "prod"
+	}
+	if s.datacenter == "" {
+		s.datacenter = "test"
+	}
+
+	s.replicationFactor = 2
+	if s.mode == "test" {
+		s.replicationFactor = 1
+	}
+	if spec.ReplicationFactor != nil {
+		s.replicationFactor = *spec.ReplicationFactor
+	}
+
+	if spec.TraceTTL != "" {
+		if dur, err := time.ParseDuration(spec.TraceTTL); err == nil {
+			s.traceTTLSeconds = int64(dur.Seconds())
+		}
+	}
+	return s
+}
+
+// revision identifies the settings of the keyspace, so that the keyspace is migrated again when they change. The image
+// is part of it, so that the schema is upgraded along with the Jaeger version.
+func (s cassandraSchema) revision() string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf(
+		"%s/%s/%s/%d/%d/%s",
+		s.keyspace, s.mode, s.datacenter, s.replicationFactor, s.traceTTLSeconds, s.image,
+	)))
+	return fmt.Sprintf("%x", sum)[:10]
+}
+
+// migration returns the CQL statement altering the replication of an existing keyspace to match the settings, the TTL
+// of its tables being altered by the script for the tables found in the keyspace
+func (s cassandraSchema) migration() string {
+	replication := fmt.Sprintf("{'class': 'NetworkTopologyStrategy', '%s': '%d'}", s.datacenter, s.replicationFactor)
+	if s.mode == "test" {
+		replication = fmt.Sprintf("{'class': 'SimpleStrategy', 'replication_factor': '%d'}", s.replicationFactor)
+	}
+
+	return fmt.Sprintf("ALTER KEYSPACE %s WITH replication = %s;", s.keyspace, replication)
+}
+
+// CassandraSchemaStatus returns the status of the keyspace once the given create-schema job has completed, or nil when
+// the job isn't a create-schema job
+func CassandraSchemaStatus(job batchv1.Job) *v1.JaegerCassandraSchemaStatus {
+	revision, ok := job.Annotations[v1.AnnotationCassandraSchemaRevision]
+	if !ok || len(job.Spec.Template.Spec.Containers) == 0 {
+		return nil
+	}
+
+	container := job.Spec.Template.Spec.Containers[0]
+	env := func(name string) string {
+		if e := util.FindEnvVar(container.Env, name); e != nil {
+			return e.Value
+		}
+		return ""
+	}
+	replicationFactor, _ := strconv.ParseInt(env("REPLICATION_FACTOR"), 10, 32)
+	traceTTLSeconds, _ := strconv.ParseInt(env("TRACE_TTL"), 10, 64)
+
+	return &v1.JaegerCassandraSchemaStatus{
+		Phase:             v1.CassandraSchemaPhaseReady,
+		Revision:          revision,
+		Job:               job.Name,
+		Keyspace:          env("KEYSPACE"),
+		Mode:              env("MODE"),
+		Datacenter:        env("DATACENTER"),
+		ReplicationFactor: int32(replicationFactor),
+		TraceTTLSeconds:   traceTTLSeconds,
+		Image:             container.Image,
+	}
+}
+
+// cassandraSchemaJobName returns the name of the job migrating the keyspace to the given revision
+func cassandraSchemaJobName(jaeger *v1.Jaeger, revision string) string {
+	// while the name itself isn't a problem, Kubernetes will create a job with a label "job-name" with this value
+	// so, this value has to be restricted to 63 chars
+	return util.Truncate("%s-cassandra-schema-%s", 63, jaeger.Name, revision)
+}
+
+// LegacyCassandraSchemaJobName returns the name of the create-schema job run for the instance before its keyspace was
+// migrated along with the settings, when the job wasn't recorded in the status of the instance yet
+func LegacyCassandraSchemaJobName(jaeger *v1.Jaeger) string {
+	return util.Truncate("%s-cassandra-schema-job", 63, jaeger.Name)
+}
+
+// cassandraDeps returns the job creating the keyspace of the instance, or migrating it when its settings changed.
+// The job is named after the revision of the settings, so that a new job runs whenever they change.
 func cassandraDeps(jaeger *v1.Jaeger) []batchv1.Job {
 	trueVar := true
 
@@ -66,15 +222,14 @@ func cassandraDeps(jaeger *v1.Jaeger) []batchv1.Job {
 		Value: port,
 	})
 
-	keyspace := jaeger.Spec.Storage.Options.StringMap()["cassandra.keyspace"]
-	if keyspace == "" {
+	if jaeger.Spec.Storage.Options.StringMap()["cassandra.keyspace"] == "" {
 		jaeger.Logger().Info("Cassandra keyspace not specified. Using 'jaeger_v1_test' for the cassandra-create-schema job.")
-		keyspace = "jaeger_v1_test" // this is default in the image
 	}
 
+	schema := cassandraSchemaFor(jaeger)
 	envVars = append(envVars, corev1.EnvVar{
 		Name:  "KEYSPACE",
-		Value: keyspace,
+		Value: schema.keyspace,
 	})
 	username := jaeger.Spec.Storage.Options.StringMap()["cassandra.username"]
 	password := jaeger.Spec.Storage.Options.StringMap()["cassandra.password"]
@@ -91,7 +246,8 @@ func cassandraDeps(jaeger *v1.Jaeger) []batchv1.Job {
 		})
 	}
 
-	truncatedName := util.Truncate("%s-cassandra-schema-job", 63, jaeger.Name)
+	revision := schema.revision()
+	truncatedName := cassandraSchemaJobName(jaeger, revision)
 
 	commonSpec := &v1.JaegerCommonSpec{
 		Annotations: map[string]string{
@@ -119,24 +275,27 @@ func cassandraDeps(jaeger *v1.Jaeger) []batchv1.Job {
 	podTimeoutSeconds := int64(320)
 	podTimeout := &podTimeoutSeconds
 
-	// TTL for trace data, in seconds (default: 172800, 2 days)
-	// see: https://github.com/jaegertracing/jaeger/blob/main/plugin/storage/cassandra/schema/create.sh
-	traceTTLSeconds := "172800"
 	if jaeger.Spec.Storage.CassandraCreateSchema.TraceTTL != "" {
-		dur, err := time.ParseDuration(jaeger.Spec.Storage.CassandraCreateSchema.TraceTTL)
-		if err != nil {
+		if _, err := time.ParseDuration(jaeger.Spec.Storage.CassandraCreateSchema.TraceTTL); err != nil {
 			jaeger.Logger().Error(
 				err,
 				"Failed to parse cassandraCreateSchema.traceTTL to time.duration. Using the default.",
 				"timeout", jaeger.Spec.Storage.CassandraCreateSchema.TraceTTL,
 			)
-		} else {
-			traceTTLSeconds = fmt.Sprintf("%.0f", dur.Seconds())
 		}
 	}
 	envVars = append(envVars, corev1.EnvVar{
 		Name:  "TRACE_TTL",
-		Value: traceTTLSeconds,
+		Value: fmt.Sprintf("%d", schema.traceTTLSeconds),
+	}, corev1.EnvVar{
+		Name:  "REPLICATION_FACTOR",
+		Value: fmt.Sprintf("%d", schema.replicationFactor),
+	}, corev1.EnvVar{
+		Name:  "SCHEMA_MIGRATION",
+		Value: schema.migration(),
+	}, corev1.EnvVar{
+		Name:  "TTL_TABLES",
+		Value: strings.Join(cassandraTTLTables, " "),
 	})
 
 	if jaeger.Spec.Storage.CassandraCreateSchema.Timeout != "" {
@@ -164,11 +323,12 @@ func cassandraDeps(jaeger *v1.Jaeger) []batchv1.Job {
 				Kind:       "Job",
 			},
 			ObjectMeta: metav1.ObjectMeta{
-				// while the name itself isn't a problem, Kubernetes will create a job with a label "job-name" with this value
-				// so, this value has to be restricted to 63 chars
 				Name:      truncatedName,
 				Namespace: jaeger.Namespace,
 				Labels:    commonSpec.Labels,
+				Annotations: map[string]string{
+					v1.AnnotationCassandraSchemaRevision: revision,
+				},
 				OwnerReferences: []metav1.OwnerReference{
 					{
 						APIVersion: jaeger.APIVersion,
@@ -193,8 +353,10 @@ func cassandraDeps(jaeger *v1.Jaeger) []batchv1.Job {
 						SecurityContext:       commonSpec.SecurityContext,
 						Volumes:               commonSpec.Volumes,
 						Containers: []corev1.Container{{
-							Image:           util.ImageName(jaeger.Spec.Storage.CassandraCreateSchema.Image, "jaeger-cassandra-schema-image"),
+							Image:           schema.image,
 							Name:            truncatedName,
+							Command:         []string{"/bin/sh", "-c"},
+							Args:            []string{cassandraSchemaScript},
 							Env:             envVars,
 							EnvFrom:         envFromSource,
 							Resources:       commonSpec.Resources,
@@ -1,16 +1,20 @@
 package storage
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/jaegertracing/jaeger-operator/pkg/version"
 
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 
 	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/util"
 )
 
 func TestCassandraCustomImage(t *testing.T) {
@@ -223,3 +227,112 @@ func TestCassandraSecurityContextOverride(t *testing.T) {
 
 	assert.Equal(t, overrideSecurityContextVar, *b[0].Spec.Template.Spec.Containers[0].SecurityContext)
 }
+
+func TestCassandraSchemaMigration(t *testing.T) {
+	// prepare
+	rf := int32(3)
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Storage.Options = v1.NewOptions(map[string]interface{}{"cassandra.keyspace": "jaeger_v1_dc1"})
+	jaeger.Spec.Storage.CassandraCreateSchema.Datacenter = "dc1"
+	jaeger.Spec.Storage.CassandraCreateSchema.TraceTTL = "72h"
+	jaeger.Spec.Storage.CassandraCreateSchema.ReplicationFactor = &rf
+
+	// test
+	b := cassandraDeps(jaeger)
+
+	// verify
+	require.Len(t, b, 1)
+	container := b[0].Spec.Template.Spec.Containers[0]
+	assert.Equal(t, []string{"/bin/sh", "-c"}, container.Command)
+	assert.Equal(t, "3", util.FindEnvVar(container.Env, "REPLICATION_FACTOR").Value)
+	assert.Equal(t, "259200", util.FindEnvVar(container.Env, "TRACE_TTL").Value)
+
+	assert.Equal(t, "ALTER KEYSPACE jaeger_v1_dc1 WITH replication = {'class': 'NetworkTopologyStrategy', 'dc1': '3'};",
+		util.FindEnvVar(container.Env, "SCHEMA_MIGRATION").Value)
+
+	// the tables of every schema version are listed, the script altering the ones found in the keyspace
+	tables := strings.Fields(util.FindEnvVar(container.Env, "TTL_TABLES").Value)
+	assert.Contains(t, tables, "traces")
+	assert.Contains(t, tables, "operation_names")
+	assert.Contains(t, tables, "operation_names_v2")
+
+	// the password isn't passed on the command line of cqlsh
+	assert.NotContains(t, container.Args[0], "-p ")
+	assert.Contains(t, container.Args[0], "--cqlshrc")
+}
+
+func TestCassandraSchemaMigrationTestMode(t *testing.T) {
+	// prepare
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Storage.CassandraCreateSchema.Mode = "test"
+
+	// test
+	b := cassandraDeps(jaeger)
+
+	// verify
+	container := b[0].Spec.Template.Spec.Containers[0]
+	assert.Equal(t, "1", util.FindEnvVar(container.Env, "REPLICATION_FACTOR").Value)
+	assert.Contains(t, util.FindEnvVar(container.Env, "SCHEMA_MIGRATION").Value,
+		"ALTER KEYSPACE jaeger_v1_test WITH replication = {'class': 'SimpleStrategy', 'replication_factor': '1'};")
+}
+
+func TestCassandraSchemaRevision(t *testing.T) {
+	// prepare
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	first := cassandraDeps(jaeger)[0]
+
+	// test
+	same := cassandraDeps(jaeger)[0]
+	jaeger.Spec.Storage.CassandraCreateSchema.TraceTTL = "168h"
+	changedTTL := cassandraDeps(jaeger)[0]
+	jaeger.Status.Version = "1.0.0"
+	upgraded := cassandraDeps(jaeger)[0]
+
+	// verify
+	assert.Equal(t, first.Name, same.Name)
+	assert.Regexp(t, "^my-instance-cassandra-schema-[0-9a-f]{10}$", first.Name)
+	assert.Equal(t, first.Name[len(first.Name)-10:], first.Annotations[v1.AnnotationCassandraSchemaRevision])
+	assert.NotEqual(t, first.Name, changedTTL.Name)
+	assert.NotEqual(t, changedTTL.Name, upgraded.Name)
+}
+
+func TestCassandraSchemaJobNameLength(t *testing.T) {
+	// prepare
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: strings.Repeat("my-instance", 6)})
+
+	// test
+	b := cassandraDeps(jaeger)
+
+	// verify
+	assert.Len(t, b[0].Name, 63)
+	assert.Equal(t, b[0].Annotations[v1.AnnotationCassandraSchemaRevision], b[0].Name[53:])
+}
+
+func TestCassandraSchemaStatus(t *testing.T) {
+	// prepare
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Storage.CassandraCreateSchema.Datacenter = "dc1"
+	jaeger.Spec.Storage.CassandraCreateSchema.Image = "jaegertracing/jaeger-cassandra-schema:1.57.0"
+	job := cassandraDeps(jaeger)[0]
+
+	// test
+	status := CassandraSchemaStatus(job)
+
+	// verify
+	require.NotNil(t, status)
+	assert.Equal(t, v1.JaegerCassandraSchemaStatus{
+		Phase:             v1.CassandraSchemaPhaseReady,
+		Revision:          job.Annotations[v1.AnnotationCassandraSchemaRevision],
+		Job:               job.Name,
+		Keyspace:          "jaeger_v1_test",
+		Mode:              "prod",
+		Datacenter:        "dc1",
+		ReplicationFactor: 2,
+		TraceTTLSeconds:   172800,
+		Image:             "jaegertracing/jaeger-cassandra-schema:1.57.0",
+	}, *status)
+}
+
+func TestCassandraSchemaStatusOtherJob(t *testing.T) {
+	assert.Nil(t, CassandraSchemaStatus(batchv1.Job{}))
+}
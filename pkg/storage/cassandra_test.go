@@ -0,0 +1,220 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/autodetect"
+)
+
+func TestShouldProvisionCassandra(t *testing.T) {
+	autodetect.OperatorConfiguration.SetCassandraIntegration(autodetect.CassandraOperatorIntegrationYes)
+	defer viper.Reset()
+
+	tests := []struct {
+		name        string
+		storage     v1.JaegerStorageType
+		options     map[string]interface{}
+		annotations map[string]string
+		expected    bool
+	}{
+		{
+			name:     "no servers",
+			storage:  v1.JaegerCassandraStorage,
+			expected: true,
+		},
+		{
+			name:     "external servers",
+			storage:  v1.JaegerCassandraStorage,
+			options:  map[string]interface{}{"cassandra.servers": "cassandra.example.com"},
+			expected: false,
+		},
+		{
+			name:        "provisioned servers",
+			storage:     v1.JaegerCassandraStorage,
+			options:     map[string]interface{}{"cassandra.servers": "my-instance-dc1-service"},
+			annotations: map[string]string{v1.AnnotationProvisionedCassandraKey: v1.AnnotationProvisionedCassandraValue},
+			expected:    true,
+		},
+		{
+			name:     "other storage",
+			storage:  v1.JaegerESStorage,
+			expected: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+			jaeger.Annotations = test.annotations
+			jaeger.Spec.Storage.Type = test.storage
+			jaeger.Spec.Storage.Options = v1.NewOptions(test.options)
+
+			assert.Equal(t, test.expected, ShouldProvisionCassandra(jaeger))
+		})
+	}
+}
+
+func TestShouldProvisionCassandraIntegrationDisabled(t *testing.T) {
+	// prepare
+	autodetect.OperatorConfiguration.SetCassandraIntegration(autodetect.CassandraOperatorIntegrationNo)
+	defer viper.Reset()
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Storage.Type = v1.JaegerCassandraStorage
+
+	// test and verify
+	assert.False(t, ShouldProvisionCassandra(jaeger))
+}
+
+func TestCassandraConfigureStorage(t *testing.T) {
+	// prepare
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance", Namespace: "observability"})
+	jaeger.Spec.Storage.Type = v1.JaegerCassandraStorage
+	jaeger.Spec.Storage.Options = v1.NewOptions(map[string]interface{}{"cassandra.keyspace": "jaeger"})
+	cd := &CassandraDeployment{Jaeger: jaeger}
+
+	// test
+	cd.ConfigureStorage()
+
+	// verify
+	assert.Equal(t, v1.AnnotationProvisionedCassandraValue, jaeger.Annotations[v1.AnnotationProvisionedCassandraKey])
+	assert.Equal(t, v1.AnnotationCassandraKindDatacenter, jaeger.Annotations[v1.AnnotationCassandraKindKey])
+	opts := jaeger.Spec.Storage.Options.StringMap()
+	assert.Equal(t, "my-instance-dc1-service.observability.svc.cluster.local", opts["cassandra.servers"])
+	assert.Equal(t, "dc1", opts["cassandra.local-dc"])
+	assert.Equal(t, "jaeger", opts["cassandra.keyspace"])
+	assert.Equal(t, "dc1", jaeger.Spec.Storage.CassandraCreateSchema.Datacenter)
+	require.NotNil(t, jaeger.Spec.Storage.CassandraCreateSchema.ReplicationFactor)
+	assert.EqualValues(t, 3, *jaeger.Spec.Storage.CassandraCreateSchema.ReplicationFactor)
+}
+
+func TestCassandraConfigureStorageSmallCluster(t *testing.T) {
+	// prepare
+	one := int32(1)
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Storage.Cassandra.Datacenter = "east"
+	jaeger.Spec.Storage.Cassandra.Size = &one
+	jaeger.Spec.Storage.CassandraCreateSchema.Datacenter = "west"
+	cd := &CassandraDeployment{Jaeger: jaeger}
+
+	// test
+	cd.ConfigureStorage()
+
+	// verify
+	assert.Equal(t, "east", jaeger.Spec.Storage.Options.StringMap()["cassandra.local-dc"])
+	assert.Equal(t, "east", jaeger.Spec.Storage.CassandraCreateSchema.Datacenter)
+	assert.EqualValues(t, 1, *jaeger.Spec.Storage.CassandraCreateSchema.ReplicationFactor)
+}
+
+func TestCassandraInjectStorageConfiguration(t *testing.T) {
+	// prepare
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	cd := &CassandraDeployment{Jaeger: jaeger}
+	pod := &corev1.PodSpec{Containers: []corev1.Container{{
+		Env: []corev1.EnvVar{
+			{Name: "CASSANDRA_USERNAME", Value: ""},
+			{Name: "KEYSPACE", Value: "jaeger_v1_test"},
+		},
+	}}}
+
+	// test
+	cd.InjectStorageConfiguration(pod)
+
+	// verify
+	env := pod.Containers[0].Env
+	assert.Len(t, env, 3)
+	for _, envVar := range env {
+		switch envVar.Name {
+		case "CASSANDRA_USERNAME":
+			require.NotNil(t, envVar.ValueFrom)
+			assert.Equal(t, "my-instance-superuser", envVar.ValueFrom.SecretKeyRef.Name)
+			assert.Equal(t, "username", envVar.ValueFrom.SecretKeyRef.Key)
+		case "CASSANDRA_PASSWORD":
+			require.NotNil(t, envVar.ValueFrom)
+			assert.Equal(t, "my-instance-superuser", envVar.ValueFrom.SecretKeyRef.Name)
+			assert.Equal(t, "password", envVar.ValueFrom.SecretKeyRef.Key)
+		default:
+			assert.Equal(t, "jaeger_v1_test", envVar.Value)
+		}
+	}
+}
+
+func TestCassandraDatacenter(t *testing.T) {
+	// prepare
+	storageClassName := "fast"
+	storageSize := resource.MustParse("50Gi")
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance", Namespace: "observability"})
+	jaeger.Spec.Storage.Cassandra.StorageClassName = &storageClassName
+	jaeger.Spec.Storage.Cassandra.StorageSize = &storageSize
+	cd := &CassandraDeployment{Jaeger: jaeger}
+
+	// test
+	dc := cd.CassandraDatacenter()
+
+	// verify
+	assert.Equal(t, "dc1", dc.Name)
+	assert.Equal(t, "observability", dc.Namespace)
+	assert.Equal(t, "my-instance", dc.Labels["app.kubernetes.io/instance"])
+	assert.Len(t, dc.OwnerReferences, 1)
+
+	spec, err := dc.Spec.GetMap()
+	require.NoError(t, err)
+	assert.Equal(t, "my-instance", spec["clusterName"])
+	assert.Equal(t, "cassandra", spec["serverType"])
+	assert.Equal(t, "4.1.5", spec["serverVersion"])
+	assert.EqualValues(t, 3, spec["size"])
+	assert.NotContains(t, spec, "resources")
+
+	claim, found, err := unstructured.NestedMap(spec, "storageConfig", "cassandraDataVolumeClaimSpec")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "fast", claim["storageClassName"])
+	storage, _, err := unstructured.NestedString(claim, "resources", "requests", "storage")
+	require.NoError(t, err)
+	assert.Equal(t, "50Gi", storage)
+}
+
+func TestK8ssandraCluster(t *testing.T) {
+	// prepare
+	size := int32(5)
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance", Namespace: "observability"})
+	jaeger.Spec.Storage.Cassandra.Datacenter = "east"
+	jaeger.Spec.Storage.Cassandra.ServerVersion = "4.0.13"
+	jaeger.Spec.Storage.Cassandra.Size = &size
+	jaeger.Spec.Storage.Cassandra.Resources = &corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("4Gi")},
+	}
+	cd := &CassandraDeployment{Jaeger: jaeger}
+
+	// test
+	k := cd.K8ssandraCluster()
+
+	// verify
+	assert.Equal(t, "my-instance", k.Name)
+	assert.Equal(t, "observability", k.Namespace)
+
+	spec, err := k.Spec.GetMap()
+	require.NoError(t, err)
+	cassandra, found, err := unstructured.NestedMap(spec, "cassandra")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "4.0.13", cassandra["serverVersion"])
+	assert.Contains(t, cassandra, "resources")
+	assert.Contains(t, cassandra, "storageConfig")
+
+	datacenters, found, err := unstructured.NestedSlice(cassandra, "datacenters")
+	require.NoError(t, err)
+	assert.True(t, found)
+	require.Len(t, datacenters, 1)
+	dc := datacenters[0].(map[string]interface{})
+	assert.EqualValues(t, 5, dc["size"])
+	name, _, _ := unstructured.NestedString(dc, "metadata", "name")
+	assert.Equal(t, "east", name)
+}
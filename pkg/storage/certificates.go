@@ -0,0 +1,246 @@
+package storage
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"sort"
+	"time"
+)
+
+const (
+	caValidity   = 5 * 365 * 24 * time.Hour
+	certValidity = 2 * 365 * 24 * time.Hour
+
+	// certificates are re-issued once they are this close to their expiry date
+	certRenewBefore = 30 * 24 * time.Hour
+
+	certKeySize = 4096
+)
+
+var (
+	oidSubjectAltName = asn1.ObjectIdentifier{2, 5, 29, 17}
+
+	// the registered ID Elasticsearch uses to recognize the certificates of its nodes
+	oidElasticsearchNode = asn1.ObjectIdentifier{1, 2, 3, 4, 5, 5}
+)
+
+// certRequest describes a certificate to be issued by the certificate authority
+type certRequest struct {
+	commonName string
+	dnsNames   []string
+	ips        []net.IP
+	nodeOID    bool
+}
+
+// keyPair holds a certificate along with its private key, both parsed and PEM encoded
+type keyPair struct {
+	cert    *x509.Certificate
+	key     *rsa.PrivateKey
+	certPEM []byte
+	keyPEM  []byte
+}
+
+func parseKeyPair(certPEM, keyPEM []byte) (*keyPair, error) {
+	cert, err := parseCertificate(certPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(keyPEM)
+	if block == nil {
+		return nil, errors.New("no PEM encoded private key found")
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("the private key isn't an RSA key")
+	}
+	if !key.PublicKey.Equal(cert.PublicKey) {
+		return nil, errors.New("the private key doesn't match the certificate")
+	}
+
+	return &keyPair{cert: cert, key: key, certPEM: certPEM, keyPEM: keyPEM}, nil
+}
+
+func parseCertificate(certPEM []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(certPEM)
+	if block == nil {
+		return nil, errors.New("no PEM encoded certificate found")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+// newCA creates a self-signed certificate authority
+func newCA(now time.Time) (*keyPair, error) {
+	template := &x509.Certificate{
+		Subject:               pkix.Name{CommonName: "openshift-cluster-logging-signer"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(caValidity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		MaxPathLenZero:        true,
+	}
+	return createKeyPair(template, nil)
+}
+
+// issue creates a certificate for the given request, signed by the certificate authority
+func (ca *keyPair) issue(req certRequest, now time.Time) (*keyPair, error) {
+	template := &x509.Certificate{
+		Subject: pkix.Name{
+			CommonName:         req.commonName,
+			OrganizationalUnit: []string{"OpenShift"},
+			Organization:       []string{"Logging"},
+		},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(certValidity),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+	}
+	if len(req.dnsNames) > 0 || len(req.ips) > 0 || req.nodeOID {
+		// the standard library can't add registered IDs, so we build the extension ourselves
+		san, err := req.subjectAltName()
+		if err != nil {
+			return nil, err
+		}
+		template.ExtraExtensions = []pkix.Extension{{Id: oidSubjectAltName, Value: san}}
+	}
+	if template.NotAfter.After(ca.cert.NotAfter) {
+		template.NotAfter = ca.cert.NotAfter
+	}
+	return createKeyPair(template, ca)
+}
+
+func createKeyPair(template *x509.Certificate, parent *keyPair) (*keyPair, error) {
+	key, err := rsa.GenerateKey(rand.Reader, certKeySize)
+	if err != nil {
+		return nil, err
+	}
+	template.SerialNumber, err = rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, err
+	}
+
+	// self-signed when there's no parent
+	parentCert, parentKey := template, key
+	if parent != nil {
+		parentCert, parentKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parentCert, &key.PublicKey, parentKey)
+	if err != nil {
+		return nil, err
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, err
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &keyPair{
+		cert:    cert,
+		key:     key,
+		certPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		keyPEM:  pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}),
+	}, nil
+}
+
+func (req certRequest) subjectAltName() ([]byte, error) {
+	names := []asn1.RawValue{}
+	for _, ip := range req.ips {
+		b := ip.To4()
+		if b == nil {
+			b = ip.To16()
+		}
+		names = append(names, asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 7, Bytes: b})
+	}
+	for _, name := range req.dnsNames {
+		names = append(names, asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 2, Bytes: []byte(name)})
+	}
+	if req.nodeOID {
+		b, err := asn1.Marshal(oidElasticsearchNode)
+		if err != nil {
+			return nil, err
+		}
+		oid := asn1.RawValue{}
+		if _, err := asn1.Unmarshal(b, &oid); err != nil {
+			return nil, err
+		}
+		names = append(names, asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 8, Bytes: oid.Bytes})
+	}
+	return asn1.Marshal(names)
+}
+
+// valid returns whether the certificate was issued for this request by the given certificate authority,
+// and isn't due for renewal yet
+func (req certRequest) valid(cert *x509.Certificate, ca *x509.Certificate, now time.Time) bool {
+	if needsRenewal(cert, now) || cert.CheckSignatureFrom(ca) != nil {
+		return false
+	}
+	if cert.Subject.CommonName != req.commonName {
+		return false
+	}
+
+	ips := []string{}
+	for _, ip := range cert.IPAddresses {
+		ips = append(ips, ip.String())
+	}
+	wantIPs := []string{}
+	for _, ip := range req.ips {
+		wantIPs = append(wantIPs, ip.String())
+	}
+	return sameItems(cert.DNSNames, req.dnsNames) && sameItems(ips, wantIPs)
+}
+
+func needsRenewal(cert *x509.Certificate, now time.Time) bool {
+	return now.Add(certRenewBefore).After(cert.NotAfter)
+}
+
+func sameItems(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a = append([]string{}, a...)
+	b = append([]string{}, b...)
+	sort.Strings(a)
+	sort.Strings(b)
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// certsNotAfter returns the earliest expiry date of the PEM encoded certificates among the given data
+func certsNotAfter(data map[string][]byte) (time.Time, error) {
+	notAfter := time.Time{}
+	for _, v := range data {
+		block, _ := pem.Decode(v)
+		if block == nil || block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("failed to parse certificate: %w", err)
+		}
+		if notAfter.IsZero() || cert.NotAfter.Before(notAfter) {
+			notAfter = cert.NotAfter
+		}
+	}
+	return notAfter, nil
+}
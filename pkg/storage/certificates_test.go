@@ -0,0 +1,119 @@
+package storage
+
+import (
+	"crypto/x509"
+	"encoding/asn1"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIssueCertificate(t *testing.T) {
+	// prepare
+	now := time.Now()
+	ca, err := newCA(now)
+	require.NoError(t, err)
+	req := certRequest{
+		commonName: "elasticsearch",
+		dnsNames:   []string{"localhost", "elasticsearch"},
+		ips:        []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback},
+		nodeOID:    true,
+	}
+
+	// test
+	issued, err := ca.issue(req, now)
+	require.NoError(t, err)
+
+	// verify
+	assert.True(t, ca.cert.IsCA)
+	assert.True(t, req.valid(issued.cert, ca.cert, now))
+	assert.Equal(t, []string{"localhost", "elasticsearch"}, issued.cert.DNSNames)
+	assert.Len(t, issued.cert.IPAddresses, 2)
+	assert.Equal(t, 4096, issued.key.N.BitLen())
+
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.cert)
+	_, err = issued.cert.Verify(x509.VerifyOptions{
+		DNSName:   "elasticsearch",
+		Roots:     pool,
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	})
+	require.NoError(t, err)
+
+	// the registered ID isn't parsed by the standard library, so we look for it in the raw extension
+	found := false
+	for _, ext := range issued.cert.Extensions {
+		if !ext.Id.Equal(oidSubjectAltName) {
+			continue
+		}
+		names := []asn1.RawValue{}
+		_, err := asn1.Unmarshal(ext.Value, &names)
+		require.NoError(t, err)
+		for _, name := range names {
+			if name.Tag == 8 {
+				oid := asn1.ObjectIdentifier{}
+				_, err := asn1.Unmarshal(append([]byte{asn1.TagOID, byte(len(name.Bytes))}, name.Bytes...), &oid)
+				require.NoError(t, err)
+				found = oid.Equal(oidElasticsearchNode)
+			}
+		}
+	}
+	assert.True(t, found)
+
+	pair, err := parseKeyPair(issued.certPEM, issued.keyPEM)
+	require.NoError(t, err)
+	assert.Equal(t, issued.cert.SerialNumber, pair.cert.SerialNumber)
+}
+
+func TestCertificateValidity(t *testing.T) {
+	now := time.Now()
+	ca, err := newCA(now)
+	require.NoError(t, err)
+	other, err := newCA(now)
+	require.NoError(t, err)
+	req := certRequest{commonName: "user.jaeger"}
+	issued, err := ca.issue(req, now)
+	require.NoError(t, err)
+
+	assert.True(t, req.valid(issued.cert, ca.cert, now))
+	assert.False(t, req.valid(issued.cert, other.cert, now), "signed by another CA")
+	assert.False(t, req.valid(issued.cert, ca.cert, now.Add(certValidity-certRenewBefore+time.Hour)), "about to expire")
+	assert.False(t, certRequest{commonName: "user.jaeger", dnsNames: []string{"jaeger"}}.valid(issued.cert, ca.cert, now), "different names")
+	assert.False(t, certRequest{commonName: "system.admin"}.valid(issued.cert, ca.cert, now), "different subject")
+}
+
+func TestParseKeyPairMismatch(t *testing.T) {
+	now := time.Now()
+	ca, err := newCA(now)
+	require.NoError(t, err)
+	other, err := newCA(now)
+	require.NoError(t, err)
+
+	_, err = parseKeyPair(ca.certPEM, other.keyPEM)
+	assert.EqualError(t, err, "the private key doesn't match the certificate")
+
+	_, err = parseKeyPair(nil, ca.keyPEM)
+	assert.EqualError(t, err, "no PEM encoded certificate found")
+
+	_, err = parseKeyPair(ca.certPEM, nil)
+	assert.EqualError(t, err, "no PEM encoded private key found")
+}
+
+func TestCertsNotAfter(t *testing.T) {
+	now := time.Now()
+	ca, err := newCA(now)
+	require.NoError(t, err)
+	issued, err := ca.issue(certRequest{commonName: "user.jaeger"}, now)
+	require.NoError(t, err)
+
+	notAfter, err := certsNotAfter(map[string][]byte{"ca": ca.certPEM, "cert": issued.certPEM, "key": issued.keyPEM})
+	require.NoError(t, err)
+	assert.Equal(t, issued.cert.NotAfter, notAfter)
+
+	notAfter, err = certsNotAfter(map[string][]byte{"foo": []byte("bar")})
+	require.NoError(t, err)
+	assert.True(t, notAfter.IsZero())
+}
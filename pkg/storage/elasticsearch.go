@@ -47,9 +47,11 @@ func (ed *ElasticsearchDeployment) getCertCaPath() string {
 
 // ElasticsearchDeployment represents an ES deployment for Jaeger
 type ElasticsearchDeployment struct {
-	Jaeger     *v1.Jaeger
-	CertScript string
-	Secrets    []corev1.Secret
+	Jaeger  *v1.Jaeger
+	Secrets []corev1.Secret
+
+	// certs holds the PEM encoded certificates and keys, by file name
+	certs map[string][]byte
 }
 
 func (ed *ElasticsearchDeployment) injectArguments(container *corev1.Container) {
@@ -2,23 +2,18 @@ package storage
 
 import (
 	"fmt"
-	"os"
-	"os/exec"
-	"path"
-	"path/filepath"
+	"net"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
 	"github.com/jaegertracing/jaeger-operator/pkg/util"
 )
 
-const (
-	// #nosec   G101 (CWE-798): Potential hardcoded credentials
-	tmpWorkingDir = "/tmp/_certs"
-)
+// AnnotationCertificatesNotAfter is set on the generated secrets, with the earliest expiry date of their certificates
+const AnnotationCertificatesNotAfter = "jaegertracing.io/certificates-not-after"
 
 type secret struct {
 	name           string
@@ -82,144 +77,128 @@ var curatorSecret = secret{
 	},
 }
 
+// esCertRequests returns the certificates to be issued for the Elasticsearch in the given namespace
+func esCertRequests(namespace string) []certRequest {
+	localhost := []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback}
+	return []certRequest{
+		{commonName: "system.admin"},
+		{commonName: "system.logging.curator"},
+		{commonName: "user.jaeger"},
+		{
+			commonName: "elasticsearch",
+			dnsNames:   append([]string{"localhost"}, esHostnames(namespace, "elasticsearch", "elasticsearch-cluster")...),
+			ips:        localhost,
+			nodeOID:    true,
+		},
+		{
+			commonName: "logging-es",
+			dnsNames:   append([]string{"localhost"}, esHostnames(namespace, "elasticsearch")...),
+			ips:        localhost,
+		},
+	}
+}
+
+func esHostnames(namespace string, services ...string) []string {
+	names := []string{}
+	for _, svc := range services {
+		for _, name := range []string{svc, fmt.Sprintf("%s.%s.svc", svc, namespace)} {
+			names = append(names, name, name+".cluster.local")
+		}
+	}
+	return names
+}
+
 // ExtractSecrets assembles a set of secrets related to Elasticsearch
 func (ed *ElasticsearchDeployment) ExtractSecrets() []corev1.Secret {
-	return []corev1.Secret{
-		createSecret(ed.Jaeger, masterSecret.instanceName(ed.Jaeger), getWorkingDirContents(getWorkingDir(ed.Jaeger), masterSecret.keyFileNameMap)),
-		createSecret(ed.Jaeger, esSecret.instanceName(ed.Jaeger), getWorkingDirContents(getWorkingDir(ed.Jaeger), esSecret.keyFileNameMap)),
-		createSecret(ed.Jaeger, jaegerSecret.instanceName(ed.Jaeger), getWorkingDirContents(getWorkingDir(ed.Jaeger), jaegerSecret.keyFileNameMap)),
-		createSecret(ed.Jaeger, curatorSecret.instanceName(ed.Jaeger), getWorkingDirContents(getWorkingDir(ed.Jaeger), curatorSecret.keyFileNameMap)),
+	secrets := []corev1.Secret{}
+	for _, s := range []secret{masterSecret, esSecret, jaegerSecret, curatorSecret} {
+		secrets = append(secrets, createSecret(ed.Jaeger, s.instanceName(ed.Jaeger), getCertsContents(ed.certs, s.keyFileNameMap)))
 	}
+	return secrets
 }
 
-// CreateCerts creates certificates for elasticsearch, jaeger and curator
-// The cert generation is done by shell script. If the certificates are not present
-// on the filesystem the operator injects them from secrets - this allows operator restarts.
-// The script also re-generates expired certificates.
+// CreateCerts creates certificates for elasticsearch, jaeger and curator, signed by the certificate authority
+// of the instance. The existing certificates are taken from the secrets, which allows operator restarts, and
+// are re-issued when they are about to expire, or when they were signed by another certificate authority.
 func (ed *ElasticsearchDeployment) CreateCerts() error {
-	err := extractSecretsToFile(ed.Jaeger, ed.Secrets, masterSecret, esSecret, jaegerSecret, curatorSecret)
-	if err != nil {
-		return fmt.Errorf("failed to extract certificates from secrets to file: %w", err)
+	now := time.Now()
+	ed.certs = extractCerts(ed.Jaeger, ed.Secrets, masterSecret, esSecret, jaegerSecret, curatorSecret)
+
+	ca, err := parseKeyPair(ed.certs["ca.crt"], ed.certs["ca.key"])
+	if err != nil || needsRenewal(ca.cert, now) {
+		ed.Jaeger.Logger().V(-1).Info("creating the certificate authority for Elasticsearch")
+		if ca, err = newCA(now); err != nil {
+			return fmt.Errorf("failed to create the certificate authority: %w", err)
+		}
+		ed.certs["ca.crt"] = ca.certPEM
+		ed.certs["ca.key"] = ca.keyPEM
 	}
-	return createESCerts(ed.CertScript, ed.Jaeger)
-}
 
-// CleanCerts removes certificates from local filesystem.
-// Use this function in tests to clean resources
-func (ed *ElasticsearchDeployment) CleanCerts() error {
-	return os.RemoveAll(getWorkingDir(ed.Jaeger))
+	for _, req := range esCertRequests(ed.Jaeger.Namespace) {
+		crt, key := req.commonName+".crt", req.commonName+".key"
+		if current, err := parseKeyPair(ed.certs[crt], ed.certs[key]); err == nil && req.valid(current.cert, ca.cert, now) {
+			continue
+		}
+
+		ed.Jaeger.Logger().V(-1).Info("issuing certificate for Elasticsearch", "certificate", req.commonName)
+		issued, err := ca.issue(req, now)
+		if err != nil {
+			return fmt.Errorf("failed to issue the certificate %s: %w", req.commonName, err)
+		}
+		ed.certs[crt] = issued.certPEM
+		ed.certs[key] = issued.keyPEM
+	}
+	return nil
 }
 
-func extractSecretsToFile(jaeger *v1.Jaeger, secrets []corev1.Secret, s ...secret) error {
+// extractCerts returns the certificates and keys found in the given secrets, by file name
+func extractCerts(jaeger *v1.Jaeger, secrets []corev1.Secret, s ...secret) map[string][]byte {
 	secretMap := map[string]corev1.Secret{}
 	for _, sec := range secrets {
 		secretMap[sec.Name] = sec
 	}
+
+	certs := map[string][]byte{}
 	for _, sec := range s {
-		if secret, ok := secretMap[sec.instanceName(jaeger)]; ok {
-			if err := extractSecretToFile(getWorkingDir(jaeger), secret.Data, sec); err != nil {
-				return fmt.Errorf("failed to extract secret %s: %w", secret.Name, err)
+		secret, ok := secretMap[sec.instanceName(jaeger)]
+		if !ok {
+			continue
+		}
+		for k, v := range sec.keyFileNameMap {
+			// the CA is present in several secrets, the first one wins
+			if _, exists := certs[v]; !exists && len(secret.Data[k]) > 0 {
+				certs[v] = secret.Data[k]
 			}
 		}
 	}
-	return nil
+	return certs
 }
 
-func extractSecretToFile(workingDir string, data map[string][]byte, secret secret) error {
-	for k, v := range secret.keyFileNameMap {
-		if err := writeToFile(workingDir, v, data[k]); err != nil {
-			return err
-		}
+func getCertsContents(certs map[string][]byte, content map[string]string) map[string][]byte {
+	c := map[string][]byte{}
+	for secretKey, certName := range content {
+		c[secretKey] = certs[certName]
 	}
-	return nil
-}
-
-func getWorkingDir(jaeger *v1.Jaeger) string {
-	return filepath.Clean(fmt.Sprintf("%s/%s/%s/%s", tmpWorkingDir, jaeger.Namespace, jaeger.Name, jaeger.UID))
+	return c
 }
 
-// createESCerts runs bash scripts which generates certificates
-func createESCerts(script string, jaeger *v1.Jaeger) error {
-	// #nosec   G204: Subprocess launching should be audited
-	cmd := exec.Command("bash", script)
-	cmd.Env = append(os.Environ(),
-		"NAMESPACE="+jaeger.Namespace,
-		"WORKING_DIR="+getWorkingDir(jaeger),
-	)
-	if out, err := cmd.CombinedOutput(); err != nil {
-		log.Log.Error(
-			err,
-			fmt.Sprintf("error running script %s", script),
-			"script", script,
-			"out", string(out),
-		)
-		return fmt.Errorf("error running script %s: %w", script, err)
+func createSecret(jaeger *v1.Jaeger, secretName string, data map[string][]byte) corev1.Secret {
+	var annotations map[string]string
+	if notAfter, err := certsNotAfter(data); err != nil {
+		jaeger.Logger().V(1).Info("failed to determine the expiry date of the certificates", "secret", secretName, "error", err.Error())
+	} else if !notAfter.IsZero() {
+		annotations = map[string]string{AnnotationCertificatesNotAfter: notAfter.UTC().Format(time.RFC3339)}
 	}
-	return nil
-}
 
-func createSecret(jaeger *v1.Jaeger, secretName string, data map[string][]byte) corev1.Secret {
 	return corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:            secretName,
 			Namespace:       jaeger.Namespace,
 			Labels:          util.Labels(secretName, "es-secret", *jaeger),
+			Annotations:     annotations,
 			OwnerReferences: []metav1.OwnerReference{util.AsOwner(jaeger)},
 		},
 		Type: corev1.SecretTypeOpaque,
 		Data: data,
 	}
 }
-
-func getWorkingDirContents(dir string, content map[string]string) map[string][]byte {
-	c := map[string][]byte{}
-	for secretKey, certName := range content {
-		c[secretKey] = getDirFileContents(dir, certName)
-	}
-	return c
-}
-
-func getDirFileContents(dir, filePath string) []byte {
-	return getFileContents(getFilePath(dir, filePath))
-}
-
-func getFilePath(dir, toFile string) string {
-	return path.Join(dir, toFile)
-}
-
-func getFileContents(path string) []byte {
-	if path == "" {
-		return nil
-	}
-	contents, err := os.ReadFile(filepath.Clean(path))
-	if err != nil {
-		return nil
-	}
-	return contents
-}
-
-func writeToFile(dir, file string, value []byte) error {
-	// first check if file exists - we prefer what is on FS to revert users editing secrets
-	rawPath := getFilePath(dir, file)
-	path := filepath.Clean(rawPath)
-	if _, err := os.Stat(path); err == nil {
-		return nil
-	}
-	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
-		return err
-	}
-	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o600)
-	if err != nil {
-		return err
-	}
-	defer util.CloseFile(f, &log.Log)
-
-	_, err = f.Write(value)
-	if err != nil {
-		// remove the file on failure - it can be correctly created in the next iteration
-		os.RemoveAll(path)
-		return err
-	}
-
-	return nil
-}
@@ -1,12 +1,8 @@
 package storage
 
 import (
-	"fmt"
-	"os"
-	"path"
-	"reflect"
-	"runtime"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -17,23 +13,10 @@ import (
 	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
 )
 
-func TestCreateESSecretsError(t *testing.T) {
-	j := v1.NewJaeger(types.NamespacedName{Name: t.Name()})
-	es := &ElasticsearchDeployment{Jaeger: j, CertScript: "/foo"}
-	err := es.CleanCerts()
-	require.NoError(t, err)
-	defer es.CleanCerts()
-	err = es.CreateCerts()
-	require.EqualError(t, err, "error running script /foo: exit status 127")
-}
-
 func TestCreateESSecrets(t *testing.T) {
-	j := v1.NewJaeger(types.NamespacedName{Name: t.Name()})
-	es := &ElasticsearchDeployment{Jaeger: j, CertScript: "../../scripts/cert_generation.sh"}
-	err := es.CleanCerts()
-	require.NoError(t, err)
-	defer es.CleanCerts()
-	err = es.CreateCerts()
+	j := v1.NewJaeger(types.NamespacedName{Name: t.Name(), Namespace: "observability"})
+	es := &ElasticsearchDeployment{Jaeger: j}
+	err := es.CreateCerts()
 	require.NoError(t, err)
 	sec := es.ExtractSecrets()
 	assert.Equal(t, []string{
@@ -43,136 +26,122 @@ func TestCreateESSecrets(t *testing.T) {
 		curatorSecret.instanceName(j),
 	},
 		[]string{sec[0].Name, sec[1].Name, sec[2].Name, sec[3].Name})
+
+	ca, err := parseCertificate(sec[0].Data["ca"])
+	require.NoError(t, err)
 	for _, s := range sec {
-		if s.Name == jaegerSecret.instanceName(j) {
-			ca, err := os.ReadFile(fmt.Sprintf("%s/%s/ca.crt", tmpWorkingDir, j.Name))
-			require.NoError(t, err)
-			key, err := os.ReadFile(fmt.Sprintf("%s/%s/user.jaeger.key", tmpWorkingDir, j.Name))
-			require.NoError(t, err)
-			cert, err := os.ReadFile(fmt.Sprintf("%s/%s/user.jaeger.crt", tmpWorkingDir, j.Name))
-			require.NoError(t, err)
-			assert.Equal(t, map[string][]byte{"ca": ca, "key": key, "cert": cert}, s.Data)
+		assert.Contains(t, s.Annotations, AnnotationCertificatesNotAfter)
+		for k, v := range s.Data {
+			assert.NotEmpty(t, v, "key %s of the secret %s", k, s.Name)
 		}
 	}
-}
 
-func TestCreateSecret(t *testing.T) {
-	j := v1.NewJaeger(types.NamespacedName{Name: "foo"})
-	j.Namespace = "myproject"
-	s := createSecret(j, "bar", map[string][]byte{"foo": {}})
-	assert.Equal(t, "bar", s.ObjectMeta.Name)
-	assert.Equal(t, j.Namespace, s.ObjectMeta.Namespace)
-	assert.Equal(t, j.Name, s.ObjectMeta.OwnerReferences[0].Name)
-	assert.Equal(t, j.Name, s.ObjectMeta.OwnerReferences[0].Name)
-	assert.Equal(t, map[string][]byte{"foo": {}}, s.Data)
-	assert.Equal(t, corev1.SecretTypeOpaque, s.Type)
-}
-
-func TestGetWorkingFileDirContent(t *testing.T) {
-	dir := "/tmp/_" + t.Name()
-	defer os.RemoveAll(dir)
-	err := os.MkdirAll(dir, os.ModePerm)
+	jaegerSec := sec[2]
+	assert.Equal(t, sec[0].Data["ca"], jaegerSec.Data["ca"])
+	pair, err := parseKeyPair(jaegerSec.Data["cert"], jaegerSec.Data["key"])
 	require.NoError(t, err)
-	err = os.WriteFile(dir+"/foobar", []byte("foo"), 0o644)
+	require.NoError(t, pair.cert.CheckSignatureFrom(ca))
+	assert.Equal(t, "user.jaeger", pair.cert.Subject.CommonName)
+
+	esCert, err := parseCertificate(sec[1].Data["elasticsearch.crt"])
 	require.NoError(t, err)
-	b := getDirFileContents(dir, "foobar")
-	assert.Equal(t, "foo", string(b))
+	assert.Contains(t, esCert.DNSNames, "elasticsearch.observability.svc")
+	assert.Contains(t, esCert.DNSNames, "elasticsearch-cluster.observability.svc.cluster.local")
 }
 
-func TestGetWorkingFileDirContent_EmptyPath(t *testing.T) {
-	b := getDirFileContents("", "")
-	assert.Nil(t, b)
-}
+func TestCreateESSecretsReusesExisting(t *testing.T) {
+	// prepare
+	j := v1.NewJaeger(types.NamespacedName{Name: t.Name(), Namespace: "observability"})
+	first := &ElasticsearchDeployment{Jaeger: j}
+	require.NoError(t, first.CreateCerts())
+	existing := first.ExtractSecrets()
 
-func TestGetWorkingFileDirContent_FileDoesNotExists(t *testing.T) {
-	b := getDirFileContents("", "jungle")
-	assert.Nil(t, b)
-}
+	// test
+	second := &ElasticsearchDeployment{Jaeger: j, Secrets: existing}
+	require.NoError(t, second.CreateCerts())
 
-func TestGetFileContent_EmptyPath(t *testing.T) {
-	b := getFileContents("")
-	assert.Nil(t, b)
+	// verify
+	assert.Equal(t, existing, second.ExtractSecrets())
 }
 
-func TestExtractSecretsToFile(t *testing.T) {
-	j := v1.NewJaeger(types.NamespacedName{Name: t.Name()})
-	caFile := fmt.Sprintf("%s/%s/ca.crt", tmpWorkingDir, j.Name)
-	defer os.Remove(caFile)
-	content := "115dasrez"
-	err := extractSecretsToFile(
-		j,
-		[]corev1.Secret{{ObjectMeta: metav1.ObjectMeta{Name: j.Name + "-sec"}, Data: map[string][]byte{"ca": []byte(content)}}},
-		secret{name: "sec", keyFileNameMap: map[string]string{"ca": "ca.crt"}},
-	)
+func TestCreateESSecretsReissuesWhenCAChanges(t *testing.T) {
+	// prepare
+	j := v1.NewJaeger(types.NamespacedName{Name: t.Name(), Namespace: "observability"})
+	first := &ElasticsearchDeployment{Jaeger: j}
+	require.NoError(t, first.CreateCerts())
+	existing := first.ExtractSecrets()
+
+	// the CA is replaced, e.g. by the user, while the other secrets still hold certificates signed by the former one
+	ca, err := newCA(time.Now())
 	require.NoError(t, err)
-	ca, err := os.ReadFile(caFile)
+	existing[0].Data = map[string][]byte{"ca": ca.certPEM, "ca-key": ca.keyPEM}
+
+	// test
+	second := &ElasticsearchDeployment{Jaeger: j, Secrets: existing}
+	require.NoError(t, second.CreateCerts())
+
+	// verify
+	sec := second.ExtractSecrets()
+	assert.Equal(t, ca.certPEM, sec[0].Data["ca"])
+	assert.Equal(t, ca.certPEM, sec[2].Data["ca"])
+	assert.NotEqual(t, existing[2].Data["cert"], sec[2].Data["cert"])
+	cert, err := parseCertificate(sec[2].Data["cert"])
 	require.NoError(t, err)
-	assert.Equal(t, []byte(content), ca)
+	assert.NoError(t, cert.CheckSignatureFrom(ca.cert))
 }
 
-func TestExtractSecretsToFile_Err(t *testing.T) {
-	err := extractSecretToFile("/root", map[string][]byte{"foo": {}}, secret{keyFileNameMap: map[string]string{"foo": "foo"}})
-
-	// Avoid assertions on OS-dependent error messages which may differ between OSes.
-	var patherr *os.PathError
-	require.ErrorAs(t, err, &patherr)
-}
+func TestCreateESSecretsRenewsExpiringCertificates(t *testing.T) {
+	// prepare
+	j := v1.NewJaeger(types.NamespacedName{Name: t.Name(), Namespace: "observability"})
+	first := &ElasticsearchDeployment{Jaeger: j}
+	require.NoError(t, first.CreateCerts())
+	existing := first.ExtractSecrets()
 
-func TestExtractSecretsToFile_FileExists(t *testing.T) {
-	defer os.RemoveAll(tmpWorkingDir + "/bar/houdy")
-	content := "115dasrez"
-	err := os.MkdirAll(tmpWorkingDir+"/bar/houdy", os.ModePerm)
+	ca, err := parseKeyPair(existing[0].Data["ca"], existing[0].Data["ca-key"])
 	require.NoError(t, err)
-	err = os.WriteFile(tmpWorkingDir+"/bar/houdy/ca.crt", []byte(content), os.ModePerm)
+	expiring, err := ca.issue(certRequest{commonName: "user.jaeger"}, time.Now().Add(-certValidity+24*time.Hour))
 	require.NoError(t, err)
-
-	j := v1.NewJaeger(types.NamespacedName{Name: "houdy"})
-	j.Namespace = "bar"
-	err = extractSecretsToFile(
-		j,
-		[]corev1.Secret{{ObjectMeta: metav1.ObjectMeta{Name: "houdy-sec"}, Data: map[string][]byte{"ca": []byte("should not be there")}}},
-		secret{name: "sec", keyFileNameMap: map[string]string{"ca": "ca.crt"}},
-	)
+	existing[2].Data["cert"] = expiring.certPEM
+	existing[2].Data["key"] = expiring.keyPEM
+
+	// test
+	second := &ElasticsearchDeployment{Jaeger: j, Secrets: existing}
+	require.NoError(t, second.CreateCerts())
+
+	// verify
+	sec := second.ExtractSecrets()
+	assert.Equal(t, existing[0].Data, sec[0].Data)
+	assert.Equal(t, existing[3].Data, sec[3].Data)
+	assert.NotEqual(t, expiring.certPEM, sec[2].Data["cert"])
+	cert, err := parseCertificate(sec[2].Data["cert"])
 	require.NoError(t, err)
-	ca, err := os.ReadFile(tmpWorkingDir + "/bar/houdy/ca.crt")
-	require.NoError(t, err)
-	assert.Equal(t, []byte(content), ca)
+	assert.False(t, needsRenewal(cert, time.Now()))
 }
 
-func TestWriteToWorkingDir(t *testing.T) {
-	_, testFile, _, _ := runtime.Caller(0)
-	defer os.RemoveAll(os.TempDir() + "/foo")
-	tests := []struct {
-		dir         string
-		file        string
-		wantErrType reflect.Type
-	}{
-		{
-			dir: "/foo", file: "", wantErrType: reflect.TypeOf((*os.PathError)(nil)),
-		},
-		{
-			dir: "/root", file: "bla", wantErrType: reflect.TypeOf((*os.PathError)(nil)),
-		},
-		{
-			// file exists
-			dir: path.Dir(testFile), file: path.Base(testFile),
-		},
-		{
-			// write to file
-			dir: os.TempDir(), file: "foo",
+func TestCreateSecret(t *testing.T) {
+	j := v1.NewJaeger(types.NamespacedName{Name: "foo"})
+	j.Namespace = "myproject"
+	s := createSecret(j, "bar", map[string][]byte{"foo": {}})
+	assert.Equal(t, "bar", s.ObjectMeta.Name)
+	assert.Equal(t, j.Namespace, s.ObjectMeta.Namespace)
+	assert.Equal(t, j.Name, s.ObjectMeta.OwnerReferences[0].Name)
+	assert.Equal(t, j.Name, s.ObjectMeta.OwnerReferences[0].Name)
+	assert.Equal(t, map[string][]byte{"foo": {}}, s.Data)
+	assert.Equal(t, corev1.SecretTypeOpaque, s.Type)
+	assert.Empty(t, s.Annotations)
+}
+
+func TestExtractCerts(t *testing.T) {
+	j := v1.NewJaeger(types.NamespacedName{Name: "houdy"})
+	certs := extractCerts(
+		j,
+		[]corev1.Secret{
+			{ObjectMeta: metav1.ObjectMeta{Name: "houdy-first"}, Data: map[string][]byte{"ca": []byte("first")}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "houdy-second"}, Data: map[string][]byte{"ca": []byte("second"), "key": []byte("key")}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "houdy-unrelated"}, Data: map[string][]byte{"ca": []byte("unrelated")}},
 		},
-	}
-	for _, test := range tests {
-		err := writeToFile(test.dir, test.file, []byte("random"))
-		if test.wantErrType != nil {
-			// Avoid assertions on OS-dependent error messages which may differ between OSes.
-			errType := reflect.TypeOf(err)
-			assert.Equal(t, test.wantErrType, errType)
-		} else {
-			require.NoError(t, err)
-			stat, err := os.Stat(fmt.Sprintf("%s/%s", test.dir, test.file))
-			require.NoError(t, err)
-			assert.Equal(t, test.file, stat.Name())
-		}
-	}
+		secret{name: "first", keyFileNameMap: map[string]string{"ca": "ca.crt"}},
+		secret{name: "second", keyFileNameMap: map[string]string{"ca": "ca.crt", "key": "user.key"}},
+	)
+	assert.Equal(t, map[string][]byte{"ca.crt": []byte("first"), "user.key": []byte("key")}, certs)
 }
@@ -18,6 +18,8 @@ import (
 	"github.com/jaegertracing/jaeger-operator/pkg/deployment"
 	"github.com/jaegertracing/jaeger-operator/pkg/ingress"
 	"github.com/jaegertracing/jaeger-operator/pkg/inject"
+	"github.com/jaegertracing/jaeger-operator/pkg/monitoring"
+	"github.com/jaegertracing/jaeger-operator/pkg/networkpolicy"
 	"github.com/jaegertracing/jaeger-operator/pkg/route"
 	"github.com/jaegertracing/jaeger-operator/pkg/storage"
 )
@@ -114,6 +116,12 @@ func newAllInOneStrategy(ctx context.Context, jaeger *v1.Jaeger) S {
 	}
 
 	c.dependencies = storage.Dependencies(jaeger)
+	c.networkPolicies = networkpolicy.New(jaeger).Get()
+
+	monitors := monitoring.New(jaeger)
+	c.serviceMonitors = monitors.ServiceMonitors()
+	c.podMonitors = monitors.PodMonitors()
+	c.prometheusRules = monitors.PrometheusRules()
 
 	return c
 }
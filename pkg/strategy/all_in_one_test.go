@@ -212,3 +212,22 @@ func testEsIndexCleaner(t *testing.T, fce func(jaeger *v1.Jaeger) S) {
 		}
 	}
 }
+
+func TestNetworkPoliciesForAllInOne(t *testing.T) {
+	trueVar := true
+	j := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	j.Spec.Strategy = v1.DeploymentStrategyAllInOne
+	j.Spec.NetworkPolicy.Enabled = &trueVar
+	c := newAllInOneStrategy(context.Background(), j)
+	assert.Len(t, c.NetworkPolicies(), 1)
+	assert.Equal(t, "my-instance-all-in-one", c.NetworkPolicies()[0].Name)
+}
+
+func TestMonitorsForAllInOne(t *testing.T) {
+	j := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	j.Spec.Strategy = v1.DeploymentStrategyAllInOne
+	j.Spec.Observability.Metrics.MonitorType = v1.JaegerPodMonitor
+	c := newAllInOneStrategy(context.Background(), j)
+	assert.Len(t, c.PodMonitors(), 1)
+	assert.Equal(t, "my-instance-all-in-one", c.PodMonitors()[0].Name)
+}
@@ -49,6 +49,10 @@ func For(ctx context.Context, jaeger *v1.Jaeger) S {
 		return newStreamingStrategy(ctx, jaeger)
 	}
 
+	if jaeger.Spec.Strategy == v1.DeploymentStrategyOTelCollector {
+		return newOTelCollectorStrategy(ctx, jaeger)
+	}
+
 	return newProductionStrategy(ctx, jaeger)
 }
 
@@ -97,13 +101,27 @@ func normalize(ctx context.Context, jaeger *v1.Jaeger) {
 	}
 
 	// normalize the deployment strategy
-	if jaeger.Spec.Strategy != v1.DeploymentStrategyProduction && jaeger.Spec.Strategy != v1.DeploymentStrategyStreaming {
+	if jaeger.Spec.Strategy != v1.DeploymentStrategyProduction &&
+		jaeger.Spec.Strategy != v1.DeploymentStrategyStreaming &&
+		jaeger.Spec.Strategy != v1.DeploymentStrategyOTelCollector {
 		jaeger.Spec.Strategy = v1.DeploymentStrategyAllInOne
 	}
 
 	// check for incompatible options
-	// if the storage is `memory`, then the only possible strategy is `all-in-one`
-	if !distributedStorage(jaeger.Spec.Storage.Type) && jaeger.Spec.Strategy != v1.DeploymentStrategyAllInOne {
+	// the OpenTelemetry Collector based Jaeger has no Kafka pipeline yet, so we keep the Jaeger v1 streaming strategy in that case
+	if jaeger.Spec.Strategy == v1.DeploymentStrategyOTelCollector && jaeger.Spec.Storage.Type == v1.JaegerKafkaStorage {
+		jaeger.Logger().V(1).Info(
+			"The Kafka storage isn't supported by the OpenTelemetry Collector strategy. Falling back to streaming",
+			"storage", jaeger.Spec.Storage.Type,
+		)
+		jaeger.Spec.Strategy = v1.DeploymentStrategyStreaming
+	}
+
+	// if the storage is `memory`, then the only possible strategy is `all-in-one`, as the
+	// OpenTelemetry Collector runs as a single instance holding both the collector and the query
+	if !distributedStorage(jaeger.Spec.Storage.Type) &&
+		jaeger.Spec.Strategy != v1.DeploymentStrategyAllInOne &&
+		jaeger.Spec.Strategy != v1.DeploymentStrategyOTelCollector {
 		jaeger.Logger().V(1).Info(
 			"No suitable storage provided. Falling back to allInOne",
 			"storage", jaeger.Spec.Storage.Type,
@@ -111,6 +129,14 @@ func normalize(ctx context.Context, jaeger *v1.Jaeger) {
 		jaeger.Spec.Strategy = v1.DeploymentStrategyAllInOne
 	}
 
+	// the traces stored by a replica of the OpenTelemetry Collector aren't visible to the other ones with a local
+	// storage, so it isn't autoscaled unless explicitly requested, which the webhook then rejects
+	if jaeger.Spec.Strategy == v1.DeploymentStrategyOTelCollector && !distributedStorage(jaeger.Spec.Storage.Type) &&
+		jaeger.Spec.Collector.Replicas == nil && jaeger.Spec.Collector.Autoscale == nil {
+		falseVar := false
+		jaeger.Spec.Collector.Autoscale = &falseVar
+	}
+
 	// we always set the value to None, except when we are on OpenShift *and* the user has not explicitly set to 'none'
 	if autodetect.OperatorConfiguration.GetPlatform() == autodetect.OpenShiftPlatform && jaeger.Spec.Ingress.Security != v1.IngressSecurityNoneExplicit {
 		jaeger.Spec.Ingress.Security = v1.IngressSecurityOAuthProxy
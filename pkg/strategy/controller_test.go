@@ -6,6 +6,7 @@ import (
 
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 
@@ -36,6 +37,44 @@ func TestNewControllerForProduction(t *testing.T) {
 	assert.Equal(t, v1.DeploymentStrategyProduction, ctrl.Type())
 }
 
+func TestNewControllerForOTelCollector(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Strategy = v1.DeploymentStrategyOTelCollector
+
+	ctrl := For(context.TODO(), jaeger)
+	assert.Equal(t, v1.DeploymentStrategyOTelCollector, ctrl.Type())
+	assert.Equal(t, v1.JaegerMemoryStorage, jaeger.Spec.Storage.Type)
+}
+
+func TestNoAutoscalingForOTelCollectorWithMemoryStorage(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Strategy = v1.DeploymentStrategyOTelCollector
+	normalize(context.Background(), jaeger)
+	require.NotNil(t, jaeger.Spec.Collector.Autoscale)
+	assert.False(t, *jaeger.Spec.Collector.Autoscale)
+}
+
+func TestAutoscalingForOTelCollectorWithDistributedStorage(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Spec.Strategy = v1.DeploymentStrategyOTelCollector
+	jaeger.Spec.Storage.Type = v1.JaegerCassandraStorage
+	normalize(context.Background(), jaeger)
+	assert.Nil(t, jaeger.Spec.Collector.Autoscale)
+}
+
+func TestIncompatibleKafkaStorageForOTelCollector(t *testing.T) {
+	jaeger := &v1.Jaeger{
+		Spec: v1.JaegerSpec{
+			Strategy: v1.DeploymentStrategyOTelCollector,
+			Storage: v1.JaegerStorageSpec{
+				Type: v1.JaegerKafkaStorage,
+			},
+		},
+	}
+	normalize(context.Background(), jaeger)
+	assert.Equal(t, v1.DeploymentStrategyStreaming, jaeger.Spec.Strategy)
+}
+
 func TestUnknownStorage(t *testing.T) {
 	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
 	jaeger.Spec.Storage.Type = "unknown"
@@ -0,0 +1,130 @@
+package strategy
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	appsv1 "k8s.io/api/apps/v1"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/account"
+	"github.com/jaegertracing/jaeger-operator/pkg/autodetect"
+	crb "github.com/jaegertracing/jaeger-operator/pkg/clusterrolebinding"
+	"github.com/jaegertracing/jaeger-operator/pkg/config/ca"
+	"github.com/jaegertracing/jaeger-operator/pkg/config/otelcol"
+	configmap "github.com/jaegertracing/jaeger-operator/pkg/config/ui"
+	"github.com/jaegertracing/jaeger-operator/pkg/consolelink"
+	"github.com/jaegertracing/jaeger-operator/pkg/cronjob"
+	"github.com/jaegertracing/jaeger-operator/pkg/deployment"
+	"github.com/jaegertracing/jaeger-operator/pkg/ingress"
+	"github.com/jaegertracing/jaeger-operator/pkg/inject"
+	"github.com/jaegertracing/jaeger-operator/pkg/route"
+	"github.com/jaegertracing/jaeger-operator/pkg/storage"
+)
+
+func newOTelCollectorStrategy(ctx context.Context, jaeger *v1.Jaeger) S {
+	tracer := otel.GetTracerProvider().Tracer(v1.ReconciliationTracer)
+	ctx, span := tracer.Start(ctx, "newOTelCollectorStrategy") // nolint:ineffassign,staticcheck
+	defer span.End()
+
+	c := S{typ: v1.DeploymentStrategyOTelCollector}
+	jaeger.Logger().V(-1).Info("Creating OpenTelemetry Collector deployment")
+
+	collector := deployment.NewOTelCollector(jaeger)
+
+	// add all service accounts
+	for _, acc := range account.Get(jaeger) {
+		c.accounts = append(c.accounts, *acc)
+	}
+
+	// add all cluster role bindings
+	c.clusterRoleBindings = crb.Get(jaeger)
+
+	// add the OpenTelemetry Collector config map, and the secret holding the storage password it refers to
+	if cm, err := otelcol.NewConfig(jaeger).Get(); err != nil {
+		c.err = err
+	} else {
+		c.configMaps = append(c.configMaps, *cm)
+	}
+	if secret := otelcol.CredentialsSecret(jaeger); secret != nil {
+		c.secrets = append(c.secrets, *secret)
+	}
+
+	// add the UI config map
+	if cm := configmap.NewUIConfig(jaeger).Get(); cm != nil {
+		c.configMaps = append(c.configMaps, *cm)
+	}
+
+	// add the optional OpenShift trusted CA config map
+	if cm := ca.GetTrustedCABundle(jaeger); cm != nil {
+		c.configMaps = append(c.configMaps, *cm)
+	}
+
+	// add the service CA config map
+	if cm := ca.GetServiceCABundle(jaeger); cm != nil {
+		c.configMaps = append(c.configMaps, *cm)
+	}
+
+	// add the deployments
+	c.deployments = []appsv1.Deployment{*inject.OAuthProxy(jaeger, collector.Get())}
+
+	// add the services
+	for _, svc := range collector.Services() {
+		c.services = append(c.services, *svc)
+	}
+
+	// add the routes/ingresses
+	if autodetect.OperatorConfiguration.GetPlatform() == autodetect.OpenShiftPlatform {
+		if q := route.NewQueryRoute(jaeger).Get(); nil != q {
+			c.routes = append(c.routes, *q)
+			if link := consolelink.Get(jaeger, q); link != nil {
+				c.consoleLinks = append(c.consoleLinks, *link)
+			}
+		}
+	} else {
+		if q := ingress.NewQueryIngress(jaeger).Get(); nil != q {
+			c.ingresses = append(c.ingresses, *q)
+		}
+	}
+	span.SetAttributes(attribute.String("Platform", autodetect.OperatorConfiguration.GetPlatform().String()))
+
+	// add autoscalers
+	c.horizontalPodAutoscalers = collector.Autoscalers()
+
+	if isBoolTrue(jaeger.Spec.Storage.Dependencies.Enabled) {
+		if cronjob.SupportedStorage(jaeger.Spec.Storage.Type) {
+			c.cronJobs = append(c.cronJobs, cronjob.CreateSparkDependencies(jaeger))
+		} else {
+			jaeger.Logger().V(1).Info(
+				"skipping spark dependencies job due to unsupported storage.",
+				"type", jaeger.Spec.Storage.Type,
+			)
+		}
+	}
+
+	if isBoolTrue(jaeger.Spec.Storage.EsIndexCleaner.Enabled) {
+		if jaeger.Spec.Storage.Type == v1.JaegerESStorage {
+			c.cronJobs = append(c.cronJobs, cronjob.CreateEsIndexCleaner(jaeger))
+		} else {
+			jaeger.Logger().V(1).Info(
+				"skipping Elasticsearch index cleaner job due to unsupported storage.",
+				"type", jaeger.Spec.Storage.Type,
+			)
+		}
+	}
+
+	if storage.EnableRollover(jaeger.Spec.Storage) {
+		c.cronJobs = append(c.cronJobs, cronjob.CreateRollover(jaeger)...)
+	}
+
+	c.dependencies = storage.Dependencies(jaeger)
+
+	if v1.ShouldInjectOpenShiftElasticsearchConfiguration(jaeger.Spec.Storage) {
+		jaeger.Logger().V(1).Info(
+			"skipping the Elasticsearch self-provisioning, as it isn't supported by the OpenTelemetry Collector strategy. Set the 'es.server-urls' option to point to an existing cluster.",
+		)
+	}
+
+	return c
+}
@@ -0,0 +1,83 @@
+package strategy
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/storage"
+)
+
+func TestCreateOTelCollectorDeployment(t *testing.T) {
+	name := "TestCreateOTelCollectorDeployment"
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: name})
+	jaeger.Spec.Strategy = v1.DeploymentStrategyOTelCollector
+	normalize(context.Background(), jaeger)
+
+	c := newOTelCollectorStrategy(context.Background(), jaeger)
+	assert.Equal(t, v1.DeploymentStrategyOTelCollector, c.Type())
+
+	require.Len(t, c.Deployments(), 1)
+	assert.Equal(t, fmt.Sprintf("%s-otel-collector", name), c.Deployments()[0].Name)
+	assert.Empty(t, c.DaemonSets())
+
+	configMaps := []string{}
+	for _, cm := range c.ConfigMaps() {
+		configMaps = append(configMaps, cm.Name)
+	}
+	assert.Contains(t, configMaps, fmt.Sprintf("%s-otel-collector-configuration", name))
+
+	services := []string{}
+	for _, svc := range c.Services() {
+		services = append(services, svc.Name)
+	}
+	assert.ElementsMatch(t, []string{
+		"testcreateotelcollectordeployment-collector-headless",
+		"testcreateotelcollectordeployment-collector",
+		"testcreateotelcollectordeployment-query",
+	}, services)
+
+	assert.Len(t, c.Ingresses(), 1)
+	// the traces kept in memory by one replica wouldn't be visible to the other ones
+	assert.Empty(t, c.HorizontalPodAutoscalers())
+}
+
+func TestCreateOTelCollectorDeploymentOnOpenShift(t *testing.T) {
+	viper.Set("platform", "openshift")
+	defer viper.Reset()
+
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestCreateOTelCollectorDeploymentOnOpenShift"})
+	jaeger.Spec.Strategy = v1.DeploymentStrategyOTelCollector
+	normalize(context.Background(), jaeger)
+
+	c := newOTelCollectorStrategy(context.Background(), jaeger)
+	assert.Len(t, c.Routes(), 1)
+	assert.Empty(t, c.Ingresses())
+
+	// the query is protected by the OAuth Proxy
+	assert.Len(t, c.Deployments()[0].Spec.Template.Spec.Containers, 2)
+}
+
+func TestOTelCollectorWithElasticsearch(t *testing.T) {
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "TestOTelCollectorWithElasticsearch"})
+	jaeger.Spec.Strategy = v1.DeploymentStrategyOTelCollector
+	jaeger.Spec.Storage.Type = v1.JaegerESStorage
+	jaeger.Spec.Storage.Options = v1.NewOptions(map[string]interface{}{
+		"es.server-urls": "http://elasticsearch:9200",
+		"es.use-aliases": "true",
+	})
+	normalize(context.Background(), jaeger)
+
+	c := newOTelCollectorStrategy(context.Background(), jaeger)
+
+	// spark dependencies, index cleaner, rollover and lookback
+	assert.Len(t, c.CronJobs(), 4)
+	assert.Equal(t, storage.Dependencies(jaeger), c.Dependencies())
+	assert.Empty(t, c.Elasticsearches())
+}
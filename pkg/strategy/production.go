@@ -12,6 +12,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1beta1 "k8s.io/api/batch/v1beta1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 
 	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
 	"github.com/jaegertracing/jaeger-operator/pkg/account"
@@ -25,6 +26,8 @@ import (
 	"github.com/jaegertracing/jaeger-operator/pkg/deployment"
 	"github.com/jaegertracing/jaeger-operator/pkg/ingress"
 	"github.com/jaegertracing/jaeger-operator/pkg/inject"
+	"github.com/jaegertracing/jaeger-operator/pkg/monitoring"
+	"github.com/jaegertracing/jaeger-operator/pkg/networkpolicy"
 	"github.com/jaegertracing/jaeger-operator/pkg/route"
 	"github.com/jaegertracing/jaeger-operator/pkg/storage"
 )
@@ -39,6 +42,17 @@ func newProductionStrategy(ctx context.Context, jaeger *v1.Jaeger) S {
 	query := deployment.NewQuery(jaeger)
 	agent := deployment.NewAgent(jaeger)
 
+	// the components connect to the Cassandra cluster provisioned via cass-operator, so the storage options
+	// have to point to it before they are built
+	var cassandra *storage.CassandraDeployment
+	if storage.ShouldProvisionCassandra(jaeger) {
+		jaeger.Logger().V(-1).Info(
+			"Cassandra auto provisioning is enabled. A Cassandra cluster will be deployed if it does not exist.",
+		)
+		cassandra = &storage.CassandraDeployment{Jaeger: jaeger}
+		cassandra.ConfigureStorage()
+	}
+
 	// add all service accounts
 	for _, acc := range account.Get(jaeger) {
 		c.accounts = append(c.accounts, *acc)
@@ -99,6 +113,13 @@ func newProductionStrategy(ctx context.Context, jaeger *v1.Jaeger) S {
 	// add autoscalers
 	c.horizontalPodAutoscalers = collector.Autoscalers()
 
+	// add the disruption budgets
+	for _, pdb := range []*policyv1.PodDisruptionBudget{collector.PodDisruptionBudget(), query.PodDisruptionBudget()} {
+		if pdb != nil {
+			c.podDisruptionBudgets = append(c.podDisruptionBudgets, *pdb)
+		}
+	}
+
 	if isBoolTrue(jaeger.Spec.Storage.Dependencies.Enabled) {
 		if cronjob.SupportedStorage(jaeger.Spec.Storage.Type) {
 			c.cronJobs = append(c.cronJobs, cronjob.CreateSparkDependencies(jaeger))
@@ -156,6 +177,23 @@ func newProductionStrategy(ctx context.Context, jaeger *v1.Jaeger) S {
 		autoProvisionElasticsearch(&c, jaeger, jobs, []*appsv1.Deployment{queryDep, cDep})
 	}
 
+	// assembles the pieces for a cassandra self-provisioned cluster via cass-operator or k8ssandra
+	if cassandra != nil {
+		var jobs []*corev1.PodSpec
+		for i := range c.dependencies {
+			jobs = append(jobs, &c.dependencies[i].Spec.Template.Spec)
+		}
+		for i := range c.cronJobs {
+			switch cj := c.cronJobs[i].(type) {
+			case *batchv1.CronJob:
+				jobs = append(jobs, &cj.Spec.JobTemplate.Spec.Template.Spec)
+			case *batchv1beta1.CronJob:
+				jobs = append(jobs, &cj.Spec.JobTemplate.Spec.Template.Spec)
+			}
+		}
+		autoProvisionCassandra(&c, cassandra, jobs, []*appsv1.Deployment{queryDep, cDep})
+	}
+
 	// the index cleaner ES job, which may have been changed by the ES self-provisioning routine
 	if indexCleaner != nil {
 		c.cronJobs = append(c.cronJobs, indexCleaner)
@@ -164,8 +202,14 @@ func newProductionStrategy(ctx context.Context, jaeger *v1.Jaeger) S {
 		c.cronJobs = append(c.cronJobs, esRollover...)
 	}
 
-	// add the deployments, which may have been changed by the ES self-provisioning routine
+	// add the deployments, which may have been changed by the ES and cassandra self-provisioning routines
 	c.deployments = []appsv1.Deployment{*cDep, *queryDep}
+	c.networkPolicies = networkpolicy.New(jaeger).Get()
+
+	monitors := monitoring.New(jaeger)
+	c.serviceMonitors = monitors.ServiceMonitors()
+	c.podMonitors = monitors.PodMonitors()
+	c.prometheusRules = monitors.PrometheusRules()
 
 	return c
 }
@@ -183,3 +227,19 @@ func autoProvisionElasticsearch(manifest *S, jaeger *v1.Jaeger, curatorPods []*c
 		manifest.elasticsearches = append(manifest.elasticsearches, *esCR)
 	}
 }
+
+func autoProvisionCassandra(manifest *S, cassandra *storage.CassandraDeployment, jobPods []*corev1.PodSpec, deployments []*appsv1.Deployment) {
+	for i := range deployments {
+		cassandra.InjectStorageConfiguration(&deployments[i].Spec.Template.Spec)
+	}
+	for _, pod := range jobPods {
+		cassandra.InjectStorageConfiguration(pod)
+	}
+
+	// K8ssandra manages the CassandraDatacenter of the cluster on its own
+	if cassandra.Kind() == v1.AnnotationCassandraKindK8ssandra {
+		manifest.k8ssandraClusters = append(manifest.k8ssandraClusters, *cassandra.K8ssandraCluster())
+		return
+	}
+	manifest.cassandraDatacenters = append(manifest.cassandraDatacenters, *cassandra.CassandraDatacenter())
+}
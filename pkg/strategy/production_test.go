@@ -127,6 +127,15 @@ func TestAutoscaleForProduction(t *testing.T) {
 	assert.Len(t, c.HorizontalPodAutoscalers(), 1)
 }
 
+func TestPodDisruptionBudgetsForProduction(t *testing.T) {
+	replicas := int32(2)
+	j := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	j.Spec.Collector.Replicas = &replicas
+	c := newProductionStrategy(context.Background(), j)
+	assert.Len(t, c.PodDisruptionBudgets(), 1)
+	assert.Equal(t, "my-instance-collector", c.PodDisruptionBudgets()[0].Name)
+}
+
 func assertDeploymentsAndServicesForProduction(t *testing.T, instance *v1.Jaeger, s S, hasDaemonSet bool, hasOAuthProxy bool, hasConfigMap bool) {
 	name := instance.Name
 
@@ -231,3 +240,151 @@ func assertEsInjectSecrets(t *testing.T, p corev1.PodSpec) {
 	assert.Contains(t, envs, "ES_TLS_KEY")
 	assert.Contains(t, envs, "ES_TLS_CERT")
 }
+
+func TestCassandraAutoProvision(t *testing.T) {
+	// prepare
+	autodetect.OperatorConfiguration.SetCassandraIntegration(autodetect.CassandraOperatorIntegrationYes)
+	defer viper.Reset()
+	viper.SetDefault(v1.FlagCronJobsVersion, v1.FlagCronJobsVersionBatchV1)
+
+	trueVar := true
+	j := v1.NewJaeger(types.NamespacedName{Name: "my-instance", Namespace: "observability"})
+	j.Spec.Storage.Type = v1.JaegerCassandraStorage
+	j.Spec.Storage.Dependencies.Enabled = &trueVar
+
+	// test
+	c := newProductionStrategy(context.Background(), j)
+
+	// verify
+	assert.Len(t, c.CassandraDatacenters(), 1)
+	assert.Empty(t, c.K8ssandraClusters())
+	assert.Equal(t, "dc1", c.CassandraDatacenters()[0].Name)
+
+	servers := "--cassandra.servers=my-instance-dc1-service.observability.svc.cluster.local"
+	for _, dep := range c.Deployments() {
+		assert.Contains(t, dep.Spec.Template.Spec.Containers[0].Args, servers)
+		assertCassandraCredentials(t, dep.Spec.Template.Spec)
+	}
+
+	assert.Len(t, c.Dependencies(), 1)
+	schema := c.Dependencies()[0].Spec.Template.Spec
+	assertCassandraCredentials(t, schema)
+	assert.Contains(t, schema.Containers[0].Env, corev1.EnvVar{Name: "CQLSH_HOST", Value: "my-instance-dc1-service.observability.svc.cluster.local"})
+	assert.Contains(t, schema.Containers[0].Env, corev1.EnvVar{Name: "DATACENTER", Value: "dc1"})
+
+	assert.Len(t, c.cronJobs, 1)
+	assertCassandraCredentials(t, c.cronJobs[0].(*batchv1.CronJob).Spec.JobTemplate.Spec.Template.Spec)
+}
+
+func TestCassandraAutoProvisionWithK8ssandra(t *testing.T) {
+	// prepare
+	autodetect.OperatorConfiguration.SetCassandraIntegration(autodetect.CassandraOperatorIntegrationYes)
+	autodetect.OperatorConfiguration.SetK8ssandraAvailability(autodetect.K8ssandraAvailabilityYes)
+	defer viper.Reset()
+
+	j := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	j.Spec.Storage.Type = v1.JaegerCassandraStorage
+
+	// test
+	c := newProductionStrategy(context.Background(), j)
+
+	// verify
+	assert.Empty(t, c.CassandraDatacenters())
+	assert.Len(t, c.K8ssandraClusters(), 1)
+	assert.Equal(t, "my-instance", c.K8ssandraClusters()[0].Name)
+	assert.Equal(t, v1.AnnotationCassandraKindK8ssandra, j.Annotations[v1.AnnotationCassandraKindKey])
+}
+
+func TestCassandraAutoProvisionKeepsDatacenter(t *testing.T) {
+	// prepare
+	autodetect.OperatorConfiguration.SetCassandraIntegration(autodetect.CassandraOperatorIntegrationYes)
+	autodetect.OperatorConfiguration.SetK8ssandraAvailability(autodetect.K8ssandraAvailabilityYes)
+	defer viper.Reset()
+
+	// the cluster was provisioned before the K8ssandra operator got installed
+	j := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	j.Spec.Storage.Type = v1.JaegerCassandraStorage
+	j.Annotations = map[string]string{
+		v1.AnnotationProvisionedCassandraKey: v1.AnnotationProvisionedCassandraValue,
+		v1.AnnotationCassandraKindKey:        v1.AnnotationCassandraKindDatacenter,
+	}
+
+	// test
+	c := newProductionStrategy(context.Background(), j)
+
+	// verify
+	assert.Len(t, c.CassandraDatacenters(), 1)
+	assert.Empty(t, c.K8ssandraClusters())
+}
+
+func TestCassandraAutoProvisionKeepsK8ssandraCluster(t *testing.T) {
+	// prepare
+	autodetect.OperatorConfiguration.SetCassandraIntegration(autodetect.CassandraOperatorIntegrationYes)
+	autodetect.OperatorConfiguration.SetK8ssandraAvailability(autodetect.K8ssandraAvailabilityNo)
+	defer viper.Reset()
+
+	j := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	j.Spec.Storage.Type = v1.JaegerCassandraStorage
+	j.Annotations = map[string]string{
+		v1.AnnotationProvisionedCassandraKey: v1.AnnotationProvisionedCassandraValue,
+		v1.AnnotationCassandraKindKey:        v1.AnnotationCassandraKindK8ssandra,
+	}
+
+	// test
+	c := newProductionStrategy(context.Background(), j)
+
+	// verify
+	assert.Empty(t, c.CassandraDatacenters())
+	assert.Len(t, c.K8ssandraClusters(), 1)
+}
+
+func TestCassandraNoAutoProvisionWithServers(t *testing.T) {
+	// prepare
+	autodetect.OperatorConfiguration.SetCassandraIntegration(autodetect.CassandraOperatorIntegrationYes)
+	defer viper.Reset()
+
+	j := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	j.Spec.Storage.Type = v1.JaegerCassandraStorage
+	j.Spec.Storage.Options = v1.NewOptions(map[string]interface{}{"cassandra.servers": "cassandra.example.com"})
+
+	// test
+	c := newProductionStrategy(context.Background(), j)
+
+	// verify
+	assert.Empty(t, c.CassandraDatacenters())
+	assert.Empty(t, c.K8ssandraClusters())
+	assert.NotContains(t, j.Annotations, v1.AnnotationProvisionedCassandraKey)
+}
+
+func assertCassandraCredentials(t *testing.T, p corev1.PodSpec) {
+	envs := map[string]corev1.EnvVar{}
+	for _, e := range p.Containers[0].Env {
+		envs[e.Name] = e
+	}
+	for _, name := range []string{"CASSANDRA_USERNAME", "CASSANDRA_PASSWORD"} {
+		if assert.Contains(t, envs, name) {
+			assert.Equal(t, "my-instance-superuser", envs[name].ValueFrom.SecretKeyRef.Name)
+		}
+	}
+}
+
+func TestNetworkPoliciesForProduction(t *testing.T) {
+	trueVar := true
+	j := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	j.Spec.Strategy = v1.DeploymentStrategyProduction
+	j.Spec.NetworkPolicy.Enabled = &trueVar
+	c := newProductionStrategy(context.Background(), j)
+	assert.Len(t, c.NetworkPolicies(), 2)
+}
+
+func TestMonitorsForProduction(t *testing.T) {
+	trueVar := true
+	j := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	j.Spec.Strategy = v1.DeploymentStrategyProduction
+	j.Spec.Observability.Metrics.MonitorType = v1.JaegerServiceMonitor
+	j.Spec.Observability.Metrics.AlertRules = &trueVar
+	c := newProductionStrategy(context.Background(), j)
+	assert.Len(t, c.ServiceMonitors(), 2)
+	assert.Empty(t, c.PodMonitors())
+	assert.Len(t, c.PrometheusRules(), 1)
+}
@@ -7,6 +7,7 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	rbac "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
@@ -15,14 +16,21 @@ import (
 	esv1 "github.com/openshift/elasticsearch-operator/apis/logging/v1"
 
 	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+	cassandrav1beta1 "github.com/jaegertracing/jaeger-operator/pkg/cassandra/v1beta1"
+	k8ssandrav1alpha1 "github.com/jaegertracing/jaeger-operator/pkg/k8ssandra/v1alpha1"
 	kafkav1beta2 "github.com/jaegertracing/jaeger-operator/pkg/kafka/v1beta2"
+	kedav1alpha1 "github.com/jaegertracing/jaeger-operator/pkg/keda/v1alpha1"
+	monitoringv1 "github.com/jaegertracing/jaeger-operator/pkg/monitoring/v1"
 )
 
 // S knows what type of deployments to build based on a given spec
 type S struct {
 	typ v1.DeploymentStrategy
+	// err holds why some of the objects couldn't be built, which fails the reconciliation
+	err error
 	// When adding a new type here, remember to update All() too
 	accounts                 []corev1.ServiceAccount
+	cassandraDatacenters     []cassandrav1beta1.CassandraDatacenter
 	clusterRoleBindings      []rbac.ClusterRoleBinding
 	configMaps               []corev1.ConfigMap
 	consoleLinks             []osconsolev1.ConsoleLink
@@ -33,11 +41,20 @@ type S struct {
 	elasticsearches          []esv1.Elasticsearch
 	horizontalPodAutoscalers []runtime.Object
 	ingresses                []networkingv1.Ingress
+	k8ssandraClusters        []k8ssandrav1alpha1.K8ssandraCluster
 	kafkas                   []kafkav1beta2.Kafka
+	kafkaNodePools           []kafkav1beta2.KafkaNodePool
 	kafkaUsers               []kafkav1beta2.KafkaUser
+	kafkaTopics              []kafkav1beta2.KafkaTopic
+	networkPolicies          []networkingv1.NetworkPolicy
+	podDisruptionBudgets     []policyv1.PodDisruptionBudget
+	podMonitors              []monitoringv1.PodMonitor
+	prometheusRules          []monitoringv1.PrometheusRule
 	routes                   []osv1.Route
+	scaledObjects            []kedav1alpha1.ScaledObject
 	services                 []corev1.Service
 	secrets                  []corev1.Secret
+	serviceMonitors          []monitoringv1.ServiceMonitor
 }
 
 // New constructs a new strategy from scratch
@@ -50,6 +67,17 @@ func (s S) Type() v1.DeploymentStrategy {
 	return s.typ
 }
 
+// WithError returns the strategy with the given error, explaining why some of the objects couldn't be built
+func (s S) WithError(err error) S {
+	s.err = err
+	return s
+}
+
+// Error returns why some of the objects of the strategy couldn't be built, if any
+func (s S) Error() error {
+	return s.err
+}
+
 // WithAccounts returns the strategy with the given list of service accounts
 func (s S) WithAccounts(accs []corev1.ServiceAccount) S {
 	s.accounts = accs
@@ -116,6 +144,30 @@ func (s S) WithHorizontalPodAutoscaler(i []runtime.Object) S {
 	return s
 }
 
+// WithNetworkPolicies returns the strategy with the given list of network policies
+func (s S) WithNetworkPolicies(n []networkingv1.NetworkPolicy) S {
+	s.networkPolicies = n
+	return s
+}
+
+// WithPodDisruptionBudgets returns the strategy with the given list of PDBs
+func (s S) WithPodDisruptionBudgets(p []policyv1.PodDisruptionBudget) S {
+	s.podDisruptionBudgets = p
+	return s
+}
+
+// WithPodMonitors returns the strategy with the given list of Prometheus Operator pod monitors
+func (s S) WithPodMonitors(m []monitoringv1.PodMonitor) S {
+	s.podMonitors = m
+	return s
+}
+
+// WithPrometheusRules returns the strategy with the given list of Prometheus Operator rules
+func (s S) WithPrometheusRules(r []monitoringv1.PrometheusRule) S {
+	s.prometheusRules = r
+	return s
+}
+
 // WithRoutes returns the strategy with the given list of routes
 func (s S) WithRoutes(r []osv1.Route) S {
 	s.routes = r
@@ -128,12 +180,48 @@ func (s S) WithKafkas(k []kafkav1beta2.Kafka) S {
 	return s
 }
 
+// WithKafkaNodePools returns the strategy with the given list of Kafka Node Pools
+func (s S) WithKafkaNodePools(k []kafkav1beta2.KafkaNodePool) S {
+	s.kafkaNodePools = k
+	return s
+}
+
 // WithKafkaUsers returns the strategy with the given list of Kafka Users
 func (s S) WithKafkaUsers(k []kafkav1beta2.KafkaUser) S {
 	s.kafkaUsers = k
 	return s
 }
 
+// WithKafkaTopics returns the strategy with the given list of Kafka Topics
+func (s S) WithKafkaTopics(k []kafkav1beta2.KafkaTopic) S {
+	s.kafkaTopics = k
+	return s
+}
+
+// WithCassandraDatacenters returns the strategy with the given list of CassandraDatacenters
+func (s S) WithCassandraDatacenters(c []cassandrav1beta1.CassandraDatacenter) S {
+	s.cassandraDatacenters = c
+	return s
+}
+
+// WithK8ssandraClusters returns the strategy with the given list of K8ssandraClusters
+func (s S) WithK8ssandraClusters(k []k8ssandrav1alpha1.K8ssandraCluster) S {
+	s.k8ssandraClusters = k
+	return s
+}
+
+// WithServiceMonitors returns the strategy with the given list of Prometheus Operator service monitors
+func (s S) WithServiceMonitors(m []monitoringv1.ServiceMonitor) S {
+	s.serviceMonitors = m
+	return s
+}
+
+// WithScaledObjects returns the strategy with the given list of KEDA ScaledObjects
+func (s S) WithScaledObjects(o []kedav1alpha1.ScaledObject) S {
+	s.scaledObjects = o
+	return s
+}
+
 // WithServices returns the strategy with the given list of routes
 func (s S) WithServices(svcs []corev1.Service) S {
 	s.services = svcs
@@ -201,16 +289,66 @@ func (s S) Kafkas() []kafkav1beta2.Kafka {
 	return s.kafkas
 }
 
+// KafkaNodePools returns the list of KafkaNodePools for this strategy.
+func (s S) KafkaNodePools() []kafkav1beta2.KafkaNodePool {
+	return s.kafkaNodePools
+}
+
 // KafkaUsers returns the list of KafkaUsers for this strategy.
 func (s S) KafkaUsers() []kafkav1beta2.KafkaUser {
 	return s.kafkaUsers
 }
 
+// KafkaTopics returns the list of KafkaTopics for this strategy.
+func (s S) KafkaTopics() []kafkav1beta2.KafkaTopic {
+	return s.kafkaTopics
+}
+
+// CassandraDatacenters returns the list of CassandraDatacenters for this strategy.
+func (s S) CassandraDatacenters() []cassandrav1beta1.CassandraDatacenter {
+	return s.cassandraDatacenters
+}
+
+// K8ssandraClusters returns the list of K8ssandraClusters for this strategy.
+func (s S) K8ssandraClusters() []k8ssandrav1alpha1.K8ssandraCluster {
+	return s.k8ssandraClusters
+}
+
+// NetworkPolicies returns the list of network policies for this strategy.
+func (s S) NetworkPolicies() []networkingv1.NetworkPolicy {
+	return s.networkPolicies
+}
+
+// PodDisruptionBudgets returns the list of PDBs for this strategy.
+func (s S) PodDisruptionBudgets() []policyv1.PodDisruptionBudget {
+	return s.podDisruptionBudgets
+}
+
+// PodMonitors returns the list of Prometheus Operator pod monitors for this strategy.
+func (s S) PodMonitors() []monitoringv1.PodMonitor {
+	return s.podMonitors
+}
+
+// PrometheusRules returns the list of Prometheus Operator rules for this strategy.
+func (s S) PrometheusRules() []monitoringv1.PrometheusRule {
+	return s.prometheusRules
+}
+
 // Routes returns the list of routes for this strategy. This might be platform-dependent
 func (s S) Routes() []osv1.Route {
 	return s.routes
 }
 
+// ServiceMonitors returns the list of Prometheus Operator service monitors for this strategy.
+func (s S) ServiceMonitors() []monitoringv1.ServiceMonitor {
+	return s.serviceMonitors
+}
+
+// ScaledObjects returns the list of KEDA ScaledObjects for this strategy.
+func (s S) ScaledObjects() []kedav1alpha1.ScaledObject {
+	return s.scaledObjects
+}
+
 // Services returns the list of services for this strategy
 func (s S) Services() []corev1.Service {
 	return s.services
@@ -261,14 +399,54 @@ func (s S) All() []runtime.Object {
 
 	ret = append(ret, s.horizontalPodAutoscalers...)
 
+	for _, o := range s.scaledObjects {
+		ret = append(ret, o.DeepCopy())
+	}
+
 	for _, o := range s.kafkas {
 		ret = append(ret, o.DeepCopy())
 	}
 
+	for _, o := range s.kafkaNodePools {
+		ret = append(ret, o.DeepCopy())
+	}
+
 	for _, o := range s.kafkaUsers {
 		ret = append(ret, o.DeepCopy())
 	}
 
+	for _, o := range s.kafkaTopics {
+		ret = append(ret, o.DeepCopy())
+	}
+
+	for _, o := range s.cassandraDatacenters {
+		ret = append(ret, o.DeepCopy())
+	}
+
+	for _, o := range s.k8ssandraClusters {
+		ret = append(ret, o.DeepCopy())
+	}
+
+	for _, o := range s.networkPolicies {
+		ret = append(ret, o.DeepCopy())
+	}
+
+	for _, o := range s.podDisruptionBudgets {
+		ret = append(ret, o.DeepCopy())
+	}
+
+	for _, o := range s.serviceMonitors {
+		ret = append(ret, o.DeepCopy())
+	}
+
+	for _, o := range s.podMonitors {
+		ret = append(ret, o.DeepCopy())
+	}
+
+	for _, o := range s.prometheusRules {
+		ret = append(ret, o.DeepCopy())
+	}
+
 	for _, o := range s.routes {
 		ret = append(ret, o.DeepCopy())
 	}
@@ -16,6 +16,8 @@ import (
 
 	esv1 "github.com/openshift/elasticsearch-operator/apis/logging/v1"
 
+	cassandrav1beta1 "github.com/jaegertracing/jaeger-operator/pkg/cassandra/v1beta1"
+	k8ssandrav1alpha1 "github.com/jaegertracing/jaeger-operator/pkg/k8ssandra/v1alpha1"
 	kafkav1beta2 "github.com/jaegertracing/jaeger-operator/pkg/kafka/v1beta2"
 )
 
@@ -86,12 +88,36 @@ func TestWithKafkas(t *testing.T) {
 	assert.Len(t, c.All(), 1)
 }
 
+func TestWithKafkaNodePools(t *testing.T) {
+	c := New().WithKafkaNodePools([]kafkav1beta2.KafkaNodePool{{}})
+	assert.Len(t, c.KafkaNodePools(), 1)
+	assert.Len(t, c.All(), 1)
+}
+
 func TestWithKafkaUsers(t *testing.T) {
 	c := New().WithKafkaUsers([]kafkav1beta2.KafkaUser{{}})
 	assert.Len(t, c.KafkaUsers(), 1)
 	assert.Len(t, c.All(), 1)
 }
 
+func TestWithKafkaTopics(t *testing.T) {
+	c := New().WithKafkaTopics([]kafkav1beta2.KafkaTopic{{}})
+	assert.Len(t, c.KafkaTopics(), 1)
+	assert.Len(t, c.All(), 1)
+}
+
+func TestWithCassandraDatacenters(t *testing.T) {
+	c := New().WithCassandraDatacenters([]cassandrav1beta1.CassandraDatacenter{{}})
+	assert.Len(t, c.CassandraDatacenters(), 1)
+	assert.Len(t, c.All(), 1)
+}
+
+func TestWithK8ssandraClusters(t *testing.T) {
+	c := New().WithK8ssandraClusters([]k8ssandrav1alpha1.K8ssandraCluster{{}})
+	assert.Len(t, c.K8ssandraClusters(), 1)
+	assert.Len(t, c.All(), 1)
+}
+
 func TestWithRoutes(t *testing.T) {
 	c := New().WithRoutes([]osv1.Route{{}})
 	assert.Len(t, c.Routes(), 1)
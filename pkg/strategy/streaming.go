@@ -12,6 +12,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1beta1 "k8s.io/api/batch/v1beta1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 
 	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
 	"github.com/jaegertracing/jaeger-operator/pkg/account"
@@ -26,6 +27,8 @@ import (
 	"github.com/jaegertracing/jaeger-operator/pkg/ingress"
 	"github.com/jaegertracing/jaeger-operator/pkg/inject"
 	"github.com/jaegertracing/jaeger-operator/pkg/kafka"
+	"github.com/jaegertracing/jaeger-operator/pkg/monitoring"
+	"github.com/jaegertracing/jaeger-operator/pkg/networkpolicy"
 	"github.com/jaegertracing/jaeger-operator/pkg/route"
 	"github.com/jaegertracing/jaeger-operator/pkg/storage"
 	"github.com/jaegertracing/jaeger-operator/pkg/util"
@@ -75,8 +78,9 @@ func newStreamingStrategy(ctx context.Context, jaeger *v1.Jaeger) S {
 	provisioned := jaeger.Annotations[v1.AnnotationProvisionedKafkaKey] == v1.AnnotationProvisionedKafkaValue
 
 	// we provision a Kafka when no brokers have been set, or, when we are not in the first run,
-	// when we know we've been the ones placing the broker information in the configuration
-	if (!pfound && !cfound) || provisioned {
+	// when we know we've been the ones placing the broker information in the configuration. Brokers
+	// set in the Kafka spec always point to an external cluster.
+	if jaeger.Spec.Kafka.Brokers == "" && ((!pfound && !cfound) || provisioned) {
 		jaeger.Logger().V(-1).Info(
 			"Kafka auto provisioning is enabled. A Kafka cluster will be deployed if it does not exist.",
 		)
@@ -111,8 +115,20 @@ func newStreamingStrategy(ctx context.Context, jaeger *v1.Jaeger) S {
 		}
 	}
 
-	// add autoscalers
-	manifest.horizontalPodAutoscalers = append(collector.Autoscalers(), ingester.Autoscalers()...)
+	// add autoscalers, KEDA takes over the ingester's when requested and available
+	manifest.horizontalPodAutoscalers = collector.Autoscalers()
+	if jaeger.Spec.Ingester.KEDA != nil && autodetect.OperatorConfiguration.IsKEDAIntegrationEnabled() {
+		manifest.scaledObjects = ingester.ScaledObjects()
+	} else {
+		manifest.horizontalPodAutoscalers = append(manifest.horizontalPodAutoscalers, ingester.Autoscalers()...)
+	}
+
+	// add the disruption budgets
+	for _, pdb := range []*policyv1.PodDisruptionBudget{collector.PodDisruptionBudget(), query.PodDisruptionBudget(), ingester.PodDisruptionBudget()} {
+		if pdb != nil {
+			manifest.podDisruptionBudgets = append(manifest.podDisruptionBudgets, *pdb)
+		}
+	}
 
 	if isBoolTrue(jaeger.Spec.Storage.Dependencies.Enabled) {
 		if cronjob.SupportedStorage(jaeger.Spec.Storage.Type) {
@@ -194,6 +210,13 @@ func newStreamingStrategy(ctx context.Context, jaeger *v1.Jaeger) S {
 		manifest.cronJobs = append(manifest.cronJobs, esRollover...)
 	}
 
+	manifest.networkPolicies = networkpolicy.New(jaeger).Get()
+
+	monitors := monitoring.New(jaeger)
+	manifest.serviceMonitors = monitors.ServiceMonitors()
+	manifest.podMonitors = monitors.PodMonitors()
+	manifest.prometheusRules = monitors.PrometheusRules()
+
 	return manifest
 }
 
@@ -205,13 +228,22 @@ func autoProvisionKafka(ctx context.Context, jaeger *v1.Jaeger, manifest S) S {
 	if jaeger.Annotations == nil {
 		jaeger.Annotations = map[string]string{}
 	}
+	// the mode is decided when the cluster is first provisioned, and kept afterwards
+	jaeger.Annotations[v1.AnnotationKafkaModeKey] = kafka.Mode(jaeger)
 	// mark that we auto provisioned a kafka for this instance
 	jaeger.Annotations[v1.AnnotationProvisionedKafkaKey] = v1.AnnotationProvisionedKafkaValue
 
 	k := kafka.Persistent(jaeger)
 	ku := kafka.User(jaeger)
 	manifest.kafkas = append(manifest.kafkas, k)
+	manifest.kafkaNodePools = append(manifest.kafkaNodePools, kafka.NodePools(jaeger)...)
 	manifest.kafkaUsers = append(manifest.kafkaUsers, ku)
+	manifest.kafkaTopics = append(manifest.kafkaTopics, kafka.Topics(jaeger)...)
+
+	if jaeger.Spec.Kafka.TLS != nil || jaeger.Spec.Kafka.Authentication != nil {
+		// the connection to the provisioned cluster is then set by the collector and ingester from the Kafka spec
+		return manifest
+	}
 
 	// these are the in-container paths, available to the Jaeger containers (ingester/collector)
 	clusterCAPath := fmt.Sprintf("/var/run/secrets/%s-cluster-ca", jaeger.Name)
@@ -36,9 +36,10 @@ func TestStreamingKafkaProvisioning(t *testing.T) {
 	name := "my-instance"
 	c := newStreamingStrategy(context.Background(), v1.NewJaeger(types.NamespacedName{Name: name}))
 
-	// one Kafka, one KafkaUser
+	// one Kafka, one KafkaUser, one KafkaTopic
 	assert.Len(t, c.Kafkas(), 1)
 	assert.Len(t, c.KafkaUsers(), 1)
+	assert.Len(t, c.KafkaTopics(), 1)
 }
 
 func TestStreamingNoKafkaProvisioningWhenConsumerBrokersSet(t *testing.T) {
@@ -65,6 +66,40 @@ func TestStreamingNoKafkaProvisioningWhenProducerBrokersSet(t *testing.T) {
 	assert.Empty(t, c.Kafkas())
 }
 
+func TestStreamingNoKafkaProvisioningWhenExternalBrokersSet(t *testing.T) {
+	name := "my-instance"
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: name})
+	jaeger.Spec.Kafka.Brokers = "my-cluster-kafka-bootstrap.kafka:9094"
+	c := newStreamingStrategy(context.Background(), jaeger)
+
+	assert.Empty(t, c.Kafkas())
+	assert.Empty(t, c.KafkaUsers())
+	assert.Empty(t, c.KafkaTopics())
+	assert.NotContains(t, jaeger.Annotations, v1.AnnotationProvisionedKafkaKey)
+}
+
+func TestStreamingKafkaProvisioningWithSCRAM(t *testing.T) {
+	name := "my-instance"
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: name, Namespace: "project"})
+	jaeger.Spec.Strategy = v1.DeploymentStrategyStreaming
+	jaeger.Spec.Kafka.Authentication = &v1.JaegerKafkaAuthenticationSpec{Type: v1.KafkaAuthenticationSCRAMSHA512}
+	c := newStreamingStrategy(context.Background(), jaeger)
+
+	// the connection isn't stored in the options, it's set by the collector and ingester from the Kafka spec
+	assert.Len(t, c.Kafkas(), 1)
+	assert.Len(t, c.KafkaUsers(), 1)
+	assert.NotContains(t, jaeger.Spec.Collector.Options.Map(), "kafka.producer.brokers")
+	assert.Empty(t, jaeger.Spec.Volumes)
+
+	for _, dep := range c.Deployments() {
+		if dep.Name != "my-instance-collector" {
+			continue
+		}
+		assert.Contains(t, dep.Spec.Template.Spec.Containers[0].Args, "--kafka.producer.brokers=my-instance-kafka-bootstrap.project.svc.cluster.local:9094")
+		assert.Contains(t, dep.Spec.Template.Spec.Containers[0].Args, "--kafka.producer.plaintext.username=my-instance")
+	}
+}
+
 func TestCreateStreamingDeploymentOnOpenShift(t *testing.T) {
 	viper.Set("platform", "openshift")
 	defer viper.Reset()
@@ -177,6 +212,49 @@ func TestAutoscaleForStreaming(t *testing.T) {
 	assert.Len(t, c.HorizontalPodAutoscalers(), 2)
 }
 
+func TestPodDisruptionBudgetsForStreaming(t *testing.T) {
+	replicas := int32(2)
+	j := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	j.Spec.Collector.Replicas = &replicas
+	j.Spec.Query.Replicas = &replicas
+	j.Spec.Ingester.Replicas = &replicas
+	c := newStreamingStrategy(context.Background(), j)
+	assert.Len(t, c.PodDisruptionBudgets(), 3)
+}
+
+func TestKEDAForStreaming(t *testing.T) {
+	// prepare
+	autodetect.OperatorConfiguration.SetKEDAIntegration(autodetect.KEDAIntegrationYes)
+	defer viper.Reset()
+
+	j := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	j.Spec.Ingester.KEDA = &v1.JaegerIngesterKEDASpec{}
+
+	// test
+	c := newStreamingStrategy(context.Background(), j)
+
+	// verify
+	assert.Len(t, c.HorizontalPodAutoscalers(), 1)
+	assert.Len(t, c.ScaledObjects(), 1)
+	assert.Equal(t, "my-instance-ingester", c.ScaledObjects()[0].Spec.ScaleTargetRef.Name)
+}
+
+func TestKEDANotAvailableForStreaming(t *testing.T) {
+	// prepare
+	autodetect.OperatorConfiguration.SetKEDAIntegration(autodetect.KEDAIntegrationNo)
+	defer viper.Reset()
+
+	j := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	j.Spec.Ingester.KEDA = &v1.JaegerIngesterKEDASpec{}
+
+	// test
+	c := newStreamingStrategy(context.Background(), j)
+
+	// verify
+	assert.Len(t, c.HorizontalPodAutoscalers(), 2)
+	assert.Empty(t, c.ScaledObjects())
+}
+
 func assertDeploymentsAndServicesForStreaming(t *testing.T, instance *v1.Jaeger, s S, hasDaemonSet bool, hasOAuthProxy bool, hasConfigMap bool) {
 	name := instance.Name
 
@@ -394,3 +472,53 @@ func assertEsInjectSecretsStreaming(t *testing.T, p corev1.PodSpec) {
 	assert.Contains(t, envs, "ES_TLS_KEY")
 	assert.Contains(t, envs, "ES_TLS_CERT")
 }
+
+func TestNetworkPoliciesForStreaming(t *testing.T) {
+	trueVar := true
+	j := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	j.Spec.Strategy = v1.DeploymentStrategyStreaming
+	j.Spec.NetworkPolicy.Enabled = &trueVar
+	c := newStreamingStrategy(context.Background(), j)
+	assert.Len(t, c.NetworkPolicies(), 3)
+}
+
+func TestMonitorsForStreaming(t *testing.T) {
+	j := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	j.Spec.Strategy = v1.DeploymentStrategyStreaming
+	j.Spec.Observability.Metrics.MonitorType = v1.JaegerPodMonitor
+	c := newStreamingStrategy(context.Background(), j)
+	assert.Empty(t, c.ServiceMonitors())
+	assert.Len(t, c.PodMonitors(), 3)
+	assert.Empty(t, c.PrometheusRules())
+}
+
+func TestStreamingKafkaProvisioningWithNodePools(t *testing.T) {
+	// prepare
+	autodetect.OperatorConfiguration.SetKafkaNodePoolsAvailability(autodetect.KafkaNodePoolsAvailabilityYes)
+	defer viper.Reset()
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+
+	// test
+	c := newStreamingStrategy(context.Background(), jaeger)
+
+	// verify
+	assert.Len(t, c.Kafkas(), 1)
+	assert.Len(t, c.KafkaNodePools(), 1)
+	assert.Equal(t, v1.AnnotationKafkaModeKRaft, jaeger.Annotations[v1.AnnotationKafkaModeKey])
+}
+
+func TestStreamingKafkaProvisioningKeepsZooKeeper(t *testing.T) {
+	// prepare
+	autodetect.OperatorConfiguration.SetKafkaNodePoolsAvailability(autodetect.KafkaNodePoolsAvailabilityYes)
+	defer viper.Reset()
+	jaeger := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	jaeger.Annotations = map[string]string{v1.AnnotationProvisionedKafkaKey: v1.AnnotationProvisionedKafkaValue}
+
+	// test
+	c := newStreamingStrategy(context.Background(), jaeger)
+
+	// verify
+	assert.Len(t, c.Kafkas(), 1)
+	assert.Empty(t, c.KafkaNodePools())
+	assert.Equal(t, v1.AnnotationKafkaModeZooKeeper, jaeger.Annotations[v1.AnnotationKafkaModeKey])
+}
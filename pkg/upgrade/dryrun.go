@@ -0,0 +1,114 @@
+package upgrade
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/semver"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+	"github.com/jaegertracing/jaeger-operator/pkg/diff"
+)
+
+// Step is one of the upgrade functions applied to an instance
+type Step struct {
+	// Version is the version the upgrade function brings the instance to
+	Version string
+
+	// Before is the instance as it was before the step
+	Before v1.Jaeger
+
+	// After is the instance as the step left it
+	After v1.Jaeger
+
+	// Actions lists the changes the step would make to other objects of the cluster
+	Actions []string
+}
+
+// DryRun runs the upgrade functions bringing the given instance to the given version, returning the instance as
+// it was before and after each of them. The changes they would make to other objects are recorded, not made.
+func DryRun(ctx context.Context, jaeger v1.Jaeger, latestVersion string) ([]Step, error) {
+	currentSemVersion, err := semver.NewVersion(jaeger.Status.Version)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version of the instance %q: %w", jaeger.Status.Version, err)
+	}
+	latestSemVersion, err := semver.NewVersion(latestVersion)
+	if err != nil {
+		return nil, fmt.Errorf("invalid target version %q: %w", latestVersion, err)
+	}
+	if currentSemVersion.LessThan(startUpdatesVersion) {
+		return nil, fmt.Errorf("cannot automatically upgrade from versions lower than %s", startUpdatesVersion)
+	}
+
+	steps := []Step{}
+	for _, v := range pendingVersions(currentSemVersion, latestSemVersion) {
+		c := &recordingClient{}
+		upgraded, err := upgrades[v.String()](ctx, c, *jaeger.DeepCopy())
+		if err != nil {
+			return steps, fmt.Errorf("failed to upgrade to %s: %w", v.String(), err)
+		}
+		upgraded.Status.Version = v.String()
+
+		steps = append(steps, Step{
+			Version: v.String(),
+			Before:  jaeger,
+			After:   upgraded,
+			Actions: c.actions,
+		})
+		jaeger = upgraded
+	}
+	return steps, nil
+}
+
+// pendingVersions returns the versions of the upgrade functions to run to go from the current to the latest version
+func pendingVersions(current, latest *semver.Version) []*semver.Version {
+	pending := []*semver.Version{}
+	for _, v := range semanticVersions {
+		// we don't need to run the upgrade function for the current version, only the next ones
+		if v.GreaterThan(current) && (v.LessThan(latest) || v.Equal(latest)) {
+			pending = append(pending, v)
+		}
+	}
+	return pending
+}
+
+// recordingClient records the writes of the upgrade functions instead of making them. Objects are never found.
+type recordingClient struct {
+	client.Client
+	actions []string
+}
+
+func (c *recordingClient) Get(_ context.Context, key client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+	return k8serrors.NewNotFound(schema.GroupResource{Resource: diff.Kind(obj)}, key.Name)
+}
+
+func (c *recordingClient) List(_ context.Context, _ client.ObjectList, _ ...client.ListOption) error {
+	return nil
+}
+
+func (c *recordingClient) Create(_ context.Context, obj client.Object, _ ...client.CreateOption) error {
+	c.record("create", obj)
+	return nil
+}
+
+func (c *recordingClient) Update(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+	c.record("update", obj)
+	return nil
+}
+
+func (c *recordingClient) Patch(_ context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) error {
+	c.record("patch", obj)
+	return nil
+}
+
+func (c *recordingClient) Delete(_ context.Context, obj client.Object, _ ...client.DeleteOption) error {
+	c.record("delete", obj)
+	return nil
+}
+
+func (c *recordingClient) record(action string, obj client.Object) {
+	c.actions = append(c.actions, fmt.Sprintf("%s %s %s/%s", action, diff.Kind(obj), obj.GetNamespace(), obj.GetName()))
+}
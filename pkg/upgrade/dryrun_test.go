@@ -0,0 +1,93 @@
+package upgrade
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+)
+
+func TestDryRunSteps(t *testing.T) {
+	// prepare
+	existing := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	existing.Status.Version = "1.16.0"
+	existing.Spec.Collector.Options = v1.NewOptions(map[string]interface{}{
+		"es.tls": true,
+	})
+
+	// test
+	steps, err := DryRun(context.Background(), *existing, "1.20.0")
+
+	// verify
+	require.NoError(t, err)
+	require.Len(t, steps, 3)
+	assert.Equal(t, "1.17.0", steps[0].Version)
+	assert.Equal(t, "1.18.0", steps[1].Version)
+	assert.Equal(t, "1.20.0", steps[2].Version)
+
+	assert.Equal(t, "1.16.0", steps[0].Before.Status.Version)
+	assert.Contains(t, steps[0].Before.Spec.Collector.Options.Map(), "es.tls")
+	assert.Contains(t, steps[0].After.Spec.Collector.Options.Map(), "es.tls.enabled")
+	assert.Equal(t, "1.17.0", steps[0].After.Status.Version)
+	assert.Equal(t, steps[0].After, steps[1].Before)
+
+	// the given instance is left untouched
+	assert.Equal(t, "1.16.0", existing.Status.Version)
+	assert.Contains(t, existing.Spec.Collector.Options.Map(), "es.tls")
+}
+
+func TestDryRunRecordsActions(t *testing.T) {
+	// prepare
+	existing := v1.NewJaeger(types.NamespacedName{Name: "my-instance", Namespace: "observability"})
+	existing.Status.Version = "1.30.0"
+	existing.Spec.Storage.Type = v1.JaegerESStorage
+
+	// test
+	steps, err := DryRun(context.Background(), *existing, "1.31.0")
+
+	// verify
+	require.NoError(t, err)
+	require.Len(t, steps, 1)
+	assert.Equal(t, []string{"delete Elasticsearch observability/elasticsearch"}, steps[0].Actions)
+}
+
+func TestDryRunUpToDate(t *testing.T) {
+	// prepare
+	existing := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	existing.Status.Version = "1.31.0"
+
+	// test
+	steps, err := DryRun(context.Background(), *existing, "1.31.0")
+
+	// verify
+	require.NoError(t, err)
+	assert.Empty(t, steps)
+}
+
+func TestDryRunInvalidVersions(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		current string
+		latest  string
+	}{
+		{name: "invalid current version", current: "not-a-version", latest: "1.31.0"},
+		{name: "invalid target version", current: "1.30.0", latest: "not-a-version"},
+		{name: "too old to be upgraded", current: "1.10.0", latest: "1.31.0"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			// prepare
+			existing := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+			existing.Status.Version = tt.current
+
+			// test
+			_, err := DryRun(context.Background(), *existing, tt.latest)
+
+			// verify
+			assert.Error(t, err)
+		})
+	}
+}
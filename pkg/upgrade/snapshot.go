@@ -0,0 +1,64 @@
+package upgrade
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+)
+
+// ErrNoSnapshot is returned when rolling back an instance that has no pre-upgrade snapshot
+var ErrNoSnapshot = errors.New("the instance has no pre-upgrade snapshot")
+
+// snapshot is what the pre-upgrade snapshot annotation holds
+type snapshot struct {
+	Version string        `json:"version"`
+	Spec    v1.JaegerSpec `json:"spec"`
+}
+
+// storeSnapshot records the given spec and version into the pre-upgrade snapshot annotation of the instance,
+// replacing the snapshot of a previous upgrade
+func storeSnapshot(jaeger *v1.Jaeger, spec v1.JaegerSpec, version string) error {
+	b, err := json.Marshal(snapshot{Version: version, Spec: spec})
+	if err != nil {
+		return fmt.Errorf("failed to store the pre-upgrade snapshot: %w", err)
+	}
+
+	if jaeger.Annotations == nil {
+		jaeger.Annotations = map[string]string{}
+	}
+	jaeger.Annotations[v1.AnnotationPreUpgradeSnapshot] = string(b)
+	return nil
+}
+
+// Rollback restores the spec and version the given instance had before its last upgrade. The upgrade policy of the
+// restored instance is set to Manual, so that it isn't upgraded again right away: setting it back to Auto, or
+// pinning a newer version, runs the upgrade again.
+func Rollback(jaeger v1.Jaeger) (v1.Jaeger, error) {
+	value, ok := jaeger.Annotations[v1.AnnotationPreUpgradeSnapshot]
+	if !ok {
+		return jaeger, ErrNoSnapshot
+	}
+
+	s := snapshot{}
+	if err := json.Unmarshal([]byte(value), &s); err != nil {
+		return jaeger, fmt.Errorf("failed to read the pre-upgrade snapshot: %w", err)
+	}
+
+	jaeger.Spec = s.Spec
+	// the version is held back by the upgrade policy instead of being pinned, as pinning a version older than the
+	// current one is refused as a downgrade
+	jaeger.Spec.Version = ""
+	jaeger.Spec.UpgradePolicy = v1.UpgradePolicyManual
+	jaeger.Status.Version = s.Version
+
+	annotations := map[string]string{}
+	for k, v := range jaeger.Annotations {
+		if k != v1.AnnotationPreUpgradeSnapshot && k != v1.AnnotationRollbackUpgrade {
+			annotations[k] = v
+		}
+	}
+	jaeger.Annotations = annotations
+	return jaeger, nil
+}
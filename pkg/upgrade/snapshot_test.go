@@ -0,0 +1,107 @@
+package upgrade
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
+)
+
+func TestSnapshotStoredOnUpgrade(t *testing.T) {
+	// prepare
+	nsn := types.NamespacedName{Name: "my-instance"}
+	existing := v1.NewJaeger(nsn)
+	existing.Status.Version = "1.16.0"
+	existing.Spec.Collector.Options = v1.NewOptions(map[string]interface{}{
+		"es.tls": true,
+	})
+	objs := []runtime.Object{existing}
+
+	s := scheme.Scheme
+	s.AddKnownTypes(v1.GroupVersion, &v1.Jaeger{})
+	s.AddKnownTypes(v1.GroupVersion, &v1.JaegerList{})
+	cl := fake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(objs...).Build()
+
+	// test
+	require.NoError(t, ManagedInstances(context.Background(), cl, cl, &record.FakeRecorder{}, "1.17.0"))
+
+	// verify
+	persisted := &v1.Jaeger{}
+	require.NoError(t, cl.Get(context.Background(), nsn, persisted))
+	assert.Equal(t, "1.17.0", persisted.Status.Version)
+	assert.Contains(t, persisted.Spec.Collector.Options.Map(), "es.tls.enabled")
+	assert.Contains(t, persisted.Annotations, v1.AnnotationPreUpgradeSnapshot)
+
+	rolledBack, err := Rollback(*persisted)
+	require.NoError(t, err)
+	assert.Equal(t, "1.16.0", rolledBack.Status.Version)
+	assert.Equal(t, map[string]interface{}{"es.tls": "true"}, rolledBack.Spec.Collector.Options.Map())
+}
+
+func TestSnapshotNotStoredWithoutUpgrade(t *testing.T) {
+	// prepare
+	existing := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	existing.Status.Version = "1.17.0"
+
+	// test
+	upgraded, err := ManagedInstance(context.Background(), nil, *existing, "1.17.0")
+
+	// verify
+	require.NoError(t, err)
+	assert.NotContains(t, upgraded.Annotations, v1.AnnotationPreUpgradeSnapshot)
+}
+
+func TestRollback(t *testing.T) {
+	// prepare
+	existing := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	existing.Spec.Strategy = v1.DeploymentStrategyProduction
+	existing.Spec.Version = "1.22.0"
+	existing.Status.Version = "1.22.0"
+	existing.Annotations = map[string]string{
+		"custom":                        "kept",
+		v1.AnnotationRollbackUpgrade:    "true",
+		v1.AnnotationPreUpgradeSnapshot: `{"version":"1.20.0","spec":{"strategy":"allinone","version":"1.20.0"}}`,
+	}
+
+	// test
+	rolledBack, err := Rollback(*existing)
+
+	// verify
+	require.NoError(t, err)
+	assert.Equal(t, v1.DeploymentStrategyAllInOne, rolledBack.Spec.Strategy)
+	assert.Empty(t, rolledBack.Spec.Version)
+	assert.Equal(t, v1.UpgradePolicyManual, rolledBack.Spec.UpgradePolicy)
+	assert.Equal(t, "1.20.0", rolledBack.Status.Version)
+	assert.Equal(t, map[string]string{"custom": "kept"}, rolledBack.Annotations)
+}
+
+func TestRollbackWithoutSnapshot(t *testing.T) {
+	// prepare
+	existing := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+
+	// test
+	_, err := Rollback(*existing)
+
+	// verify
+	assert.ErrorIs(t, err, ErrNoSnapshot)
+}
+
+func TestRollbackInvalidSnapshot(t *testing.T) {
+	// prepare
+	existing := v1.NewJaeger(types.NamespacedName{Name: "my-instance"})
+	existing.Annotations = map[string]string{v1.AnnotationPreUpgradeSnapshot: "not-json"}
+
+	// test
+	_, err := Rollback(*existing)
+
+	// verify
+	assert.Error(t, err)
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/Masterminds/semver"
 	"github.com/spf13/viper"
 	"go.opentelemetry.io/otel"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
@@ -15,8 +17,11 @@ import (
 	"github.com/jaegertracing/jaeger-operator/pkg/tracing"
 )
 
+// EventReasonUpgraded is the reason for the event recorded against an instance that has been upgraded
+const EventReasonUpgraded = "Upgraded"
+
 // ManagedInstances finds all the Jaeger instances for the current operator and upgrades them, if necessary
-func ManagedInstances(ctx context.Context, c client.Client, reader client.Reader, latestVersion string) error {
+func ManagedInstances(ctx context.Context, c client.Client, reader client.Reader, recorder record.EventRecorder, latestVersion string) error {
 	tracer := otel.GetTracerProvider().Tracer(v1.ReconciliationTracer)
 	ctx, span := tracer.Start(ctx, "ManagedInstances")
 	defer span.End()
@@ -58,8 +63,14 @@ func ManagedInstances(ctx context.Context, c client.Client, reader client.Reader
 
 			continue
 		}
+		targetVersion := TargetVersion(j, latestVersion)
+		if targetVersion == j.Status.Version {
+			// held back on its version by its pinned version or upgrade policy, or simply up to date
+			continue
+		}
+
 		patch := client.MergeFrom(j.DeepCopy())
-		jaeger, err := ManagedInstance(ctx, c, j, latestVersion)
+		jaeger, err := ManagedInstance(ctx, c, j, targetVersion)
 		if err != nil {
 			// nothing to do at this level, just go to the next instance
 			log.Log.Error(
@@ -67,7 +78,7 @@ func ManagedInstances(ctx context.Context, c client.Client, reader client.Reader
 				"Failed to upgrade",
 				"jaeger", jaeger.Name,
 				"namespace", jaeger.Namespace,
-				"latest-jaeger-version", latestVersion,
+				"target-jaeger-version", targetVersion,
 			)
 
 			continue
@@ -88,6 +99,8 @@ func ManagedInstances(ctx context.Context, c client.Client, reader client.Reader
 						"version", version,
 					)
 					tracing.HandleError(err, span)
+				} else if version != j.Status.Version {
+					recorder.Eventf(&jaeger, corev1.EventTypeNormal, EventReasonUpgraded, "Upgraded from version %s to %s", j.Status.Version, version)
 				}
 			} else {
 				log.Log.Error(
@@ -104,6 +117,36 @@ func ManagedInstances(ctx context.Context, c client.Client, reader client.Reader
 	return nil
 }
 
+// TargetVersion returns the version the given instance should run, based on its pinned version and upgrade policy,
+// out of the latest version known by the operator
+func TargetVersion(jaeger v1.Jaeger, latestVersion string) string {
+	if len(jaeger.Spec.Version) > 0 {
+		return jaeger.Spec.Version
+	}
+
+	if jaeger.Spec.UpgradePolicy == v1.UpgradePolicyManual && len(jaeger.Status.Version) > 0 {
+		return jaeger.Status.Version
+	}
+
+	return latestVersion
+}
+
+// AvailableUpgrade returns the latest version known by the operator when it's newer than the version of the given
+// instance, or an empty string when the instance is up to date
+func AvailableUpgrade(jaeger v1.Jaeger, latestVersion string) string {
+	current, err := semver.NewVersion(jaeger.Status.Version)
+	if err != nil {
+		return ""
+	}
+
+	latest, err := semver.NewVersion(latestVersion)
+	if err != nil || !latest.GreaterThan(current) {
+		return ""
+	}
+
+	return latestVersion
+}
+
 // ManagedInstance performs the necessary changes to bring the given Jaeger instance to the current version
 func ManagedInstance(ctx context.Context, client client.Client, jaeger v1.Jaeger, latestVersion string) (v1.Jaeger, error) {
 	tracer := otel.GetTracerProvider().Tracer(v1.ReconciliationTracer)
@@ -148,28 +191,35 @@ func ManagedInstance(ctx context.Context, client client.Client, jaeger v1.Jaeger
 		return jaeger, nil
 	}
 
-	for _, v := range semanticVersions {
-		// we don't need to run the upgrade function for the version 'v', only the next ones
-		if v.GreaterThan(currentSemVersion) && (v.LessThan(latestSemVersion) || v.Equal(latestSemVersion)) {
-			upgraded, err := upgrades[v.String()](ctx, client, jaeger)
-			if err != nil {
-				log.Log.Error(
-					err,
-					"failed to upgrade managed instance",
-					"instance", jaeger.Name,
-					"namespace", jaeger.Namespace,
-					"to", v.String(),
-				)
-				return jaeger, tracing.HandleError(err, span)
-			}
+	previousVersion := jaeger.Status.Version
+	previousSpec := *jaeger.Spec.DeepCopy()
 
-			upgraded.Status.Version = v.String()
-			jaeger = upgraded
+	for _, v := range pendingVersions(currentSemVersion, latestSemVersion) {
+		upgraded, err := upgrades[v.String()](ctx, client, jaeger)
+		if err != nil {
+			log.Log.Error(
+				err,
+				"failed to upgrade managed instance",
+				"instance", jaeger.Name,
+				"namespace", jaeger.Namespace,
+				"to", v.String(),
+			)
+			return jaeger, tracing.HandleError(err, span)
 		}
+
+		upgraded.Status.Version = v.String()
+		jaeger = upgraded
 	}
 
 	// Set to latestVersion
 	jaeger.Status.Version = latestSemVersion.String()
 
+	if jaeger.Status.Version != previousVersion {
+		// keep what we had before the upgrade, so that it can be rolled back
+		if err := storeSnapshot(&jaeger, previousSpec, previousVersion); err != nil {
+			return jaeger, tracing.HandleError(err, span)
+		}
+	}
+
 	return jaeger, nil
 }
@@ -2,6 +2,7 @@ package upgrade
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/spf13/viper"
@@ -10,7 +11,9 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -26,13 +29,94 @@ func TestVersionUpgradeToLatest(t *testing.T) {
 	existing.Status.Version = "1.11.0" // this is the first version we have an upgrade function
 	objs := []runtime.Object{existing}
 
+	s := scheme.Scheme
+	s.AddKnownTypes(v1.GroupVersion, &v1.Jaeger{})
+	s.AddKnownTypes(v1.GroupVersion, &v1.JaegerList{})
+	cl := fake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(objs...).WithStatusSubresource(existing).Build()
+	recorder := record.NewFakeRecorder(1)
+
+	// test
+	require.NoError(t, ManagedInstances(context.Background(), cl, cl, recorder, "1.12.0"))
+
+	// verify
+	persisted := &v1.Jaeger{}
+	require.NoError(t, cl.Get(context.Background(), nsn, persisted))
+	assert.Equal(t, "1.12.0", persisted.Status.Version)
+	require.Len(t, recorder.Events, 1)
+	assert.Equal(t, "Normal Upgraded Upgraded from version 1.11.0 to 1.12.0", <-recorder.Events)
+}
+
+func TestNoUpgradeEventWhenStatusPatchFails(t *testing.T) {
+	// prepare
+	nsn := types.NamespacedName{Name: "my-instance"}
+
+	existing := v1.NewJaeger(nsn)
+	existing.Status.Version = "1.11.0"
+	objs := []runtime.Object{existing}
+
+	s := scheme.Scheme
+	s.AddKnownTypes(v1.GroupVersion, &v1.Jaeger{})
+	s.AddKnownTypes(v1.GroupVersion, &v1.JaegerList{})
+	cl := fake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(objs...).WithStatusSubresource(existing).
+		WithInterceptorFuncs(interceptor.Funcs{
+			SubResourcePatch: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+				return errors.New("the status can't be stored")
+			},
+		}).
+		Build()
+	recorder := record.NewFakeRecorder(1)
+
+	// test
+	require.NoError(t, ManagedInstances(context.Background(), cl, cl, recorder, "1.12.0"))
+
+	// verify
+	persisted := &v1.Jaeger{}
+	require.NoError(t, cl.Get(context.Background(), nsn, persisted))
+	assert.Equal(t, "1.11.0", persisted.Status.Version)
+	assert.Empty(t, recorder.Events)
+}
+
+func TestVersionKeptWithManualUpgradePolicy(t *testing.T) {
+	// prepare
+	nsn := types.NamespacedName{Name: "my-instance"}
+
+	existing := v1.NewJaeger(nsn)
+	existing.Spec.UpgradePolicy = v1.UpgradePolicyManual
+	existing.Status.Version = "1.11.0"
+	objs := []runtime.Object{existing}
+
+	s := scheme.Scheme
+	s.AddKnownTypes(v1.GroupVersion, &v1.Jaeger{})
+	s.AddKnownTypes(v1.GroupVersion, &v1.JaegerList{})
+	cl := fake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(objs...).Build()
+	recorder := record.NewFakeRecorder(1)
+
+	// test
+	require.NoError(t, ManagedInstances(context.Background(), cl, cl, recorder, "1.12.0"))
+
+	// verify
+	persisted := &v1.Jaeger{}
+	require.NoError(t, cl.Get(context.Background(), nsn, persisted))
+	assert.Equal(t, "1.11.0", persisted.Status.Version)
+	assert.Empty(t, recorder.Events)
+}
+
+func TestVersionUpgradeToPinnedVersion(t *testing.T) {
+	// prepare
+	nsn := types.NamespacedName{Name: "my-instance"}
+
+	existing := v1.NewJaeger(nsn)
+	existing.Spec.Version = "1.12.0"
+	existing.Status.Version = "1.11.0"
+	objs := []runtime.Object{existing}
+
 	s := scheme.Scheme
 	s.AddKnownTypes(v1.GroupVersion, &v1.Jaeger{})
 	s.AddKnownTypes(v1.GroupVersion, &v1.JaegerList{})
 	cl := fake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(objs...).Build()
 
 	// test
-	require.NoError(t, ManagedInstances(context.Background(), cl, cl, "1.12.0"))
+	require.NoError(t, ManagedInstances(context.Background(), cl, cl, &record.FakeRecorder{}, "1.15.0"))
 
 	// verify
 	persisted := &v1.Jaeger{}
@@ -40,6 +124,34 @@ func TestVersionUpgradeToLatest(t *testing.T) {
 	assert.Equal(t, "1.12.0", persisted.Status.Version)
 }
 
+func TestTargetVersion(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		spec     v1.JaegerSpec
+		current  string
+		expected string
+	}{
+		{name: "auto", current: "1.11.0", expected: "1.15.0"},
+		{name: "explicit auto", spec: v1.JaegerSpec{UpgradePolicy: v1.UpgradePolicyAuto}, current: "1.11.0", expected: "1.15.0"},
+		{name: "manual", spec: v1.JaegerSpec{UpgradePolicy: v1.UpgradePolicyManual}, current: "1.11.0", expected: "1.11.0"},
+		{name: "manual for new instance", spec: v1.JaegerSpec{UpgradePolicy: v1.UpgradePolicyManual}, expected: "1.15.0"},
+		{name: "pinned", spec: v1.JaegerSpec{Version: "1.12.0"}, current: "1.11.0", expected: "1.12.0"},
+		{name: "pinned for new instance", spec: v1.JaegerSpec{Version: "1.12.0", UpgradePolicy: v1.UpgradePolicyAuto}, expected: "1.12.0"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			jaeger := v1.Jaeger{Spec: tt.spec, Status: v1.JaegerStatus{Version: tt.current}}
+			assert.Equal(t, tt.expected, TargetVersion(jaeger, "1.15.0"))
+		})
+	}
+}
+
+func TestAvailableUpgrade(t *testing.T) {
+	assert.Equal(t, "1.15.0", AvailableUpgrade(v1.Jaeger{Status: v1.JaegerStatus{Version: "1.12.0"}}, "1.15.0"))
+	assert.Empty(t, AvailableUpgrade(v1.Jaeger{Status: v1.JaegerStatus{Version: "1.15.0"}}, "1.15.0"))
+	assert.Empty(t, AvailableUpgrade(v1.Jaeger{Status: v1.JaegerStatus{Version: "1.16.0"}}, "1.15.0"))
+	assert.Empty(t, AvailableUpgrade(v1.Jaeger{}, "1.15.0"))
+}
+
 func TestVersionUpgradeToLatestMultinamespace(t *testing.T) {
 	// prepare
 	viper.Set(v1.ConfigWatchNamespace, "observability,other-observability")
@@ -60,7 +172,7 @@ func TestVersionUpgradeToLatestMultinamespace(t *testing.T) {
 	cl := fake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(objs...).Build()
 
 	// test
-	require.NoError(t, ManagedInstances(context.Background(), cl, cl, "1.12.0"))
+	require.NoError(t, ManagedInstances(context.Background(), cl, cl, &record.FakeRecorder{}, "1.12.0"))
 
 	// verify
 	persisted := &v1.Jaeger{}
@@ -88,7 +200,7 @@ func TestVersionUpgradeToLatestOwnedResource(t *testing.T) {
 	cl := fake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(objs...).Build()
 
 	// test
-	require.NoError(t, ManagedInstances(context.Background(), cl, cl, "1.12.0"))
+	require.NoError(t, ManagedInstances(context.Background(), cl, cl, &record.FakeRecorder{}, "1.12.0"))
 
 	// verify
 	persisted := &v1.Jaeger{}
@@ -109,13 +221,16 @@ func TestUnknownVersion(t *testing.T) {
 	s.AddKnownTypes(v1.GroupVersion, &v1.JaegerList{})
 	cl := fake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(objs...).Build()
 
+	recorder := record.NewFakeRecorder(1)
+
 	// test
-	require.NoError(t, ManagedInstances(context.Background(), cl, cl, "1.12.0"))
+	require.NoError(t, ManagedInstances(context.Background(), cl, cl, recorder, "1.12.0"))
 
 	// verify
 	persisted := &v1.Jaeger{}
 	require.NoError(t, cl.Get(context.Background(), nsn, persisted))
 	assert.Equal(t, "1.10.0", persisted.Status.Version)
+	assert.Empty(t, recorder.Events)
 }
 
 func TestSkipForNonOwnedInstances(t *testing.T) {
@@ -138,7 +253,7 @@ func TestSkipForNonOwnedInstances(t *testing.T) {
 	cl := fake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(objs...).Build()
 
 	// test
-	require.NoError(t, ManagedInstances(context.Background(), cl, cl, opver.Get().Jaeger))
+	require.NoError(t, ManagedInstances(context.Background(), cl, cl, &record.FakeRecorder{}, opver.Get().Jaeger))
 
 	// verify
 	persisted := &v1.Jaeger{}
@@ -174,7 +289,7 @@ func TestSkipUpgradeForVersionsGreaterThanLatest(t *testing.T) {
 	cl := fake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(objs...).Build()
 
 	// test
-	require.NoError(t, ManagedInstances(context.Background(), cl, cl, opver.Get().Jaeger))
+	require.NoError(t, ManagedInstances(context.Background(), cl, cl, &record.FakeRecorder{}, opver.Get().Jaeger))
 
 	// verify
 	persisted := &v1.Jaeger{}
@@ -9,6 +9,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
@@ -30,7 +31,7 @@ func TestUpgradeDeprecatedOptionsv1_15_0(t *testing.T) {
 	cl := fake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(objs...).Build()
 
 	// test
-	require.NoError(t, ManagedInstances(context.Background(), cl, cl, latestVersion))
+	require.NoError(t, ManagedInstances(context.Background(), cl, cl, &record.FakeRecorder{}, latestVersion))
 
 	// verify
 	persisted := &v1.Jaeger{}
@@ -10,6 +10,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
@@ -46,7 +47,7 @@ func TestUpgradeDeprecatedOptionsv1_18_0(t *testing.T) {
 	cl := fake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(objs...).Build()
 
 	// test
-	require.NoError(t, ManagedInstances(context.Background(), cl, cl, latestVersion))
+	require.NoError(t, ManagedInstances(context.Background(), cl, cl, &record.FakeRecorder{}, latestVersion))
 
 	// verify
 	persisted := &v1.Jaeger{}
@@ -89,7 +90,7 @@ func TestUpgradeAgentWithTChannelEnablev1_18_0_(t *testing.T) {
 	s.AddKnownTypes(v1.GroupVersion, &v1.JaegerList{})
 	cl := fake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(objs...).Build()
 
-	require.NoError(t, ManagedInstances(context.Background(), cl, cl, latestVersion))
+	require.NoError(t, ManagedInstances(context.Background(), cl, cl, &record.FakeRecorder{}, latestVersion))
 
 	// verify
 	persisted := &v1.Jaeger{}
@@ -9,6 +9,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	v1 "github.com/jaegertracing/jaeger-operator/apis/v1"
@@ -46,7 +47,7 @@ func TestUpgradeJaegerTagssv1_22_0(t *testing.T) {
 	cl := fake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(objs...).Build()
 
 	// test
-	require.NoError(t, ManagedInstances(context.Background(), cl, cl, latestVersion))
+	require.NoError(t, ManagedInstances(context.Background(), cl, cl, &record.FakeRecorder{}, latestVersion))
 
 	// verify
 	persisted := &v1.Jaeger{}
@@ -90,7 +91,7 @@ func TestDeleteQueryRemovedFlags(t *testing.T) {
 	s.AddKnownTypes(v1.GroupVersion, &v1.Jaeger{})
 	s.AddKnownTypes(v1.GroupVersion, &v1.JaegerList{})
 	cl := fake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(objs...).Build()
-	require.NoError(t, ManagedInstances(context.Background(), cl, cl, latestVersion))
+	require.NoError(t, ManagedInstances(context.Background(), cl, cl, &record.FakeRecorder{}, latestVersion))
 
 	persisted := &v1.Jaeger{}
 	require.NoError(t, cl.Get(context.Background(), nsn, persisted))
@@ -139,7 +140,7 @@ func TestCassandraVerifyHostFlags(t *testing.T) {
 			s.AddKnownTypes(v1.GroupVersion, &v1.Jaeger{})
 			s.AddKnownTypes(v1.GroupVersion, &v1.JaegerList{})
 			cl := fake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(objs...).Build()
-			require.NoError(t, ManagedInstances(context.Background(), cl, cl, latestVersion))
+			require.NoError(t, ManagedInstances(context.Background(), cl, cl, &record.FakeRecorder{}, latestVersion))
 
 			persisted := &v1.Jaeger{}
 			require.NoError(t, cl.Get(context.Background(), nsn, persisted))
@@ -244,7 +245,7 @@ func TestMigrateQueryHostPortFlagsv1_22_0(t *testing.T) {
 		s.AddKnownTypes(v1.GroupVersion, &v1.Jaeger{})
 		s.AddKnownTypes(v1.GroupVersion, &v1.JaegerList{})
 		cl := fake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(objs...).Build()
-		require.NoError(t, ManagedInstances(context.Background(), cl, cl, latestVersion))
+		require.NoError(t, ManagedInstances(context.Background(), cl, cl, &record.FakeRecorder{}, latestVersion))
 
 		persisted := &v1.Jaeger{}
 		require.NoError(t, cl.Get(context.Background(), nsn, persisted))
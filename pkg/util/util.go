@@ -286,6 +286,18 @@ func ImageName(image, param string) string {
 	return image
 }
 
+// InstanceImageName works like ImageName, but resolves the image of the parameter for the Jaeger version run by the
+// given instance, so that the instances held back on their version keep the images of that version. Images which
+// can't be resolved for the version, like images referenced by digest, are returned as they are.
+func InstanceImageName(jaeger v1.Jaeger, image, param string) string {
+	if image != "" || jaeger.Status.Version == "" || jaeger.Status.Version == version.Get().Jaeger {
+		return ImageName(image, param)
+	}
+
+	image, _ = version.Image(viper.GetString(param), version.Get().Jaeger, jaeger.Status.Version)
+	return image
+}
+
 func AgentImageName(image, param string) string {
 	if image == "" {
 		param := viper.GetString(param)
@@ -298,6 +310,30 @@ func AgentImageName(image, param string) string {
 	return image
 }
 
+// InstanceAgentImageName works like InstanceImageName for the agent, which has the Jaeger version of the instance
+// when the instance is held back on its version
+func InstanceAgentImageName(jaeger v1.Jaeger, image, param string) string {
+	if image != "" || jaeger.Status.Version == "" || jaeger.Status.Version == version.Get().Jaeger {
+		return AgentImageName(image, param)
+	}
+
+	image, _ = version.Image(viper.GetString(param), version.Get().Agent, jaeger.Status.Version)
+	return image
+}
+
+// JaegerV2ImageName works like ImageName, but appends the Jaeger v2 version when the parameter value has no tag/digest
+func JaegerV2ImageName(image, param string) string {
+	if image == "" {
+		param := viper.GetString(param)
+		if strings.IndexByte(param, ':') == -1 {
+			image = fmt.Sprintf("%s:%s", param, version.Get().JaegerV2)
+		} else {
+			image = param
+		}
+	}
+	return image
+}
+
 // RemoveEmptyVars removes empty variables from the input slice.
 func RemoveEmptyVars(envVars []corev1.EnvVar) []corev1.EnvVar {
 	var notEmpty []corev1.EnvVar
@@ -523,6 +523,36 @@ func TestImageNameParamWithDigest(t *testing.T) {
 	assert.Equal(t, "org/custom-image@sha256:2a7ef4373262fa5fa3b3eaac86015650f8f3eee65d6e2674df931657873e318e", ImageName("", "test-image"))
 }
 
+func TestInstanceImageName(t *testing.T) {
+	viper.Set("test-image", "org/custom-image")
+	defer viper.Reset()
+
+	jaeger := v1.Jaeger{Status: v1.JaegerStatus{Version: "1.2.3"}}
+	assert.Equal(t, "org/custom-image:1.2.3", InstanceImageName(jaeger, "", "test-image"))
+	assert.Equal(t, "org/actual-image:4.5.6", InstanceImageName(jaeger, "org/actual-image:4.5.6", "test-image"))
+	assert.Equal(t, "org/custom-image:"+version.Get().Jaeger, InstanceImageName(v1.Jaeger{}, "", "test-image"))
+
+	viper.Set("test-image", "org/custom-image:4.5.6")
+	assert.Equal(t, "org/custom-image:4.5.6", InstanceImageName(jaeger, "", "test-image"))
+
+	// images tagged with the version of the operator, like the related images, are resolved for the instance
+	viper.Set("test-image", "org/custom-image:"+version.Get().Jaeger)
+	assert.Equal(t, "org/custom-image:1.2.3", InstanceImageName(jaeger, "", "test-image"))
+
+	viper.Set("test-image", "org/custom-image@sha256:0123")
+	assert.Equal(t, "org/custom-image@sha256:0123", InstanceImageName(jaeger, "", "test-image"))
+}
+
+func TestInstanceAgentImageName(t *testing.T) {
+	viper.Set("test-image", "org/custom-agent:"+version.Get().Agent)
+	defer viper.Reset()
+
+	jaeger := v1.Jaeger{Status: v1.JaegerStatus{Version: "1.2.3"}}
+	assert.Equal(t, "org/custom-agent:1.2.3", InstanceAgentImageName(jaeger, "", "test-image"))
+	assert.Equal(t, "org/actual-agent:4.5.6", InstanceAgentImageName(jaeger, "org/actual-agent:4.5.6", "test-image"))
+	assert.Equal(t, "org/custom-agent:"+version.Get().Agent, InstanceAgentImageName(v1.Jaeger{}, "", "test-image"))
+}
+
 func TestImageNameParamDefaultNoTag(t *testing.T) {
 	viper.SetDefault("test-image", "org/default-image")
 	defer viper.Reset()
@@ -11,6 +11,8 @@ var (
 	buildDate     string
 	defaultJaeger string
 	defaultAgent  string
+	// the Jaeger v2 distribution has its own version line
+	defaultJaegerV2 string
 )
 
 // Version holds this Operator's version as well as the version of some of the components it uses
@@ -19,6 +21,7 @@ type Version struct {
 	BuildDate string `json:"build-date"`
 	Jaeger    string `json:"jaeger-version"`
 	Agent     string `json:"agent-version"`
+	JaegerV2  string `json:"jaeger-v2-version"`
 	Go        string `json:"go-version"`
 }
 
@@ -29,6 +32,7 @@ func Get() Version {
 		BuildDate: buildDate,
 		Jaeger:    DefaultJaeger(),
 		Agent:     DefaultAgent(),
+		JaegerV2:  DefaultJaegerV2(),
 		Go:        runtime.Version(),
 	}
 }
@@ -65,8 +69,40 @@ func DefaultAgent() string {
 	return "0.0.0"
 }
 
+// DefaultJaegerV2 returns the default Jaeger v2 version to use for the OpenTelemetry Collector based distribution
+func DefaultJaegerV2() string {
+	if len(defaultJaegerV2) > 0 {
+		// this should always be set, as it's specified during the build
+		return defaultJaegerV2
+	}
+
+	// fallback value, useful for tests
+	return "0.0.0"
+}
+
 // DefaultJaegerMajorMinor returns the major.minor format of the default Jaeger version
 func DefaultJaegerMajorMinor() string {
 	version := DefaultJaeger()
 	return version[:strings.LastIndex(version, ".")]
 }
+
+// Image returns the given image for the target version: the version is appended to untagged images, and replaces the
+// tag of images tagged with the current version. The image is returned unchanged, along with false, when it can't be
+// resolved for the target version, like images referenced by digest or tagged with another version.
+func Image(image, current, target string) (string, bool) {
+	if strings.IndexByte(image, '@') > -1 {
+		return image, false
+	}
+
+	// the registry might have a port, the tag is only after the last path component
+	name, tag := image, ""
+	if i := strings.LastIndexByte(image, ':'); i > strings.LastIndexByte(image, '/') {
+		name, tag = image[:i], image[i+1:]
+	}
+
+	switch tag {
+	case "", current, target:
+		return fmt.Sprintf("%s:%s", name, target), true
+	}
+	return image, false
+}
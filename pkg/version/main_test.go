@@ -14,3 +14,24 @@ func TestDefaultJaegerMajorMinor(t *testing.T) {
 	}()
 	assert.Equal(t, "0.0", DefaultJaegerMajorMinor())
 }
+
+func TestImage(t *testing.T) {
+	for _, tt := range []struct {
+		image    string
+		expected string
+		resolved bool
+	}{
+		{image: "jaegertracing/jaeger-collector", expected: "jaegertracing/jaeger-collector:1.60.0", resolved: true},
+		{image: "jaegertracing/jaeger-collector:1.65.0", expected: "jaegertracing/jaeger-collector:1.60.0", resolved: true},
+		{image: "jaegertracing/jaeger-collector:1.60.0", expected: "jaegertracing/jaeger-collector:1.60.0", resolved: true},
+		{image: "registry:5000/jaeger-collector", expected: "registry:5000/jaeger-collector:1.60.0", resolved: true},
+		{image: "jaegertracing/jaeger-collector:latest", expected: "jaegertracing/jaeger-collector:latest", resolved: false},
+		{image: "jaegertracing/jaeger-collector@sha256:0123", expected: "jaegertracing/jaeger-collector@sha256:0123", resolved: false},
+	} {
+		t.Run(tt.image, func(t *testing.T) {
+			image, resolved := Image(tt.image, "1.65.0", "1.60.0")
+			assert.Equal(t, tt.expected, image)
+			assert.Equal(t, tt.resolved, resolved)
+		})
+	}
+}
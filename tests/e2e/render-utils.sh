@@ -839,13 +839,13 @@ function version_lt() {
 }
 
 
-# Check if the KAFKA-PROVISION-MINIMAL feature is enabled.
+# Check if the Kafka clusters are provisioned with the minimal profile.
 function is_kafka_minimal_enabled() {
     namespaces=( observability openshift-operators openshift-distributed-tracing )
     for i in "${namespaces[@]}"
     do
-        enabled="$(kubectl get pods -n $i -l name=jaeger-operator -o yaml | $YQ e '.items[0].spec.containers[0].env[] | select(.name=="KAFKA-PROVISIONING-MINIMAL").value')"
-        if [ "$enabled" == true ]; then
+        enabled="$(kubectl get pods -n $i -l name=jaeger-operator -o yaml | $YQ e '.items[0].spec.containers[0].env[] | select(.name=="KAFKA-PROVISIONING-PROFILE").value')"
+        if [ "$enabled" == minimal ]; then
             return 0
         fi
     done